@@ -0,0 +1,106 @@
+//+build cgo
+
+package ffi
+
+import (
+	"github.com/filecoin-project/filecoin-ffi/generated"
+	"github.com/pkg/errors"
+)
+
+// The min-sig variant of the BLS signature scheme swaps the groups used by
+// Filecoin: public keys are points of G2 and signatures points of G1, using
+// the BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_ domain separation tag.
+// Private keys are shared by both variants, but their public keys and
+// signatures have distinct types, so they cannot be mixed up.
+
+// PublicKeyG2FromPrivateKey derives the min-sig public key of a private key.
+// An error is returned if the private key does not encode a valid scalar or
+// has been zeroed.
+func PublicKeyG2FromPrivateKey(privateKey PrivateKey) (PublicKeyG2, error) {
+	resp := generated.FilPrivateKeyPublicKeyG2(privateKey[:])
+	if resp == nil {
+		return PublicKeyG2{}, errors.New("failed to derive public key: invalid private key")
+	}
+
+	defer generated.FilDestroyPrivateKeyPublicKeyG2Response(resp)
+
+	resp.Deref()
+	resp.PublicKey.Deref()
+
+	var publicKey PublicKeyG2
+	copy(publicKey[:], resp.PublicKey.Inner[:])
+	return publicKey, nil
+}
+
+// SignG1 signs a message with the min-sig variant. An error is returned if the
+// private key does not encode a valid scalar or has been zeroed.
+func SignG1(privateKey PrivateKey, message Message) (SignatureG1, error) {
+	resp := generated.FilPrivateKeySignG1(privateKey[:], message, uint(len(message)))
+	if resp == nil {
+		return SignatureG1{}, errors.New("failed to sign message: invalid private key")
+	}
+
+	defer generated.FilDestroySignatureG1Response(resp)
+
+	resp.Deref()
+	resp.Signature.Deref()
+
+	var signature SignatureG1
+	copy(signature[:], resp.Signature.Inner[:])
+	return signature, nil
+}
+
+// VerifyG1 verifies that a min-sig signature is the aggregated signature of
+// messages, which must be distinct, by the public key with the same index. It
+// returns false if the lengths of messages and publicKeys differ, if no
+// messages are given, or if the signature or a public key is not a valid point
+// of the prime order subgroup.
+func VerifyG1(signature SignatureG1, messages []Message, publicKeys []PublicKeyG2) bool {
+	if len(messages) != len(publicKeys) || len(messages) == 0 {
+		return false
+	}
+
+	var flattenedMessages []byte
+	messagesSizes := make([]uint, len(messages))
+	for idx := range messages {
+		flattenedMessages = append(flattenedMessages, messages[idx]...)
+		messagesSizes[idx] = uint(len(messages[idx]))
+	}
+
+	flattenedPublicKeys := make([]byte, PublicKeyG2Bytes*len(publicKeys))
+	for idx, publicKey := range publicKeys {
+		copy(flattenedPublicKeys[(PublicKeyG2Bytes*idx):(PublicKeyG2Bytes*(1+idx))], publicKey[:])
+	}
+
+	isValid := generated.FilHashVerifyG1(signature[:], flattenedMessages, uint(len(flattenedMessages)), messagesSizes, uint(len(messagesSizes)), flattenedPublicKeys, uint(len(flattenedPublicKeys)))
+
+	return isValid > 0
+}
+
+// AggregateG1 aggregates min-sig signatures together into a new signature. An
+// error is returned if no signatures are given or if one of them is not a
+// valid point of the prime order subgroup.
+func AggregateG1(signatures []SignatureG1) (SignatureG1, error) {
+	if len(signatures) == 0 {
+		return SignatureG1{}, errors.New("no signatures to aggregate")
+	}
+
+	flattenedSignatures := make([]byte, SignatureG1Bytes*len(signatures))
+	for idx, sig := range signatures {
+		copy(flattenedSignatures[(SignatureG1Bytes*idx):(SignatureG1Bytes*(1+idx))], sig[:])
+	}
+
+	resp := generated.FilAggregateG1(flattenedSignatures, uint(len(flattenedSignatures)))
+	if resp == nil {
+		return SignatureG1{}, errors.New("failed to aggregate signatures: invalid signature")
+	}
+
+	defer generated.FilDestroySignatureG1Response(resp)
+
+	resp.Deref()
+	resp.Signature.Deref()
+
+	var out SignatureG1
+	copy(out[:], resp.Signature.Inner[:])
+	return out, nil
+}
@@ -1,8 +1,18 @@
 package ffi
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math/big"
 	"math/rand"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -109,6 +119,1007 @@ func TestBlsAggregateErrors(t *testing.T) {
 	})
 }
 
+func TestBLSAggregateVerify(t *testing.T) {
+	for _, signers := range []int{1, 2, 100} {
+		signers := signers
+		t.Run(fmt.Sprintf("%d signers", signers), func(t *testing.T) {
+			var pubks []PublicKey
+			var digests []Digest
+			var sigs []Signature
+			for i := 0; i < signers; i++ {
+				priv := PrivateKeyGenerate()
+				msg := Message(fmt.Sprintf("message of signer %d", i))
+				pubks = append(pubks, PrivateKeyPublicKey(priv))
+				digests = append(digests, Hash(msg))
+				sigs = append(sigs, *PrivateKeySign(priv, msg))
+			}
+
+			aggregateSign := Aggregate(sigs)
+			require.NotNil(t, aggregateSign)
+
+			assert.True(t, AggregateVerify(pubks, digests, *aggregateSign))
+
+			// mismatched lengths
+			assert.False(t, AggregateVerify(pubks, digests[:signers-1], *aggregateSign))
+
+			// a digest signed by nobody
+			swapped := append([]Digest{}, digests...)
+			swapped[signers-1] = Hash(Message("not signed"))
+			assert.False(t, AggregateVerify(pubks, swapped, *aggregateSign))
+		})
+	}
+
+	t.Run("malformed signature", func(t *testing.T) {
+		priv := PrivateKeyGenerate()
+		digest := Hash(Message("hello"))
+
+		var garbage Signature
+		for i := range garbage {
+			garbage[i] = 0xff
+		}
+
+		assert.False(t, AggregateVerify([]PublicKey{PrivateKeyPublicKey(priv)}, []Digest{digest}, garbage))
+	})
+}
+
+func TestBLSAggregateVerifyUnique(t *testing.T) {
+	var privs []PrivateKey
+	var pubks []PublicKey
+	var digests []Digest
+	var sigs []Signature
+	for i := 0; i < 5; i++ {
+		priv := PrivateKeyGenerate()
+		msg := Message(fmt.Sprintf("message of signer %d", i))
+		privs = append(privs, priv)
+		pubks = append(pubks, PrivateKeyPublicKey(priv))
+		digests = append(digests, Hash(msg))
+		sigs = append(sigs, *PrivateKeySign(priv, msg))
+	}
+
+	aggregateSign := Aggregate(sigs)
+	require.NotNil(t, aggregateSign)
+
+	ok, err := AggregateVerifyUnique(pubks, digests, *aggregateSign)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// a digest signed by nobody
+	swapped := append([]Digest{}, digests...)
+	swapped[4] = Hash(Message("not signed"))
+	ok, err = AggregateVerifyUnique(pubks, swapped, *aggregateSign)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// the same key signing a second digest
+	msg := Message("second message of signer 1")
+	dupPubks := append(append([]PublicKey{}, pubks...), pubks[1])
+	dupDigests := append(append([]Digest{}, digests...), Hash(msg))
+	dupSign := Aggregate(append(append([]Signature{}, sigs...), *PrivateKeySign(privs[1], msg)))
+	require.NotNil(t, dupSign)
+
+	// is accepted by AggregateVerify
+	assert.True(t, AggregateVerify(dupPubks, dupDigests, *dupSign))
+
+	// but not by AggregateVerifyUnique
+	_, err = AggregateVerifyUnique(dupPubks, dupDigests, *dupSign)
+	assert.True(t, errors.Is(err, ErrDuplicatePublicKey))
+	assert.Contains(t, err.Error(), "public key 5 duplicates public key 1")
+
+	badPubks := append([]PublicKey{}, pubks...)
+	badPubks[2] = PublicKey{}
+	_, err = AggregateVerifyUnique(badPubks, digests, *aggregateSign)
+	assert.True(t, errors.Is(err, ErrInvalidPublicKey))
+
+	_, err = AggregateVerifyUnique(pubks, digests[1:], *aggregateSign)
+	assert.Error(t, err)
+}
+
+func TestBLSVerifyAggregateSignatureDistinct(t *testing.T) {
+	var privs []PrivateKey
+	var pairs []MessagePublicKeyPair
+	var sigs []Signature
+	for i := 0; i < 4; i++ {
+		priv := PrivateKeyGenerate()
+		msg := Message(fmt.Sprintf("block %d", i))
+		privs = append(privs, priv)
+		pairs = append(pairs, MessagePublicKeyPair{Message: msg, PublicKey: PrivateKeyPublicKey(priv)})
+		sigs = append(sigs, *PrivateKeySign(priv, msg))
+	}
+
+	aggregateSign := Aggregate(sigs)
+	require.NotNil(t, aggregateSign)
+
+	ok, err := VerifyAggregateSignatureDistinct(*aggregateSign, pairs)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = VerifyAggregateSignatureDistinct(*aggregateSign, pairs[1:])
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// the same signer signing two messages is rejected
+	msg := Message("another block")
+	dupPairs := append(append([]MessagePublicKeyPair{}, pairs...), MessagePublicKeyPair{Message: msg, PublicKey: pairs[2].PublicKey})
+	dupSign := Aggregate(append(append([]Signature{}, sigs...), *PrivateKeySign(privs[2], msg)))
+	require.NotNil(t, dupSign)
+
+	_, err = VerifyAggregateSignatureDistinct(*dupSign, dupPairs)
+	assert.True(t, errors.Is(err, ErrDuplicatePublicKey))
+
+	badPairs := append([]MessagePublicKeyPair{}, pairs...)
+	badPairs[0].PublicKey = PublicKey{}
+	_, err = VerifyAggregateSignatureDistinct(*aggregateSign, badPairs)
+	assert.True(t, errors.Is(err, ErrInvalidPublicKey))
+
+	_, err = VerifyAggregateSignatureDistinct(*aggregateSign, nil)
+	assert.Error(t, err)
+}
+
+func TestBLSVerifyAggregateSignatureSafe(t *testing.T) {
+	var privs []PrivateKey
+	var pubks []PublicKey
+	var msgs []Message
+	var sigs []Signature
+	for i := 0; i < 4; i++ {
+		priv := PrivateKeyGenerate()
+		// the augmented scheme allows the signers to sign the same message
+		msg := Message("checkpoint")
+		sig, err := SignAugmented(priv, msg)
+		require.NoError(t, err)
+
+		privs = append(privs, priv)
+		pubks = append(pubks, PrivateKeyPublicKey(priv))
+		msgs = append(msgs, msg)
+		sigs = append(sigs, sig)
+	}
+
+	aggregateSign := Aggregate(sigs)
+	require.NotNil(t, aggregateSign)
+
+	ok, err := VerifyAggregateSignatureSafe(*aggregateSign, msgs, pubks)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = VerifyAggregateSignatureSafe(*aggregateSign, msgs[1:], pubks[1:])
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// signatures of the bare messages are not augmented signatures
+	var plainSigs []Signature
+	for i, priv := range privs {
+		plainSigs = append(plainSigs, *PrivateKeySign(priv, msgs[i]))
+	}
+	plainSign := Aggregate(plainSigs)
+	require.NotNil(t, plainSign)
+	ok, err = VerifyAggregateSignatureSafe(*plainSign, msgs, pubks)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	dupPubks := append(append([]PublicKey{}, pubks...), pubks[1])
+	dupMsgs := append(append([]Message{}, msgs...), Message("other"))
+	_, err = VerifyAggregateSignatureSafe(*aggregateSign, dupMsgs, dupPubks)
+	assert.True(t, errors.Is(err, ErrDuplicatePublicKey))
+
+	badPubks := append([]PublicKey{}, pubks...)
+	badPubks[0] = PublicKey{}
+	_, err = VerifyAggregateSignatureSafe(*aggregateSign, msgs, badPubks)
+	assert.True(t, errors.Is(err, ErrInvalidPublicKey))
+
+	_, err = VerifyAggregateSignatureSafe(*aggregateSign, msgs[1:], pubks)
+	assert.Error(t, err)
+
+	_, err = VerifyAggregateSignatureSafe(*aggregateSign, nil, nil)
+	assert.Error(t, err)
+}
+
+func TestBLSFastAggregateVerify(t *testing.T) {
+	msg := Message("quorum message")
+	digest := Hash(msg)
+
+	var pubks []PublicKey
+	var sigs []Signature
+	for i := 0; i < 50; i++ {
+		priv := PrivateKeyGenerate()
+		pubks = append(pubks, PrivateKeyPublicKey(priv))
+		sigs = append(sigs, *PrivateKeySign(priv, msg))
+	}
+
+	aggregateSign := Aggregate(sigs)
+	require.NotNil(t, aggregateSign)
+
+	assert.True(t, FastAggregateVerify(pubks, digest, *aggregateSign))
+	assert.False(t, FastAggregateVerify(pubks[1:], digest, *aggregateSign))
+	assert.False(t, FastAggregateVerify(pubks, Hash(Message("other message")), *aggregateSign))
+
+	t.Run("no public keys", func(t *testing.T) {
+		assert.False(t, FastAggregateVerify(nil, digest, *aggregateSign))
+	})
+
+	t.Run("duplicate public keys", func(t *testing.T) {
+		priv := PrivateKeyGenerate()
+		pubk := PrivateKeyPublicKey(priv)
+		sig := PrivateKeySign(priv, msg)
+
+		doubled := Aggregate([]Signature{*sig, *sig})
+		require.NotNil(t, doubled)
+
+		assert.True(t, FastAggregateVerify([]PublicKey{pubk, pubk}, digest, *doubled))
+	})
+
+	t.Run("identity signature", func(t *testing.T) {
+		assert.False(t, FastAggregateVerify(pubks, digest, CreateZeroSignature()))
+	})
+}
+
+func TestBLSVerifyBatch(t *testing.T) {
+	var digests []Digest
+	var pubks []PublicKey
+	var sigs []Signature
+	for i := 0; i < 5; i++ {
+		priv := PrivateKeyGenerate()
+		msg := Message(fmt.Sprintf("batch message %d", i))
+		digests = append(digests, Hash(msg))
+		pubks = append(pubks, PrivateKeyPublicKey(priv))
+		sigs = append(sigs, *PrivateKeySign(priv, msg))
+	}
+
+	t.Run("valid batch", func(t *testing.T) {
+		ok, err := VerifyBatch(digests, pubks, sigs)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("single entry", func(t *testing.T) {
+		ok, err := VerifyBatch(digests[:1], pubks[:1], sigs[:1])
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = VerifyBatch(digests[:1], pubks[1:2], sigs[:1])
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		ok, err := VerifyBatch(nil, nil, nil)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("duplicate digests", func(t *testing.T) {
+		priv := PrivateKeyGenerate()
+		msg := Message("batch message 0")
+
+		ok, err := VerifyBatch(
+			append([]Digest{Hash(msg)}, digests...),
+			append([]PublicKey{PrivateKeyPublicKey(priv)}, pubks...),
+			append([]Signature{*PrivateKeySign(priv, msg)}, sigs...),
+		)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("swapped signatures", func(t *testing.T) {
+		swapped := append([]Signature{}, sigs...)
+		swapped[0], swapped[1] = swapped[1], swapped[0]
+
+		ok, err := VerifyBatch(digests, pubks, swapped)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		_, err := VerifyBatch(digests, pubks[:4], sigs)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrBatchLengthMismatch))
+	})
+}
+
+func TestBLSBatchVerifySignatures(t *testing.T) {
+	var requests []VerificationRequest
+	for i := 0; i < 6; i++ {
+		priv := PrivateKeyGenerate()
+		msg := Message(fmt.Sprintf("hello %d", i))
+		requests = append(requests, VerificationRequest{
+			Message:   msg,
+			PublicKey: PrivateKeyPublicKey(priv),
+			Signature: *PrivateKeySign(priv, msg),
+		})
+	}
+
+	results, err := BatchVerifySignatures(requests)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, true, true, true, true, true}, results)
+
+	// break a few entries in different ways
+	requests[1].Message = Message("tampered")
+	requests[3].PublicKey = requests[4].PublicKey
+	for i := range requests[5].Signature {
+		requests[5].Signature[i] = 0xff
+	}
+
+	results, err = BatchVerifySignatures(requests)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true, false, true, false}, results)
+
+	results, err = BatchVerifySignatures(nil)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestBLSAggregatePublicKeys(t *testing.T) {
+	msg := Message("signed by everyone")
+	digest := Hash(msg)
+
+	var pubks []PublicKey
+	var sigs []Signature
+	for i := 0; i < 4; i++ {
+		priv := PrivateKeyGenerate()
+		pubks = append(pubks, PrivateKeyPublicKey(priv))
+		sigs = append(sigs, *PrivateKeySign(priv, msg))
+	}
+
+	aggregateKey, err := AggregatePublicKeys(pubks)
+	require.NoError(t, err)
+
+	aggregateSign := Aggregate(sigs)
+	require.NotNil(t, aggregateSign)
+
+	assert.True(t, Verify(aggregateSign, []Digest{digest}, []PublicKey{*aggregateKey}))
+
+	// a key aggregated from a subset of the signers does not verify
+	partialKey, err := AggregatePublicKeys(pubks[1:])
+	require.NoError(t, err)
+	assert.False(t, Verify(aggregateSign, []Digest{digest}, []PublicKey{*partialKey}))
+
+	t.Run("no public keys", func(t *testing.T) {
+		_, err := AggregatePublicKeys(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid public key", func(t *testing.T) {
+		var garbage PublicKey
+		for i := range garbage {
+			garbage[i] = 0xff
+		}
+
+		_, err := AggregatePublicKeys([]PublicKey{pubks[0], garbage})
+		require.Error(t, err)
+	})
+}
+
+func TestBLSProofOfPossession(t *testing.T) {
+	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+
+	pop := PopProve(priv)
+	assert.True(t, PopVerify(pubk, pop))
+
+	// a proof of possession is specific to its key
+	other := PrivateKeyGenerate()
+	assert.False(t, PopVerify(PrivateKeyPublicKey(other), pop))
+	assert.False(t, PopVerify(pubk, PopProve(other)))
+
+	// a proof of possession is not a signature of the public key bytes
+	assert.False(t, HashVerify(&pop, []Message{pubk[:]}, []PublicKey{pubk}))
+
+	// and a signature of the public key bytes is not a proof of possession
+	sig := PrivateKeySign(priv, pubk[:])
+	assert.False(t, PopVerify(pubk, *sig))
+}
+
+func TestBLSGenerateProofOfPossession(t *testing.T) {
+	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+
+	pop, err := GenerateProofOfPossession(priv)
+	require.NoError(t, err)
+	assert.Equal(t, PopProve(priv), pop)
+
+	ok, err := VerifyProofOfPossession(pubk, pop)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// a well-formed proof of another key does not verify
+	otherPop, err := GenerateProofOfPossession(PrivateKeyGenerate())
+	require.NoError(t, err)
+
+	ok, err = VerifyProofOfPossession(pubk, otherPop)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	var garbage Signature
+	for i := range garbage {
+		garbage[i] = 0xff
+	}
+
+	_, err = VerifyProofOfPossession(pubk, garbage)
+	assert.True(t, errors.Is(err, ErrInvalidSignatureEncoding))
+
+	_, err = VerifyProofOfPossession(PublicKey{}, pop)
+	assert.True(t, errors.Is(err, ErrInvalidPublicKey))
+
+	var invalid PrivateKey
+	for i := range invalid {
+		invalid[i] = 0xff
+	}
+
+	_, err = GenerateProofOfPossession(invalid)
+	assert.Error(t, err)
+}
+
+func TestBLSValidatePublicKey(t *testing.T) {
+	pubk := PrivateKeyPublicKey(PrivateKeyGenerate())
+	require.NoError(t, ValidatePublicKey(pubk))
+
+	t.Run("mutated key", func(t *testing.T) {
+		mutated := pubk
+		mutated[PublicKeyBytes-1] ^= 0x01
+		require.Error(t, ValidatePublicKey(mutated))
+	})
+
+	t.Run("all zero key", func(t *testing.T) {
+		var zero PublicKey
+		require.True(t, errors.Is(ValidatePublicKey(zero), ErrInvalidPointEncoding))
+	})
+
+	t.Run("point at infinity", func(t *testing.T) {
+		var infinity PublicKey
+		infinity[0] = 0xc0
+		require.True(t, errors.Is(ValidatePublicKey(infinity), ErrPointAtInfinity))
+		require.True(t, errors.Is(ValidatePublicKey(infinity), ErrIdentityPoint))
+		require.NoError(t, ValidatePublicKey(infinity, WithIdentityAllowed()))
+	})
+
+	t.Run("point outside of subgroup", func(t *testing.T) {
+		// (0, 2) is on the curve, but has order 3
+		var torsion PublicKey
+		torsion[0] = 0x80
+		require.True(t, errors.Is(ValidatePublicKey(torsion), ErrPointNotInSubgroup))
+	})
+
+	t.Run("typed error", func(t *testing.T) {
+		var zero PublicKey
+		err := ValidatePublicKey(zero)
+		require.True(t, errors.Is(err, ErrInvalidPublicKey))
+		assert.False(t, errors.Is(err, ErrPointAtInfinity))
+		assert.Equal(t, "invalid public key: not a valid compressed point encoding", err.Error())
+
+		var infinity PublicKey
+		infinity[0] = 0xc0
+		require.True(t, errors.Is(ValidatePublicKey(infinity), ErrInvalidPublicKey))
+	})
+}
+
+func TestBLSHexEncoding(t *testing.T) {
+	priv := PrivateKeyGenerateWithSeed(PrivateKeyGenSeed{1, 2, 3})
+	pubk := PrivateKeyPublicKey(priv)
+	msg := Message("hello hex")
+	sig := PrivateKeySign(priv, msg)
+	digest := Hash(msg)
+
+	parsedSig, err := ParseSignatureFromHex(sig.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, *sig, parsedSig)
+
+	parsedPriv, err := ParsePrivateKeyFromHex(priv.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, priv, parsedPriv)
+
+	parsedPubk, err := ParsePublicKeyFromHex(pubk.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, pubk, parsedPubk)
+
+	parsedDigest, err := ParseDigestFromHex(digest.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, digest, parsedDigest)
+
+	assert.Len(t, sig.Hex(), 2*SignatureBytes)
+
+	_, err = ParseSignatureFromHex(pubk.Hex())
+	require.Error(t, err)
+
+	_, err = ParsePublicKeyFromHex(sig.Hex())
+	require.Error(t, err)
+
+	_, err = ParsePrivateKeyFromHex("zz")
+	require.Error(t, err)
+
+	_, err = ParseDigestFromHex("")
+	require.Error(t, err)
+}
+
+func TestBLSValidateSignature(t *testing.T) {
+	sig := PrivateKeySign(PrivateKeyGenerate(), Message("hello"))
+	require.NoError(t, ValidateSignature(*sig))
+
+	t.Run("garbage", func(t *testing.T) {
+		var garbage Signature
+		for i := range garbage {
+			garbage[i] = 0xff
+		}
+		require.True(t, errors.Is(ValidateSignature(garbage), ErrInvalidPointEncoding))
+	})
+
+	t.Run("all zero signature", func(t *testing.T) {
+		var zero Signature
+		require.True(t, errors.Is(ValidateSignature(zero), ErrInvalidPointEncoding))
+	})
+
+	t.Run("point at infinity", func(t *testing.T) {
+		require.True(t, errors.Is(ValidateSignature(CreateZeroSignature()), ErrPointAtInfinity))
+	})
+}
+
+func TestBLSParseSignature(t *testing.T) {
+	sig := PrivateKeySign(PrivateKeyGenerate(), Message("hello"))
+	require.NotNil(t, sig)
+
+	b := sig.Bytes()
+	require.Len(t, b, SignatureBytes)
+
+	parsed, err := ParseSignature(b)
+	require.NoError(t, err)
+	assert.Equal(t, *sig, parsed)
+
+	// the returned bytes are a copy
+	b[0] ^= 0xff
+	assert.NotEqual(t, b, sig.Bytes())
+
+	_, err = ParseSignature(sig[:SignatureBytes-1])
+	assert.Error(t, err)
+
+	_, err = ParseSignature(append(sig.Bytes(), 0))
+	assert.Error(t, err)
+
+	var garbage Signature
+	for i := range garbage {
+		garbage[i] = 0xff
+	}
+	_, err = ParseSignature(garbage[:])
+	assert.True(t, errors.Is(err, ErrInvalidPointEncoding))
+
+	// the placeholder signature is only accepted when asked for
+	zero := CreateZeroSignature()
+	_, err = ParseSignature(zero[:])
+	assert.True(t, errors.Is(err, ErrIdentityPoint))
+
+	parsed, err = ParseSignature(zero[:], WithIdentityAllowed())
+	require.NoError(t, err)
+	assert.Equal(t, zero, parsed)
+
+	// other invalid signatures are still rejected
+	_, err = ParseSignature(garbage[:], WithIdentityAllowed())
+	assert.True(t, errors.Is(err, ErrInvalidPointEncoding))
+}
+
+func TestBLSIsIdentity(t *testing.T) {
+	var sig Signature
+	sig[0] = 0xc0
+	assert.True(t, sig.IsIdentity())
+
+	var pubk PublicKey
+	pubk[0] = 0xc0
+	assert.True(t, pubk.IsIdentity())
+
+	// an uninitialized value is not the identity point
+	assert.False(t, (&Signature{}).IsIdentity())
+	assert.False(t, (&PublicKey{}).IsIdentity())
+	assert.False(t, (*Signature)(nil).IsIdentity())
+	assert.False(t, (*PublicKey)(nil).IsIdentity())
+
+	// nor is an encoding with other bits set
+	sig[SignatureBytes-1] = 1
+	assert.False(t, sig.IsIdentity())
+
+	pubk[0] = 0xe0
+	assert.False(t, pubk.IsIdentity())
+}
+
+func TestBLSMarshalIETF(t *testing.T) {
+	sig := PrivateKeySign(PrivateKeyGenerate(), Message("hello"))
+	require.NotNil(t, sig)
+
+	b, err := sig.MarshalIETF()
+	require.NoError(t, err)
+	assert.Equal(t, sig.Bytes(), b)
+	assert.NotZero(t, b[0]&0x80, "compression flag not set")
+	assert.Zero(t, b[0]&0x40, "infinity flag set")
+
+	parsed, err := UnmarshalIETFSignature(b)
+	require.NoError(t, err)
+	assert.Equal(t, *sig, parsed)
+
+	// the identity point is encoded with just the compression and infinity
+	// flags set
+	zero := CreateZeroSignature()
+	b, err = zero.MarshalIETF()
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte{0xc0}, make([]byte, SignatureBytes-1)...), b)
+
+	_, err = UnmarshalIETFSignature(b)
+	assert.True(t, errors.Is(err, ErrIdentityPoint))
+
+	parsed, err = UnmarshalIETFSignature(b, WithIdentityAllowed())
+	require.NoError(t, err)
+	assert.Equal(t, zero, parsed)
+
+	// an uninitialized signature is not a valid encoding
+	_, err = Signature{}.MarshalIETF()
+	assert.True(t, errors.Is(err, ErrInvalidPointEncoding))
+
+	uncompressed := sig.Bytes()
+	uncompressed[0] &^= 0x80
+	_, err = UnmarshalIETFSignature(uncompressed)
+	assert.True(t, errors.Is(err, ErrInvalidPointEncoding))
+
+	for _, invalidIdentity := range [][]byte{
+		append([]byte{0xe0}, make([]byte, SignatureBytes-1)...),
+		append([]byte{0xc0, 1}, make([]byte, SignatureBytes-2)...),
+	} {
+		_, err = UnmarshalIETFSignature(invalidIdentity)
+		assert.True(t, errors.Is(err, ErrInvalidPointEncoding))
+	}
+
+	_, err = UnmarshalIETFSignature(sig[:SignatureBytes-1])
+	assert.Error(t, err)
+}
+
+func BenchmarkBLSValidateSignature(b *testing.B) {
+	sig := PrivateKeySign(PrivateKeyGenerate(), Message("this is a message that i will be signing"))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := ValidateSignature(*sig); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestBLSCustomDST(t *testing.T) {
+	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+	msg := Message("hello other protocol")
+
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_")
+	otherDST := []byte("ANOTHER-PROTOCOL-V1")
+
+	digest, err := HashWithDST(msg, dst)
+	require.NoError(t, err)
+	assert.NotEqual(t, Hash(msg), digest)
+
+	otherDigest, err := HashWithDST(msg, otherDST)
+	require.NoError(t, err)
+	assert.NotEqual(t, digest, otherDigest)
+
+	sig, err := SignWithDST(priv, msg, dst)
+	require.NoError(t, err)
+	assert.True(t, Verify(&sig, []Digest{digest}, []PublicKey{pubk}))
+
+	ok, err := VerifyWithDST(sig, msg, pubk, dst)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = VerifyWithDST(sig, msg, pubk, otherDST)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// a signature using a custom tag is not a regular signature
+	assert.False(t, HashVerify(&sig, []Message{msg}, []PublicKey{pubk}))
+
+	t.Run("empty tag", func(t *testing.T) {
+		_, err := HashWithDST(msg, nil)
+		require.Error(t, err)
+
+		_, err = SignWithDST(priv, msg, []byte{})
+		require.Error(t, err)
+
+		_, err = VerifyWithDST(sig, msg, pubk, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("tag too long", func(t *testing.T) {
+		_, err := HashWithDST(msg, make([]byte, MaxDSTBytes+1))
+		require.Error(t, err)
+
+		_, err = HashWithDST(msg, make([]byte, MaxDSTBytes))
+		require.NoError(t, err)
+	})
+}
+
+func TestBLSSignMessageWithDomain(t *testing.T) {
+	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+	msg := Message("hello other chain")
+
+	sig, err := SignMessageWithDomain(priv, msg, []byte(EthereumDomain))
+	require.NoError(t, err)
+
+	dstSig, err := SignWithDST(priv, msg, []byte(EthereumDomain))
+	require.NoError(t, err)
+	assert.Equal(t, dstSig, sig)
+
+	ok, err := VerifySignatureWithDomain(sig, pubk, msg, []byte(EthereumDomain))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = VerifySignatureWithDomain(sig, pubk, msg, []byte(hashDST))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = SignMessageWithDomain(priv, msg, nil)
+	assert.True(t, errors.Is(err, ErrEmptyDST))
+}
+
+func TestBLSPublicKeyFromPrivateKey(t *testing.T) {
+	priv := PrivateKeyGenerate()
+
+	pubk, err := PublicKeyFromPrivateKey(priv)
+	require.NoError(t, err)
+	assert.Equal(t, PrivateKeyPublicKey(priv), pubk)
+	assert.NoError(t, ValidatePublicKey(pubk))
+
+	// larger than the order of the scalar field
+	var outOfRange PrivateKey
+	for i := range outOfRange {
+		outOfRange[i] = 0xff
+	}
+
+	_, err = PublicKeyFromPrivateKey(outOfRange)
+	assert.Error(t, err)
+}
+
+func TestBLSPrivateKeyZero(t *testing.T) {
+	msg := Message("hello zero")
+
+	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+	require.False(t, priv.IsZero())
+
+	sig := PrivateKeySign(priv, msg)
+	require.NotNil(t, sig)
+	require.True(t, HashVerify(sig, []Message{msg}, []PublicKey{pubk}))
+
+	priv.Zero()
+	require.True(t, priv.IsZero())
+	assert.Equal(t, PrivateKey{}, priv)
+
+	// a zeroed key can no longer be used
+	assert.Nil(t, PrivateKeySign(priv, msg))
+	assert.Equal(t, PublicKey{}, PrivateKeyPublicKey(priv))
+
+	_, err := SignWithDST(priv, msg, []byte("SOME-DST"))
+	assert.Error(t, err)
+}
+
+func TestBLSGuardedPrivateKey(t *testing.T) {
+	msg := Message("hello guarded")
+
+	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+
+	guarded := NewGuardedPrivateKey(priv)
+	priv.Zero()
+
+	guardedPubk, err := guarded.PublicKey()
+	require.NoError(t, err)
+	assert.Equal(t, pubk, guardedPubk)
+
+	sig, err := guarded.Sign(msg)
+	require.NoError(t, err)
+	assert.True(t, HashVerify(sig, []Message{msg}, []PublicKey{pubk}))
+
+	guarded.Zero()
+
+	_, err = guarded.Sign(msg)
+	assert.Equal(t, ErrPrivateKeyZeroed, err)
+
+	_, err = guarded.PublicKey()
+	assert.Equal(t, ErrPrivateKeyZeroed, err)
+}
+
+func TestBLSSecurePrivateKey(t *testing.T) {
+	msg := Message("hello secure")
+
+	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+	expected := PrivateKeySign(priv, msg)
+
+	secure, err := NewSecurePrivateKey(priv)
+	require.NoError(t, err)
+	priv.Zero()
+
+	securePubk, err := secure.PublicKey()
+	require.NoError(t, err)
+	assert.Equal(t, pubk, securePubk)
+
+	sig, err := secure.Sign(msg)
+	require.NoError(t, err)
+	assert.Equal(t, expected, sig)
+	assert.True(t, HashVerify(sig, []Message{msg}, []PublicKey{pubk}))
+
+	require.NoError(t, secure.Zero())
+
+	_, err = secure.Sign(msg)
+	assert.Equal(t, ErrPrivateKeyZeroed, err)
+
+	_, err = secure.PublicKey()
+	assert.Equal(t, ErrPrivateKeyZeroed, err)
+
+	// zeroing twice is safe
+	require.NoError(t, secure.Zero())
+}
+
+func TestBLSSigner(t *testing.T) {
+	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+	msg := Message("hello signer")
+
+	signer, err := NewSigner(priv)
+	require.NoError(t, err)
+
+	signerPubk, err := signer.PublicKey()
+	require.NoError(t, err)
+	assert.Equal(t, pubk, signerPubk)
+
+	sig, err := signer.Sign(msg)
+	require.NoError(t, err)
+	assert.Equal(t, *PrivateKeySign(priv, msg), sig)
+	assert.True(t, HashVerify(&sig, []Message{msg}, []PublicKey{pubk}))
+
+	signer.Free()
+
+	_, err = signer.Sign(msg)
+	assert.Equal(t, ErrSignerFreed, err)
+
+	_, err = signer.PublicKey()
+	assert.Equal(t, ErrSignerFreed, err)
+
+	// freeing twice is safe
+	signer.Free()
+
+	_, err = NewSigner(PrivateKey{})
+	assert.Error(t, err)
+}
+
+func BenchmarkBLSSignerSign(b *testing.B) {
+	signer, err := NewSigner(PrivateKeyGenerate())
+	require.NoError(b, err)
+	defer signer.Free()
+
+	msg := Message("this is a message that i will be signing")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := signer.Sign(msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestBLSEqualAndIsZero(t *testing.T) {
+	var priv, otherPriv PrivateKey
+	var pubk, otherPubk PublicKey
+	var sig, otherSig Signature
+
+	assert.True(t, priv.IsZero())
+	assert.True(t, pubk.IsZero())
+	assert.True(t, sig.IsZero())
+
+	for i := range priv {
+		priv[i] = byte(i + 1)
+	}
+	for i := range pubk {
+		pubk[i] = byte(i + 1)
+	}
+	for i := range sig {
+		sig[i] = byte(i + 1)
+	}
+
+	assert.False(t, priv.IsZero())
+	assert.False(t, pubk.IsZero())
+	assert.False(t, sig.IsZero())
+
+	otherPriv, otherPubk, otherSig = priv, pubk, sig
+	assert.True(t, priv.Equal(otherPriv))
+	assert.True(t, pubk.Equal(&otherPubk))
+	assert.True(t, sig.Equal(&otherSig))
+
+	// a difference in the last byte is noticed
+	otherPriv[PrivateKeyBytes-1]++
+	otherPubk[PublicKeyBytes-1]++
+	otherSig[SignatureBytes-1]++
+	assert.False(t, priv.Equal(otherPriv))
+	assert.False(t, pubk.Equal(&otherPubk))
+	assert.False(t, sig.Equal(&otherSig))
+
+	// the private key methods take values, so they work on map entries
+	keys := map[string]PrivateKey{"priv": priv}
+	assert.True(t, keys["priv"].Equal(priv))
+	assert.False(t, keys["priv"].IsZero())
+	assert.True(t, PrivateKey{}.IsZero())
+	assert.False(t, PrivateKey{}.Equal(priv))
+
+	// the identity point is not an uninitialized signature
+	zeroSig := CreateZeroSignature()
+	assert.False(t, zeroSig.IsZero())
+
+	var nilPubk *PublicKey
+	var nilSig *Signature
+
+	assert.True(t, nilPubk.IsZero())
+	assert.True(t, nilSig.IsZero())
+
+	assert.True(t, nilPubk.Equal(nil))
+	assert.True(t, nilSig.Equal(nil))
+
+	assert.False(t, nilPubk.Equal(&pubk))
+	assert.False(t, nilSig.Equal(&sig))
+	assert.False(t, pubk.Equal(nil))
+	assert.False(t, sig.Equal(nil))
+}
+
+func TestBLSTextEncoding(t *testing.T) {
+	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+	sig := PrivateKeySign(priv, Message("hello text"))
+
+	type encoded struct {
+		PrivateKey PrivateKey
+		PublicKey  PublicKey
+		Signature  Signature
+	}
+
+	in := encoded{PrivateKey: priv, PublicKey: pubk, Signature: *sig}
+
+	serialized, err := json.Marshal(in)
+	require.NoError(t, err)
+	assert.Contains(t, string(serialized), fmt.Sprintf(`"PublicKey":"%s"`, pubk.Hex()))
+
+	var out encoded
+	require.NoError(t, json.Unmarshal(serialized, &out))
+	assert.Equal(t, in, out)
+
+	t.Run("hex helpers", func(t *testing.T) {
+		decodedSig, err := SignatureFromHex(SignatureToHex(*sig))
+		require.NoError(t, err)
+		assert.Equal(t, *sig, decodedSig)
+
+		decodedPubk, err := PublicKeyFromHex(PublicKeyToHex(pubk))
+		require.NoError(t, err)
+		assert.Equal(t, pubk, decodedPubk)
+
+		decodedPriv, err := PrivateKeyFromHex(PrivateKeyToHex(priv))
+		require.NoError(t, err)
+		assert.Equal(t, priv, decodedPriv)
+	})
+
+	t.Run("upper case", func(t *testing.T) {
+		decodedPubk, err := PublicKeyFromHex(strings.ToUpper(pubk.Hex()))
+		require.NoError(t, err)
+		assert.Equal(t, pubk, decodedPubk)
+	})
+
+	t.Run("wrong length", func(t *testing.T) {
+		_, err := SignatureFromHex(sig.Hex()[2:])
+		assert.Error(t, err)
+
+		_, err = PublicKeyFromHex(pubk.Hex() + "00")
+		assert.Error(t, err)
+
+		_, err = PrivateKeyFromHex("")
+		assert.Error(t, err)
+	})
+
+	t.Run("private key is untouched on error", func(t *testing.T) {
+		target := priv
+		assert.Error(t, target.UnmarshalText([]byte(priv.Hex()[:40])))
+		assert.Equal(t, priv, target)
+
+		assert.Error(t, target.UnmarshalText([]byte("not hex at all")))
+		assert.Equal(t, priv, target)
+	})
+}
+
 func BenchmarkBLSVerifyBatch(b *testing.B) {
 	b.Run("10", benchmarkBLSVerifyBatchSize(10))
 	b.Run("50", benchmarkBLSVerifyBatchSize(50))
@@ -118,69 +1129,1075 @@ func BenchmarkBLSVerifyBatch(b *testing.B) {
 	b.Run("4000", benchmarkBLSVerifyBatchSize(4000))
 }
 
-func benchmarkBLSVerifyBatchSize(size int) func(b *testing.B) {
-	return func(b *testing.B) {
-		var digests []Digest
-		var msgs []Message
-		var sigs []Signature
-		var pubks []PublicKey
-		for i := 0; i < size; i++ {
-			msg := Message(fmt.Sprintf("cats cats cats cats %d %d %d dogs", i, i, i))
-			msgs = append(msgs, msg)
-			digests = append(digests, Hash(msg))
-			priv := PrivateKeyGenerate()
-			sig := PrivateKeySign(priv, msg)
-			sigs = append(sigs, *sig)
-			pubk := PrivateKeyPublicKey(priv)
-			pubks = append(pubks, pubk)
+func benchmarkBLSVerifyBatchSize(size int) func(b *testing.B) {
+	return func(b *testing.B) {
+		var digests []Digest
+		var msgs []Message
+		var sigs []Signature
+		var pubks []PublicKey
+		for i := 0; i < size; i++ {
+			msg := Message(fmt.Sprintf("cats cats cats cats %d %d %d dogs", i, i, i))
+			msgs = append(msgs, msg)
+			digests = append(digests, Hash(msg))
+			priv := PrivateKeyGenerate()
+			sig := PrivateKeySign(priv, msg)
+			sigs = append(sigs, *sig)
+			pubk := PrivateKeyPublicKey(priv)
+			pubks = append(pubks, pubk)
+		}
+
+		t := time.Now()
+		agsig := Aggregate(sigs)
+		fmt.Println("Aggregate took: ", time.Since(t))
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if !Verify(agsig, digests, pubks) {
+				b.Fatal("failed to verify")
+			}
+		}
+	}
+}
+
+func BenchmarkBLSVerifyBatchIndependent(b *testing.B) {
+	for _, size := range []int{10, 100, 1000} {
+		var digests []Digest
+		var sigs []Signature
+		var pubks []PublicKey
+		for i := 0; i < size; i++ {
+			msg := Message(fmt.Sprintf("cats cats cats cats %d %d %d dogs", i, i, i))
+			digests = append(digests, Hash(msg))
+			priv := PrivateKeyGenerate()
+			sigs = append(sigs, *PrivateKeySign(priv, msg))
+			pubks = append(pubks, PrivateKeyPublicKey(priv))
+		}
+
+		b.Run(fmt.Sprintf("VerifyBatch/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if ok, err := VerifyBatch(digests, pubks, sigs); err != nil || !ok {
+					b.Fatal("failed to verify")
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("Verify/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j := range sigs {
+					if !Verify(&sigs[j], []Digest{digests[j]}, []PublicKey{pubks[j]}) {
+						b.Fatal("failed to verify")
+					}
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkBLSHashAndVerify(b *testing.B) {
+	priv := PrivateKeyGenerate()
+
+	msg := Message("this is a message that i will be signing")
+	sig := PrivateKeySign(priv, msg)
+
+	// fmt.Println("SIG SIZE: ", len(sig))
+	// fmt.Println("SIG: ", sig)
+	pubk := PrivateKeyPublicKey(priv)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		digest := Hash(msg)
+		if !Verify(sig, []Digest{digest}, []PublicKey{pubk}) {
+			b.Fatal("failed to verify")
+		}
+	}
+}
+
+func BenchmarkBLSHashVerify(b *testing.B) {
+	priv := PrivateKeyGenerate()
+
+	msg := Message("this is a message that i will be signing")
+	sig := PrivateKeySign(priv, msg)
+
+	// fmt.Println("SIG SIZE: ", len(sig))
+	// fmt.Println("SIG: ", sig)
+	pubk := PrivateKeyPublicKey(priv)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !HashVerify(sig, []Message{msg}, []PublicKey{pubk}) {
+			b.Fatal("failed to verify")
+		}
+	}
+}
+
+func TestBLSUncompressedEncoding(t *testing.T) {
+	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+	sig := PrivateKeySign(priv, Message("hello uncompressed"))
+
+	uncompressedPubk, err := pubk.Uncompressed()
+	require.NoError(t, err)
+
+	decodedPubk, err := PublicKeyFromUncompressed(uncompressedPubk)
+	require.NoError(t, err)
+	assert.Equal(t, pubk, decodedPubk)
+
+	uncompressedSig, err := sig.Uncompressed()
+	require.NoError(t, err)
+
+	decodedSig, err := SignatureFromUncompressed(uncompressedSig)
+	require.NoError(t, err)
+	assert.Equal(t, *sig, decodedSig)
+
+	// the uncompressed encodings still verify once converted back
+	assert.True(t, Verify(&decodedSig, []Digest{Hash(Message("hello uncompressed"))}, []PublicKey{decodedPubk}))
+
+	t.Run("invalid uncompressed public key", func(t *testing.T) {
+		corrupted := uncompressedPubk
+		corrupted[len(corrupted)-1] ^= 0x01
+
+		_, err := PublicKeyFromUncompressed(corrupted)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid uncompressed signature", func(t *testing.T) {
+		corrupted := uncompressedSig
+		corrupted[len(corrupted)-1] ^= 0x01
+
+		_, err := SignatureFromUncompressed(corrupted)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid compressed inputs", func(t *testing.T) {
+		var badPubk PublicKey
+		badPubk[0] = 0x80
+
+		_, err := badPubk.Uncompressed()
+		assert.Error(t, err)
+
+		var badSig Signature
+		for i := range badSig {
+			badSig[i] = 0xff
+		}
+
+		_, err = badSig.Uncompressed()
+		assert.Error(t, err)
+	})
+}
+
+func TestBLSPrivateKeyGenerateWithSeedAndDomain(t *testing.T) {
+	seed := PrivateKeyGenSeed{4, 5, 6}
+
+	wallet := PrivateKeyGenerateWithSeedAndDomain(seed, "wallet")
+	assert.Equal(t, wallet, PrivateKeyGenerateWithSeedAndDomain(seed, "wallet"))
+
+	harness := PrivateKeyGenerateWithSeedAndDomain(seed, "test-harness")
+	assert.NotEqual(t, wallet, harness)
+	assert.NotEqual(t, PrivateKeyGenerateWithSeed(seed), wallet)
+
+	assert.Equal(t, PrivateKeyGenerateWithSeed(seed), PrivateKeyGenerateWithSeedAndDomain(seed, ""))
+
+	// generated keys sign
+	pubk, err := PublicKeyFromPrivateKey(wallet)
+	require.NoError(t, err)
+
+	msg := Message("seeded")
+	sig := PrivateKeySign(wallet, msg)
+	require.NotNil(t, sig)
+	assert.True(t, Verify(sig, []Digest{Hash(msg)}, []PublicKey{pubk}))
+}
+
+func TestBLSPrivateKeyGenerateFromReader(t *testing.T) {
+	first, err := PrivateKeyGenerateFromReader(rand.New(rand.NewSource(42)))
+	require.NoError(t, err)
+
+	secnd, err := PrivateKeyGenerateFromReader(rand.New(rand.NewSource(42)))
+	require.NoError(t, err)
+
+	assert.Equal(t, first, secnd)
+
+	other, err := PrivateKeyGenerateFromReader(rand.New(rand.NewSource(43)))
+	require.NoError(t, err)
+	assert.NotEqual(t, first, other)
+
+	// exactly the bytes of a seed are consumed
+	var seed PrivateKeyGenSeed
+	entropy := bytes.NewReader(append(bytes.Repeat([]byte{7}, len(seed)), 1, 2, 3))
+	fromSeedBytes, err := PrivateKeyGenerateFromReader(entropy)
+	require.NoError(t, err)
+	assert.Equal(t, 3, entropy.Len())
+
+	copy(seed[:], bytes.Repeat([]byte{7}, len(seed)))
+	assert.Equal(t, PrivateKeyGenerateWithSeed(seed), fromSeedBytes)
+
+	// the key signs and verifies
+	pubk := PrivateKeyPublicKey(first)
+	msg := Message("from reader")
+	sig := PrivateKeySign(first, msg)
+	require.NotNil(t, sig)
+	assert.True(t, HashVerify(sig, []Message{msg}, []PublicKey{pubk}))
+
+	_, err = PrivateKeyGenerateFromReader(bytes.NewReader(make([]byte, len(seed)-1)))
+	assert.True(t, errors.Is(err, io.ErrUnexpectedEOF))
+
+	_, err = PrivateKeyGenerateFromReader(bytes.NewReader(nil))
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+func TestBLSHierarchicalKeyDerivation(t *testing.T) {
+	// test vectors from EIP-2333
+	vectors := []struct {
+		seed     string
+		masterSK string
+		index    uint32
+		childSK  string
+	}{
+		{
+			seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			masterSK: "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+			index:    0,
+			childSK:  "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+		},
+		{
+			seed:     "3141592653589793238462643383279502884197169399375105820974944592",
+			masterSK: "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+			index:    3141592653,
+			childSK:  "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+		},
+		{
+			seed:     "0099FF991111002299DD7744EE3355BBDD8844115566CC55663355668888CC00",
+			masterSK: "27580842291869792442942448775674722299803720648445448686099262467207037398656",
+			index:    4294967295,
+			childSK:  "29358610794459428860402234341874281240803786294062035874021252734817515685787",
+		},
+		{
+			seed:     "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+			masterSK: "19022158461524446591288038168518313374041767046816487870552872741050760015818",
+			index:    42,
+			childSK:  "31372231650479070279774297061823572166496564838472787488249775572789064611981",
+		},
+	}
+
+	// private keys are little endian
+	toPrivateKey := func(t *testing.T, decimal string) PrivateKey {
+		n, ok := new(big.Int).SetString(decimal, 10)
+		require.True(t, ok)
+
+		be := n.Bytes()
+
+		var out PrivateKey
+		for i := range be {
+			out[i] = be[len(be)-1-i]
+		}
+		return out
+	}
+
+	for i, v := range vectors {
+		t.Run(fmt.Sprintf("vector %d", i), func(t *testing.T) {
+			seed, err := hex.DecodeString(v.seed)
+			require.NoError(t, err)
+
+			master, err := DeriveMasterKey(seed)
+			require.NoError(t, err)
+			assert.Equal(t, toPrivateKey(t, v.masterSK), master)
+
+			child, err := DeriveChildKey(master, v.index)
+			require.NoError(t, err)
+			assert.Equal(t, toPrivateKey(t, v.childSK), child)
+		})
+	}
+
+	t.Run("short seed", func(t *testing.T) {
+		_, err := DeriveMasterKey(make([]byte, MinMasterSeedBytes-1))
+		assert.True(t, errors.Is(err, ErrSeedTooShort))
+	})
+
+	t.Run("invalid parent", func(t *testing.T) {
+		var parent PrivateKey
+		for i := range parent {
+			parent[i] = 0xff
+		}
+
+		_, err := DeriveChildKey(parent, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestBLSAggregator(t *testing.T) {
+	var sigs []Signature
+	for i := 0; i < 10; i++ {
+		priv := PrivateKeyGenerate()
+		sigs = append(sigs, *PrivateKeySign(priv, Message(fmt.Sprintf("aggregated %d", i))))
+	}
+
+	t.Run("matches Aggregate", func(t *testing.T) {
+		agg := NewAggregator()
+		for _, sig := range sigs {
+			require.NoError(t, agg.Add(sig))
+		}
+
+		finalized, err := agg.Finalize()
+		require.NoError(t, err)
+		assert.Equal(t, *Aggregate(sigs), finalized)
+
+		aggregated, err := agg.Aggregate()
+		require.NoError(t, err)
+		assert.Equal(t, Aggregate(sigs), aggregated)
+		assert.Equal(t, len(sigs), agg.Count())
+	})
+
+	t.Run("single signature", func(t *testing.T) {
+		agg := NewAggregator()
+		require.NoError(t, agg.Add(sigs[0]))
+
+		finalized, err := agg.Finalize()
+		require.NoError(t, err)
+		assert.Equal(t, *Aggregate(sigs[:1]), finalized)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		agg := NewAggregator()
+		assert.Equal(t, 0, agg.Count())
+
+		_, err := agg.Finalize()
+		assert.True(t, errors.Is(err, ErrEmptyAggregator))
+
+		_, err = agg.Aggregate()
+		assert.True(t, errors.Is(err, ErrEmptyAggregator))
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		agg := NewAggregator()
+		require.NoError(t, agg.Add(sigs[0]))
+
+		var invalid Signature
+		for i := range invalid {
+			invalid[i] = 0xff
+		}
+		assert.Error(t, agg.Add(invalid))
+		assert.Equal(t, 1, agg.Count())
+
+		// the aggregate is left as it was
+		finalized, err := agg.Finalize()
+		require.NoError(t, err)
+		assert.Equal(t, sigs[0], finalized)
+	})
+}
+
+func BenchmarkBLSAggregator(b *testing.B) {
+	for _, size := range []int{10, 100, 1000} {
+		var sigs []Signature
+		for i := 0; i < size; i++ {
+			priv := PrivateKeyGenerate()
+			sigs = append(sigs, *PrivateKeySign(priv, Message(fmt.Sprintf("aggregated %d", i))))
+		}
+
+		// signatures arrive one at a time, and the aggregate of all the
+		// signatures received so far is needed after each of them
+		b.Run(fmt.Sprintf("Aggregator/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				agg := NewAggregator()
+				for _, sig := range sigs {
+					if err := agg.Add(sig); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("Aggregate/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for j := range sigs {
+					if Aggregate(sigs[:j+1]) == nil {
+						b.Fatal("failed to aggregate")
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestBLSThresholdSignatures(t *testing.T) {
+	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+
+	msg := Message("two of three")
+	digest := Hash(msg)
+	expected := PrivateKeySign(priv, msg)
+
+	keyShares, err := SplitPrivateKey(priv, 2, 3)
+	require.NoError(t, err)
+	require.Len(t, keyShares, 3)
+
+	sigShares := make(map[uint64]Signature)
+	for i, share := range keyShares {
+		sigShares[uint64(i+1)] = *PrivateKeySign(share, msg)
+	}
+
+	for _, subset := range [][]uint64{{1, 2}, {1, 3}, {2, 3}, {1, 2, 3}} {
+		t.Run(fmt.Sprintf("shares %v", subset), func(t *testing.T) {
+			shares := make(map[uint64]Signature)
+			for _, index := range subset {
+				shares[index] = sigShares[index]
+			}
+
+			recovered, err := RecoverSignature(shares, 2)
+			require.NoError(t, err)
+			assert.Equal(t, expected, recovered)
+			assert.True(t, Verify(recovered, []Digest{digest}, []PublicKey{pubk}))
+		})
+	}
+
+	t.Run("fewer than threshold", func(t *testing.T) {
+		_, err := RecoverSignature(map[uint64]Signature{1: sigShares[1]}, 2)
+		assert.Error(t, err)
+	})
+
+	t.Run("share list", func(t *testing.T) {
+		recovered, err := RecoverSignatureShares([]SignatureShare{{3, sigShares[3]}, {1, sigShares[1]}}, 2)
+		require.NoError(t, err)
+		assert.Equal(t, expected, recovered)
+	})
+
+	t.Run("duplicate index", func(t *testing.T) {
+		_, err := RecoverSignatureShares([]SignatureShare{{1, sigShares[1]}, {1, sigShares[1]}, {2, sigShares[2]}}, 2)
+		assert.EqualError(t, err, "duplicate signature share index 1")
+	})
+
+	t.Run("zero index", func(t *testing.T) {
+		_, err := RecoverSignature(map[uint64]Signature{0: sigShares[1], 2: sigShares[2]}, 2)
+		assert.EqualError(t, err, "signature share index must not be 0")
+
+		_, err = RecoverSignatureShares([]SignatureShare{{0, sigShares[1]}, {2, sigShares[2]}}, 2)
+		assert.EqualError(t, err, "signature share index must not be 0")
+	})
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		_, err := SplitPrivateKey(priv, 4, 3)
+		assert.Error(t, err)
+
+		_, err = SplitPrivateKey(priv, 0, 3)
+		assert.Error(t, err)
+	})
+}
+
+func TestBLSVerifyBatchCtx(t *testing.T) {
+	size := 3*verifyCtxChunkSize + 1
+
+	var digests []Digest
+	var pubks []PublicKey
+	var sigs []Signature
+	var requests []VerificationRequest
+	var privs []PrivateKey
+	for i := 0; i < size; i++ {
+		msg := Message(fmt.Sprintf("synced %d", i))
+		priv := PrivateKeyGenerate()
+
+		privs = append(privs, priv)
+		digests = append(digests, Hash(msg))
+		pubks = append(pubks, PrivateKeyPublicKey(priv))
+		sigs = append(sigs, *PrivateKeySign(priv, msg))
+		requests = append(requests, VerificationRequest{Message: msg, PublicKey: pubks[i], Signature: sigs[i]})
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	t.Run("valid", func(t *testing.T) {
+		isValid, err := VerifyBatchCtx(context.Background(), digests, pubks, sigs)
+		require.NoError(t, err)
+		assert.True(t, isValid)
+
+		results, err := BatchVerifySignaturesCtx(context.Background(), requests)
+		require.NoError(t, err)
+		require.Len(t, results, size)
+		for _, result := range results {
+			assert.True(t, result)
 		}
+	})
 
-		t := time.Now()
-		agsig := Aggregate(sigs)
-		fmt.Println("Aggregate took: ", time.Since(t))
+	t.Run("invalid entry in last chunk", func(t *testing.T) {
+		badSigs := append([]Signature{}, sigs...)
+		badSigs[size-1] = sigs[0]
 
-		b.ResetTimer()
-		for i := 0; i < b.N; i++ {
-			if !Verify(agsig, digests, pubks) {
-				b.Fatal("failed to verify")
-			}
+		isValid, err := VerifyBatchCtx(context.Background(), digests, pubks, badSigs)
+		require.NoError(t, err)
+		assert.False(t, isValid)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		isValid, err := VerifyBatchCtx(cancelled, digests, pubks, sigs)
+		assert.Equal(t, context.Canceled, err)
+		assert.False(t, isValid)
+
+		_, err = BatchVerifySignaturesCtx(cancelled, requests)
+		assert.Equal(t, context.Canceled, err)
+
+		aggregate := Aggregate(sigs)
+		require.NotNil(t, aggregate)
+
+		isValid, err = AggregateVerifyCtx(cancelled, pubks, digests, *aggregate)
+		assert.Equal(t, context.Canceled, err)
+		assert.False(t, isValid)
+
+		isValid, err = AggregateVerifyCtx(context.Background(), pubks, digests, *aggregate)
+		require.NoError(t, err)
+		assert.True(t, isValid)
+	})
+
+	t.Run("fast aggregate", func(t *testing.T) {
+		msg := Message("orphaned block")
+		digest := Hash(msg)
+		sameDigestSigs := make([]Signature, len(privs))
+		for idx, priv := range privs {
+			sameDigestSigs[idx] = *PrivateKeySign(priv, msg)
 		}
+		aggregate := Aggregate(sameDigestSigs)
+		require.NotNil(t, aggregate)
+
+		isValid, err := FastAggregateVerifyCtx(context.Background(), pubks, digest, *aggregate)
+		require.NoError(t, err)
+		assert.True(t, isValid)
+		assert.Equal(t, FastAggregateVerify(pubks, digest, *aggregate), isValid)
+
+		// a signer missing from the last chunk is noticed
+		isValid, err = FastAggregateVerifyCtx(context.Background(), pubks[:size-1], digest, *aggregate)
+		require.NoError(t, err)
+		assert.False(t, isValid)
+
+		isValid, err = FastAggregateVerifyCtx(cancelled, pubks, digest, *aggregate)
+		assert.Equal(t, context.Canceled, err)
+		assert.False(t, isValid)
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		_, err := VerifyBatchCtx(context.Background(), digests[1:], pubks, sigs)
+		assert.True(t, errors.Is(err, ErrBatchLengthMismatch))
+	})
+}
+
+// shortReader returns at most n bytes per read
+type shortReader struct {
+	r io.Reader
+	n int
+}
+
+func (s *shortReader) Read(p []byte) (int, error) {
+	if len(p) > s.n {
+		p = p[:s.n]
 	}
+	return s.r.Read(p)
 }
 
-func BenchmarkBLSHashAndVerify(b *testing.B) {
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("disk on fire")
+}
+
+func TestBLSSignDigest(t *testing.T) {
 	priv := PrivateKeyGenerate()
+	pubk := PrivateKeyPublicKey(priv)
+	msg := Message("blind me")
 
-	msg := Message("this is a message that i will be signing")
-	sig := PrivateKeySign(priv, msg)
+	sig, err := SignDigest(priv, Hash(msg))
+	require.NoError(t, err)
+	assert.Equal(t, *PrivateKeySign(priv, msg), sig)
 
-	// fmt.Println("SIG SIZE: ", len(sig))
-	// fmt.Println("SIG: ", sig)
+	dst := []byte("BLIND-SIGNATURE-V1")
+	digest, err := HashWithDST(msg, dst)
+	require.NoError(t, err)
+
+	sig, err = SignDigest(priv, digest)
+	require.NoError(t, err)
+
+	withDST, err := SignWithDST(priv, msg, dst)
+	require.NoError(t, err)
+	assert.Equal(t, withDST, sig)
+	assert.True(t, Verify(&sig, []Digest{digest}, []PublicKey{pubk}))
+
+	var garbage Digest
+	for i := range garbage {
+		garbage[i] = 0xff
+	}
+	_, err = SignDigest(priv, garbage)
+	assert.Error(t, err)
+
+	_, err = SignDigest(PrivateKey{}, Hash(msg))
+	assert.Error(t, err)
+}
+
+func TestBLSHashReader(t *testing.T) {
+	priv := PrivateKeyGenerate()
 	pubk := PrivateKeyPublicKey(priv)
 
+	for _, size := range []int{0, 1, 63, 64, 65, hashReaderChunkSize, 3*hashReaderChunkSize + 17} {
+		msg := make([]byte, size)
+		_, err := rand.Read(msg)
+		require.NoError(t, err)
+
+		t.Run(fmt.Sprintf("%d bytes", size), func(t *testing.T) {
+			digest, err := HashReader(bytes.NewReader(msg))
+			require.NoError(t, err)
+			assert.Equal(t, Hash(msg), digest)
+
+			digest, err = HashReader(&shortReader{r: bytes.NewReader(msg), n: 7})
+			require.NoError(t, err)
+			assert.Equal(t, Hash(msg), digest)
+
+			sig, err := SignReader(priv, bytes.NewReader(msg))
+			require.NoError(t, err)
+			assert.Equal(t, *PrivateKeySign(priv, msg), sig)
+			assert.True(t, Verify(&sig, []Digest{digest}, []PublicKey{pubk}))
+		})
+	}
+
+	t.Run("reader error", func(t *testing.T) {
+		_, err := HashReader(io.MultiReader(strings.NewReader("partial"), failingReader{}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "disk on fire")
+
+		_, err = SignReader(priv, failingReader{})
+		assert.Error(t, err)
+	})
+
+	t.Run("zeroed private key", func(t *testing.T) {
+		_, err := SignReader(PrivateKey{}, strings.NewReader("hello"))
+		assert.Error(t, err)
+	})
+}
+
+func TestBLSPrivateKeysPublicKeys(t *testing.T) {
+	var privs []PrivateKey
+	for i := 0; i < 100; i++ {
+		privs = append(privs, PrivateKeyGenerate())
+	}
+
+	pubks, err := PrivateKeysPublicKeys(privs)
+	require.NoError(t, err)
+	require.Len(t, pubks, len(privs))
+	for i, priv := range privs {
+		assert.Equal(t, PrivateKeyPublicKey(priv), pubks[i])
+	}
+
+	pubks, err = PrivateKeysPublicKeys(nil)
+	require.NoError(t, err)
+	assert.Empty(t, pubks)
+
+	t.Run("invalid private key", func(t *testing.T) {
+		invalid := append([]PrivateKey{}, privs...)
+		invalid[42].Zero()
+		invalid[57].Zero()
+
+		_, err := PrivateKeysPublicKeys(invalid)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "index 42")
+	})
+}
+
+func BenchmarkBLSPrivateKeysPublicKeys(b *testing.B) {
+	for _, size := range []int{100, 10000} {
+		privs := make([]PrivateKey, size)
+		for i := range privs {
+			privs[i] = PrivateKeyGenerate()
+		}
+
+		b.Run(fmt.Sprintf("PrivateKeysPublicKeys/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := PrivateKeysPublicKeys(privs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("PrivateKeyPublicKey/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, priv := range privs {
+					PrivateKeyPublicKey(priv)
+				}
+			}
+		})
+	}
+}
+
+func TestBLSKeyCache(t *testing.T) {
+	cache := NewKeyCache(2)
+
+	privs := []PrivateKey{PrivateKeyGenerate(), PrivateKeyGenerate(), PrivateKeyGenerate()}
+	for _, priv := range privs {
+		assert.Equal(t, PrivateKeyPublicKey(priv), cache.PublicKey(priv))
+	}
+
+	// only the two most recently used keys are kept
+	assert.Equal(t, 2, cache.Len())
+
+	assert.Equal(t, PrivateKeyPublicKey(privs[1]), cache.PublicKey(privs[1]))
+	assert.Equal(t, 2, cache.Len())
+
+	// the cache is keyed by neither the private keys nor their plain hashes
+	for key := range cache.entries {
+		for _, priv := range privs {
+			assert.NotEqual(t, sha256.Sum256(priv[:]), key)
+			assert.False(t, bytes.Contains(key[:], priv[:]))
+		}
+	}
+
+	// invalid keys are not cached
+	assert.Equal(t, PublicKey{}, cache.PublicKey(PrivateKey{}))
+	assert.Equal(t, 2, cache.Len())
+
+	cache.Purge()
+	assert.Equal(t, 0, cache.Len())
+	assert.Equal(t, PrivateKeyPublicKey(privs[0]), cache.PublicKey(privs[0]))
+
+	t.Run("concurrent use", func(t *testing.T) {
+		cache := NewKeyCache(len(privs))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					priv := privs[j%len(privs)]
+					assert.Equal(t, PrivateKeyPublicKey(priv), cache.PublicKey(priv))
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, len(privs), cache.Len())
+	})
+
+	assert.Panics(t, func() { NewKeyCache(0) })
+}
+
+func BenchmarkBLSKeyCache(b *testing.B) {
+	cache := NewKeyCache(1)
+	priv := PrivateKeyGenerate()
+
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		digest := Hash(msg)
-		if !Verify(sig, []Digest{digest}, []PublicKey{pubk}) {
-			b.Fatal("failed to verify")
+		cache.PublicKey(priv)
+	}
+}
+
+func TestBLSVerifyMultiSignature(t *testing.T) {
+	msg := Message("block attestation")
+
+	var pubks []PublicKey
+	var sigs []Signature
+	for i := 0; i < 20; i++ {
+		priv := PrivateKeyGenerate()
+		pubks = append(pubks, PrivateKeyPublicKey(priv))
+		sigs = append(sigs, *PrivateKeySign(priv, msg))
+	}
+
+	multiSig := Aggregate(sigs)
+	require.NotNil(t, multiSig)
+
+	isValid, err := VerifyMultiSignature(*multiSig, msg, pubks)
+	require.NoError(t, err)
+	assert.True(t, isValid)
+
+	isValid, err = VerifyMultiSignature(*multiSig, Message("other block"), pubks)
+	require.NoError(t, err)
+	assert.False(t, isValid)
+
+	isValid, err = VerifyMultiSignature(*multiSig, msg, pubks[:19])
+	require.NoError(t, err)
+	assert.False(t, isValid)
+
+	_, err = VerifyMultiSignature(*multiSig, msg, nil)
+	assert.Error(t, err)
+}
+
+func BenchmarkBLSVerifyMultiSignature(b *testing.B) {
+	msg := Message("block attestation")
+
+	var pubks []PublicKey
+	var sigs []Signature
+	for i := 0; i < 100; i++ {
+		priv := PrivateKeyGenerate()
+		pubks = append(pubks, PrivateKeyPublicKey(priv))
+		sigs = append(sigs, *PrivateKeySign(priv, msg))
+	}
+	multiSig := Aggregate(sigs)
+
+	b.Run("VerifyMultiSignature", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if ok, err := VerifyMultiSignature(*multiSig, msg, pubks); err != nil || !ok {
+				b.Fatal("failed to verify")
+			}
 		}
+	})
+}
+
+func TestBLSVerifyDetailed(t *testing.T) {
+	var privs []PrivateKey
+	var pubks []PublicKey
+	var digests []Digest
+	var sigs []Signature
+	for i := 0; i < 3; i++ {
+		msg := Message(fmt.Sprintf("detailed %d", i))
+		priv := PrivateKeyGenerate()
+
+		privs = append(privs, priv)
+		pubks = append(pubks, PrivateKeyPublicKey(priv))
+		digests = append(digests, Hash(msg))
+		sigs = append(sigs, *PrivateKeySign(priv, msg))
 	}
+
+	aggregate := Aggregate(sigs)
+	require.NotNil(t, aggregate)
+	require.NoError(t, VerifyDetailed(aggregate, digests, pubks))
+
+	t.Run("invalid signature encoding", func(t *testing.T) {
+		assert.True(t, errors.Is(VerifyDetailed(nil, digests, pubks), ErrInvalidSignatureEncoding))
+
+		var garbage Signature
+		for i := range garbage {
+			garbage[i] = 0xff
+		}
+		assert.True(t, errors.Is(VerifyDetailed(&garbage, digests, pubks), ErrInvalidSignatureEncoding))
+
+		var infinity Signature
+		infinity[0] = 0xc0
+		assert.True(t, errors.Is(VerifyDetailed(&infinity, digests, pubks), ErrInvalidSignatureEncoding))
+	})
+
+	t.Run("invalid public key", func(t *testing.T) {
+		invalid := append([]PublicKey{}, pubks...)
+		invalid[1] = PublicKey{}
+
+		err := VerifyDetailed(aggregate, digests, invalid)
+		assert.True(t, errors.Is(err, ErrInvalidPublicKey))
+		assert.Contains(t, err.Error(), "public key 1")
+
+		// (0, 2) is on the curve, but has order 3
+		var torsion PublicKey
+		torsion[0] = 0x80
+		invalid[1] = torsion
+		assert.True(t, errors.Is(VerifyDetailed(aggregate, digests, invalid), ErrInvalidPublicKey))
+	})
+
+	t.Run("verification failed", func(t *testing.T) {
+		swapped := []PublicKey{pubks[1], pubks[0], pubks[2]}
+		assert.True(t, errors.Is(VerifyDetailed(aggregate, digests, swapped), ErrVerificationFailed))
+
+		assert.True(t, errors.Is(VerifyDetailed(aggregate, digests[:2], pubks), ErrVerificationFailed))
+
+		assert.True(t, errors.Is(VerifyDetailed(&sigs[0], digests, pubks), ErrVerificationFailed))
+	})
+
+	t.Run("bool API is unchanged", func(t *testing.T) {
+		assert.True(t, Verify(aggregate, digests, pubks))
+		assert.False(t, Verify(&sigs[0], digests, pubks))
+	})
 }
 
-func BenchmarkBLSHashVerify(b *testing.B) {
+func TestBLSHashMessage(t *testing.T) {
+	msg := Message("pre-hashed for an HSM")
+
+	digest, err := HashMessage(msg, "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+	require.NoError(t, err)
+	assert.Equal(t, Hash(msg), digest)
+
+	custom, err := HashMessage(msg, "MY-PROTOCOL-V1")
+	require.NoError(t, err)
+	assert.NotEqual(t, digest, custom)
+
+	fromBytes, err := HashWithDST(msg, []byte("MY-PROTOCOL-V1"))
+	require.NoError(t, err)
+	assert.Equal(t, custom, fromBytes)
+
+	_, err = HashMessage(msg, "")
+	assert.True(t, errors.Is(err, ErrEmptyDST))
+
+	_, err = HashMessage(msg, strings.Repeat("x", MaxDSTBytes+1))
+	assert.Error(t, err)
+}
+
+func TestBLSSignMany(t *testing.T) {
 	priv := PrivateKeyGenerate()
 
-	msg := Message("this is a message that i will be signing")
-	sig := PrivateKeySign(priv, msg)
+	var messages []Message
+	for i := 0; i < 100; i++ {
+		messages = append(messages, Message(fmt.Sprintf("message %d", i)))
+	}
 
-	// fmt.Println("SIG SIZE: ", len(sig))
-	// fmt.Println("SIG: ", sig)
-	pubk := PrivateKeyPublicKey(priv)
+	for _, workers := range []int{-1, 0, 1, 7} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			sigs, err := SignMany(priv, messages, workers)
+			require.NoError(t, err)
+			require.Len(t, sigs, len(messages))
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		if !HashVerify(sig, []Message{msg}, []PublicKey{pubk}) {
-			b.Fatal("failed to verify")
+			for i, msg := range messages {
+				assert.Equal(t, *PrivateKeySign(priv, msg), sigs[i])
+			}
+		})
+	}
+
+	sigs, err := SignMany(priv, nil, 4)
+	require.NoError(t, err)
+	assert.Empty(t, sigs)
+
+	t.Run("failed signing", func(t *testing.T) {
+		_, err := SignMany(PrivateKey{}, messages[:1], 4)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "message 0")
+	})
+}
+
+func BenchmarkBLSSignMany(b *testing.B) {
+	priv := PrivateKeyGenerate()
+
+	var messages []Message
+	for i := 0; i < 1000; i++ {
+		messages = append(messages, Message(fmt.Sprintf("message %d", i)))
+	}
+
+	b.Run("SignMany", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := SignMany(priv, messages, 0); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("PrivateKeySign", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, msg := range messages {
+				PrivateKeySign(priv, msg)
+			}
 		}
+	})
+}
+
+func TestBLSVerifyBatchWhich(t *testing.T) {
+	size := 37
+
+	var digests []Digest
+	var pubks []PublicKey
+	var sigs []Signature
+	for i := 0; i < size; i++ {
+		msg := Message(fmt.Sprintf("which %d", i))
+		priv := PrivateKeyGenerate()
+
+		digests = append(digests, Hash(msg))
+		pubks = append(pubks, PrivateKeyPublicKey(priv))
+		sigs = append(sigs, *PrivateKeySign(priv, msg))
+	}
+
+	results, err := VerifyBatchWhich(digests, pubks, sigs)
+	require.NoError(t, err)
+	require.Len(t, results, size)
+	for _, result := range results {
+		assert.True(t, result)
 	}
+
+	invalid := map[int]bool{0: true, 5: true, 6: true, 36: true}
+
+	badSigs := append([]Signature{}, sigs...)
+	for idx := range invalid {
+		badSigs[idx] = sigs[(idx+1)%size]
+	}
+
+	// a malformed signature is reported as invalid as well
+	for i := range badSigs[20] {
+		badSigs[20][i] = 0xff
+	}
+	invalid[20] = true
+
+	results, err = VerifyBatchWhich(digests, pubks, badSigs)
+	require.NoError(t, err)
+	require.Len(t, results, size)
+	for idx, result := range results {
+		assert.Equal(t, !invalid[idx], result, "entry %d", idx)
+	}
+
+	_, err = VerifyBatchWhich(digests[1:], pubks, sigs)
+	assert.True(t, errors.Is(err, ErrBatchLengthMismatch))
+
+	results, err = VerifyBatchWhich(nil, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestBLSSelfTest(t *testing.T) {
+	require.NoError(t, SelfTestBLS())
+}
+
+func TestBLSMinSig(t *testing.T) {
+	var privs []PrivateKey
+	var pubks []PublicKeyG2
+	var msgs []Message
+	var sigs []SignatureG1
+	for i := 0; i < 3; i++ {
+		priv := PrivateKeyGenerate()
+		pubk, err := PublicKeyG2FromPrivateKey(priv)
+		require.NoError(t, err)
+
+		msg := Message(fmt.Sprintf("min-sig message %d", i))
+		sig, err := SignG1(priv, msg)
+		require.NoError(t, err)
+
+		assert.True(t, VerifyG1(sig, []Message{msg}, []PublicKeyG2{pubk}))
+		assert.False(t, VerifyG1(sig, []Message{Message("other")}, []PublicKeyG2{pubk}))
+
+		privs = append(privs, priv)
+		pubks = append(pubks, pubk)
+		msgs = append(msgs, msg)
+		sigs = append(sigs, sig)
+	}
+
+	// signing is deterministic
+	again, err := SignG1(privs[0], msgs[0])
+	require.NoError(t, err)
+	assert.Equal(t, sigs[0], again)
+
+	aggregate, err := AggregateG1(sigs)
+	require.NoError(t, err)
+	assert.True(t, VerifyG1(aggregate, msgs, pubks))
+	assert.False(t, VerifyG1(aggregate, msgs[1:], pubks[1:]))
+	assert.False(t, VerifyG1(aggregate, msgs, pubks[:2]))
+
+	// messages must be distinct
+	assert.False(t, VerifyG1(sigs[0], []Message{msgs[0], msgs[0]}, []PublicKeyG2{pubks[0], pubks[0]}))
+
+	_, err = AggregateG1(nil)
+	assert.Error(t, err)
+
+	var garbage SignatureG1
+	for i := range garbage {
+		garbage[i] = 0xff
+	}
+	_, err = AggregateG1([]SignatureG1{sigs[0], garbage})
+	assert.Error(t, err)
+
+	_, err = SignG1(PrivateKey{}, msgs[0])
+	assert.Error(t, err)
+
+	_, err = PublicKeyG2FromPrivateKey(PrivateKey{})
+	assert.Error(t, err)
+
+	t.Run("variants do not verify each other", func(t *testing.T) {
+		priv := privs[0]
+		msg := msgs[0]
+
+		// a min-pk public key is a point of G1, but not a min-sig signature
+		var pubkAsSig SignatureG1
+		pubk := PrivateKeyPublicKey(priv)
+		copy(pubkAsSig[:], pubk[:])
+		assert.False(t, VerifyG1(pubkAsSig, []Message{msg}, []PublicKeyG2{pubks[0]}))
+
+		// a min-sig public key is a point of G2, but not a min-pk signature
+		var pubkG2AsSig Signature
+		copy(pubkG2AsSig[:], pubks[0][:])
+		assert.False(t, HashVerify(&pubkG2AsSig, []Message{msg}, []PublicKey{pubk}))
+
+		// the same key signs the same message differently in each variant
+		sig := PrivateKeySign(priv, msg)
+		require.NotNil(t, sig)
+		assert.NotEqual(t, sigs[0][:], sig[:SignatureG1Bytes])
+	})
 }
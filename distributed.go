@@ -3,6 +3,10 @@
 package ffi
 
 import (
+	"context"
+	"sort"
+	"sync"
+
 	"github.com/filecoin-project/filecoin-ffi/generated"
 	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/specs-actors/v5/actors/runtime/proof"
@@ -265,3 +269,174 @@ func MergeWindowPoStPartitionProofs(
 
 	return &out, nil
 }
+
+// GenerateWindowPoStParallel generates the same Window PoSt as
+// GenerateWindowPoSt, spreading the work over up to workers goroutines. Window
+// PoSt challenges depend on the partition a sector falls in, so the sectors
+// cannot be proven in independent calls to GenerateWindowPoSt. Instead, the
+// vanilla proofs of the sectors and then the snark proofs of the partitions
+// are generated in parallel, and the partition proofs merged. ctx is checked
+// before each sector and partition is handed to a worker. If the vanilla proof
+// of any sector cannot be generated, the sectors which failed across all
+// workers are returned along with an error. The sectors are assigned to
+// partitions in sector number order, as the verifier does, whatever the key of
+// privateSectors.
+func GenerateWindowPoStParallel(
+	ctx context.Context,
+	minerID abi.ActorID,
+	privateSectors SortedPrivateSectorInfo,
+	randomness abi.PoStRandomness,
+	workers int,
+) ([]proof.PoStProof, []abi.SectorNumber, error) {
+	if workers < 1 {
+		return nil, nil, errors.Errorf("workers must be at least 1, got %d", workers)
+	}
+
+	privateSectors = privateSectors.bySectorNumber()
+	sectors := privateSectors.Values()
+	if len(sectors) == 0 {
+		return nil, nil, errors.New("no sectors to prove")
+	}
+
+	proofType := sectors[0].PoStProofType
+	sectorNumbers := make([]abi.SectorNumber, len(sectors))
+	for i, sector := range sectors {
+		if sector.PoStProofType != proofType {
+			return nil, nil, errors.Errorf("sector %d has proof type %d, expected %d", sector.SectorNumber, sector.PoStProofType, proofType)
+		}
+		sectorNumbers[i] = sector.SectorNumber
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	challenges, err := GeneratePoStFallbackSectorChallenges(proofType, minerID, randomness, sectorNumbers)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate sector challenges")
+	}
+
+	var lk sync.Mutex
+	var faultySectors []abi.SectorNumber
+	vanillaProofs := make([][]byte, len(sectors))
+	err = forEachParallel(ctx, workers, len(sectors), func(i int) {
+		vanillaProof, err := GenerateSingleVanillaProof(sectors[i], challenges.Challenges[sectors[i].SectorNumber])
+
+		lk.Lock()
+		defer lk.Unlock()
+
+		if err != nil {
+			faultySectors = append(faultySectors, sectors[i].SectorNumber)
+			return
+		}
+		vanillaProofs[i] = vanillaProof
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(faultySectors) > 0 {
+		sort.Slice(faultySectors, func(i, j int) bool {
+			return faultySectors[i] < faultySectors[j]
+		})
+		return nil, faultySectors, errors.Errorf("failed to generate vanilla proofs for %d sectors", len(faultySectors))
+	}
+
+	partitionSectors, err := windowPoStPartitionSectors(proofType, uint(len(sectors)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	numPartitions := (len(sectors) + partitionSectors - 1) / partitionSectors
+	partitionProofs := make([]PartitionProof, numPartitions)
+	partitionErrs := make([]error, numPartitions)
+	err = forEachParallel(ctx, workers, numPartitions, func(i int) {
+		end := (i + 1) * partitionSectors
+		if end > len(vanillaProofs) {
+			end = len(vanillaProofs)
+		}
+
+		partitionProof, err := GenerateSinglePartitionWindowPoStWithVanilla(proofType, minerID, randomness, vanillaProofs[i*partitionSectors:end], uint(i))
+		if err != nil {
+			partitionErrs[i] = errors.Wrapf(err, "failed to generate proof of partition %d", i)
+			return
+		}
+		partitionProofs[i] = *partitionProof
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, err := range partitionErrs {
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	merged, err := MergeWindowPoStPartitionProofs(proofType, partitionProofs)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to merge partition proofs")
+	}
+
+	return []proof.PoStProof{*merged}, nil, nil
+}
+
+// windowPoStPartitionSectors returns the number of sectors in a Window PoSt
+// partition, capped at numSectors.
+func windowPoStPartitionSectors(proofType abi.RegisteredPoStProof, numSectors uint) (int, error) {
+	metadata, err := PoStProofMetadata(proofType)
+	if err != nil {
+		return 0, err
+	}
+
+	if uint64(numSectors) < metadata.MaxPartitionSectors {
+		return int(numSectors), nil
+	}
+	return int(metadata.MaxPartitionSectors), nil
+}
+
+// forEachParallel calls fn with each index in [0, n) on up to workers
+// goroutines. It stops handing out indices once ctx is done, and returns
+// ctx.Err() after the calls already in progress have returned.
+func forEachParallel(ctx context.Context, workers, n int, fn func(i int)) error {
+	if workers > n {
+		workers = n
+	}
+
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				// an index may have been handed out just as ctx was done
+				if ctx.Err() != nil {
+					continue
+				}
+				fn(i)
+			}
+		}()
+	}
+
+	var err error
+dispatch:
+	for i := 0; i < n; i++ {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			break dispatch
+		case indices <- i:
+		}
+	}
+
+	close(indices)
+	wg.Wait()
+
+	return err
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	gen "github.com/whyrusleeping/cbor-gen"
+
+	ffi "github.com/filecoin-project/filecoin-ffi"
+)
+
+func main() {
+	err := gen.WriteTupleEncodersToFile("./cbor_gen.go", "ffi",
+		ffi.PublicSectorInfo{},
+		ffi.PrivateSectorInfo{},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -230,6 +230,330 @@ func (x *FilAggregateResponse) Deref() {
 	x.Signature = *NewFilBLSSignatureRef(unsafe.Pointer(&x.refb3efa36d.signature))
 }
 
+// allocFilBLSSignatureG1Memory allocates memory for type C.fil_BLSSignatureG1 in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilBLSSignatureG1Memory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilBLSSignatureG1Value))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilBLSSignatureG1Value = unsafe.Sizeof([1]C.fil_BLSSignatureG1{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilBLSSignatureG1) Ref() *C.fil_BLSSignatureG1 {
+	if x == nil {
+		return nil
+	}
+	return x.refc8c9973d
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilBLSSignatureG1) Free() {
+	if x != nil && x.allocsc8c9973d != nil {
+		x.allocsc8c9973d.(*cgoAllocMap).Free()
+		x.refc8c9973d = nil
+	}
+}
+
+// NewFilBLSSignatureG1Ref creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilBLSSignatureG1Ref(ref unsafe.Pointer) *FilBLSSignatureG1 {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilBLSSignatureG1)
+	obj.refc8c9973d = (*C.fil_BLSSignatureG1)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilBLSSignatureG1) PassRef() (*C.fil_BLSSignatureG1, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.refc8c9973d != nil {
+		return x.refc8c9973d, nil
+	}
+	memc8c9973d := allocFilBLSSignatureG1Memory(1)
+	refc8c9973d := (*C.fil_BLSSignatureG1)(memc8c9973d)
+	allocsc8c9973d := new(cgoAllocMap)
+	allocsc8c9973d.Add(memc8c9973d)
+
+	var cinner_allocs *cgoAllocMap
+	refc8c9973d.inner, cinner_allocs = *(*[48]C.uint8_t)(unsafe.Pointer(&x.Inner)), cgoAllocsUnknown
+	allocsc8c9973d.Borrow(cinner_allocs)
+
+	x.refc8c9973d = refc8c9973d
+	x.allocsc8c9973d = allocsc8c9973d
+	return refc8c9973d, allocsc8c9973d
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilBLSSignatureG1) PassValue() (C.fil_BLSSignatureG1, *cgoAllocMap) {
+	if x.refc8c9973d != nil {
+		return *x.refc8c9973d, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilBLSSignatureG1) Deref() {
+	if x.refc8c9973d == nil {
+		return
+	}
+	x.Inner = *(*[48]byte)(unsafe.Pointer(&x.refc8c9973d.inner))
+}
+
+// allocFilSignatureG1ResponseMemory allocates memory for type C.fil_SignatureG1Response in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilSignatureG1ResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilSignatureG1ResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilSignatureG1ResponseValue = unsafe.Sizeof([1]C.fil_SignatureG1Response{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilSignatureG1Response) Ref() *C.fil_SignatureG1Response {
+	if x == nil {
+		return nil
+	}
+	return x.ref803049a4
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilSignatureG1Response) Free() {
+	if x != nil && x.allocs803049a4 != nil {
+		x.allocs803049a4.(*cgoAllocMap).Free()
+		x.ref803049a4 = nil
+	}
+}
+
+// NewFilSignatureG1ResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilSignatureG1ResponseRef(ref unsafe.Pointer) *FilSignatureG1Response {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilSignatureG1Response)
+	obj.ref803049a4 = (*C.fil_SignatureG1Response)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilSignatureG1Response) PassRef() (*C.fil_SignatureG1Response, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref803049a4 != nil {
+		return x.ref803049a4, nil
+	}
+	mem803049a4 := allocFilSignatureG1ResponseMemory(1)
+	ref803049a4 := (*C.fil_SignatureG1Response)(mem803049a4)
+	allocs803049a4 := new(cgoAllocMap)
+	allocs803049a4.Add(mem803049a4)
+
+	var csignature_allocs *cgoAllocMap
+	ref803049a4.signature, csignature_allocs = x.Signature.PassValue()
+	allocs803049a4.Borrow(csignature_allocs)
+
+	x.ref803049a4 = ref803049a4
+	x.allocs803049a4 = allocs803049a4
+	return ref803049a4, allocs803049a4
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilSignatureG1Response) PassValue() (C.fil_SignatureG1Response, *cgoAllocMap) {
+	if x.ref803049a4 != nil {
+		return *x.ref803049a4, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilSignatureG1Response) Deref() {
+	if x.ref803049a4 == nil {
+		return
+	}
+	x.Signature = *NewFilBLSSignatureG1Ref(unsafe.Pointer(&x.ref803049a4.signature))
+}
+
+// allocFilBLSPublicKeyMemory allocates memory for type C.fil_BLSPublicKey in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilBLSPublicKeyMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilBLSPublicKeyValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilBLSPublicKeyValue = unsafe.Sizeof([1]C.fil_BLSPublicKey{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilBLSPublicKey) Ref() *C.fil_BLSPublicKey {
+	if x == nil {
+		return nil
+	}
+	return x.ref6d0cab13
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilBLSPublicKey) Free() {
+	if x != nil && x.allocs6d0cab13 != nil {
+		x.allocs6d0cab13.(*cgoAllocMap).Free()
+		x.ref6d0cab13 = nil
+	}
+}
+
+// NewFilBLSPublicKeyRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilBLSPublicKeyRef(ref unsafe.Pointer) *FilBLSPublicKey {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilBLSPublicKey)
+	obj.ref6d0cab13 = (*C.fil_BLSPublicKey)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilBLSPublicKey) PassRef() (*C.fil_BLSPublicKey, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref6d0cab13 != nil {
+		return x.ref6d0cab13, nil
+	}
+	mem6d0cab13 := allocFilBLSPublicKeyMemory(1)
+	ref6d0cab13 := (*C.fil_BLSPublicKey)(mem6d0cab13)
+	allocs6d0cab13 := new(cgoAllocMap)
+	allocs6d0cab13.Add(mem6d0cab13)
+
+	var cinner_allocs *cgoAllocMap
+	ref6d0cab13.inner, cinner_allocs = *(*[48]C.uint8_t)(unsafe.Pointer(&x.Inner)), cgoAllocsUnknown
+	allocs6d0cab13.Borrow(cinner_allocs)
+
+	x.ref6d0cab13 = ref6d0cab13
+	x.allocs6d0cab13 = allocs6d0cab13
+	return ref6d0cab13, allocs6d0cab13
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilBLSPublicKey) PassValue() (C.fil_BLSPublicKey, *cgoAllocMap) {
+	if x.ref6d0cab13 != nil {
+		return *x.ref6d0cab13, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilBLSPublicKey) Deref() {
+	if x.ref6d0cab13 == nil {
+		return
+	}
+	x.Inner = *(*[48]byte)(unsafe.Pointer(&x.ref6d0cab13.inner))
+}
+
+// allocFilAggregatePublicKeysResponseMemory allocates memory for type C.fil_AggregatePublicKeysResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilAggregatePublicKeysResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilAggregatePublicKeysResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilAggregatePublicKeysResponseValue = unsafe.Sizeof([1]C.fil_AggregatePublicKeysResponse{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilAggregatePublicKeysResponse) Ref() *C.fil_AggregatePublicKeysResponse {
+	if x == nil {
+		return nil
+	}
+	return x.refc61cdc81
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilAggregatePublicKeysResponse) Free() {
+	if x != nil && x.allocsc61cdc81 != nil {
+		x.allocsc61cdc81.(*cgoAllocMap).Free()
+		x.refc61cdc81 = nil
+	}
+}
+
+// NewFilAggregatePublicKeysResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilAggregatePublicKeysResponseRef(ref unsafe.Pointer) *FilAggregatePublicKeysResponse {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilAggregatePublicKeysResponse)
+	obj.refc61cdc81 = (*C.fil_AggregatePublicKeysResponse)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilAggregatePublicKeysResponse) PassRef() (*C.fil_AggregatePublicKeysResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.refc61cdc81 != nil {
+		return x.refc61cdc81, nil
+	}
+	memc61cdc81 := allocFilAggregatePublicKeysResponseMemory(1)
+	refc61cdc81 := (*C.fil_AggregatePublicKeysResponse)(memc61cdc81)
+	allocsc61cdc81 := new(cgoAllocMap)
+	allocsc61cdc81.Add(memc61cdc81)
+
+	var cpublic_key_allocs *cgoAllocMap
+	refc61cdc81.public_key, cpublic_key_allocs = x.PublicKey.PassValue()
+	allocsc61cdc81.Borrow(cpublic_key_allocs)
+
+	x.refc61cdc81 = refc61cdc81
+	x.allocsc61cdc81 = allocsc61cdc81
+	return refc61cdc81, allocsc61cdc81
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilAggregatePublicKeysResponse) PassValue() (C.fil_AggregatePublicKeysResponse, *cgoAllocMap) {
+	if x.refc61cdc81 != nil {
+		return *x.refc61cdc81, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilAggregatePublicKeysResponse) Deref() {
+	if x.refc61cdc81 == nil {
+		return
+	}
+	x.PublicKey = *NewFilBLSPublicKeyRef(unsafe.Pointer(&x.refc61cdc81.public_key))
+}
+
 // allocFilAggregateProofMemory allocates memory for type C.fil_AggregateProof in C.
 // The caller is responsible for freeing the this memory via C.free.
 func allocFilAggregateProofMemory(n int) unsafe.Pointer {
@@ -750,77 +1074,73 @@ func (x *FilSealCommitPhase2Response) Deref() {
 	x.CommitInputsLen = (uint)(x.ref5860b9a4.commit_inputs_len)
 }
 
-// allocFilClearCacheResponseMemory allocates memory for type C.fil_ClearCacheResponse in C.
+// allocFilBLSPrivateKeyMemory allocates memory for type C.fil_BLSPrivateKey in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilClearCacheResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilClearCacheResponseValue))
+func allocFilBLSPrivateKeyMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilBLSPrivateKeyValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilClearCacheResponseValue = unsafe.Sizeof([1]C.fil_ClearCacheResponse{})
+const sizeOfFilBLSPrivateKeyValue = unsafe.Sizeof([1]C.fil_BLSPrivateKey{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilClearCacheResponse) Ref() *C.fil_ClearCacheResponse {
+func (x *FilBLSPrivateKey) Ref() *C.fil_BLSPrivateKey {
 	if x == nil {
 		return nil
 	}
-	return x.refa9a80400
+	return x.ref2f77fe3a
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilClearCacheResponse) Free() {
-	if x != nil && x.allocsa9a80400 != nil {
-		x.allocsa9a80400.(*cgoAllocMap).Free()
-		x.refa9a80400 = nil
+func (x *FilBLSPrivateKey) Free() {
+	if x != nil && x.allocs2f77fe3a != nil {
+		x.allocs2f77fe3a.(*cgoAllocMap).Free()
+		x.ref2f77fe3a = nil
 	}
 }
 
-// NewFilClearCacheResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilBLSPrivateKeyRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilClearCacheResponseRef(ref unsafe.Pointer) *FilClearCacheResponse {
+func NewFilBLSPrivateKeyRef(ref unsafe.Pointer) *FilBLSPrivateKey {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilClearCacheResponse)
-	obj.refa9a80400 = (*C.fil_ClearCacheResponse)(unsafe.Pointer(ref))
+	obj := new(FilBLSPrivateKey)
+	obj.ref2f77fe3a = (*C.fil_BLSPrivateKey)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilClearCacheResponse) PassRef() (*C.fil_ClearCacheResponse, *cgoAllocMap) {
+func (x *FilBLSPrivateKey) PassRef() (*C.fil_BLSPrivateKey, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.refa9a80400 != nil {
-		return x.refa9a80400, nil
+	} else if x.ref2f77fe3a != nil {
+		return x.ref2f77fe3a, nil
 	}
-	mema9a80400 := allocFilClearCacheResponseMemory(1)
-	refa9a80400 := (*C.fil_ClearCacheResponse)(mema9a80400)
-	allocsa9a80400 := new(cgoAllocMap)
-	allocsa9a80400.Add(mema9a80400)
-
-	var cerror_msg_allocs *cgoAllocMap
-	refa9a80400.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocsa9a80400.Borrow(cerror_msg_allocs)
+	mem2f77fe3a := allocFilBLSPrivateKeyMemory(1)
+	ref2f77fe3a := (*C.fil_BLSPrivateKey)(mem2f77fe3a)
+	allocs2f77fe3a := new(cgoAllocMap)
+	allocs2f77fe3a.Add(mem2f77fe3a)
 
-	var cstatus_code_allocs *cgoAllocMap
-	refa9a80400.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocsa9a80400.Borrow(cstatus_code_allocs)
+	var cinner_allocs *cgoAllocMap
+	ref2f77fe3a.inner, cinner_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.Inner)), cgoAllocsUnknown
+	allocs2f77fe3a.Borrow(cinner_allocs)
 
-	x.refa9a80400 = refa9a80400
-	x.allocsa9a80400 = allocsa9a80400
-	return refa9a80400, allocsa9a80400
+	x.ref2f77fe3a = ref2f77fe3a
+	x.allocs2f77fe3a = allocs2f77fe3a
+	return ref2f77fe3a, allocs2f77fe3a
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilClearCacheResponse) PassValue() (C.fil_ClearCacheResponse, *cgoAllocMap) {
-	if x.refa9a80400 != nil {
-		return *x.refa9a80400, nil
+func (x FilBLSPrivateKey) PassValue() (C.fil_BLSPrivateKey, *cgoAllocMap) {
+	if x.ref2f77fe3a != nil {
+		return *x.ref2f77fe3a, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -828,81 +1148,80 @@ func (x FilClearCacheResponse) PassValue() (C.fil_ClearCacheResponse, *cgoAllocM
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilClearCacheResponse) Deref() {
-	if x.refa9a80400 == nil {
+func (x *FilBLSPrivateKey) Deref() {
+	if x.ref2f77fe3a == nil {
 		return
 	}
-	x.ErrorMsg = packPCharString(x.refa9a80400.error_msg)
-	x.StatusCode = (FCPResponseStatus)(x.refa9a80400.status_code)
+	x.Inner = *(*[32]byte)(unsafe.Pointer(&x.ref2f77fe3a.inner))
 }
 
-// allocFilZeroSignatureResponseMemory allocates memory for type C.fil_ZeroSignatureResponse in C.
+// allocFilBLSSignerMemory allocates memory for type C.fil_BLSSigner in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilZeroSignatureResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilZeroSignatureResponseValue))
+func allocFilBLSSignerMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilBLSSignerValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilZeroSignatureResponseValue = unsafe.Sizeof([1]C.fil_ZeroSignatureResponse{})
+const sizeOfFilBLSSignerValue = unsafe.Sizeof([1]C.fil_BLSSigner{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilZeroSignatureResponse) Ref() *C.fil_ZeroSignatureResponse {
+func (x *FilBLSSigner) Ref() *C.fil_BLSSigner {
 	if x == nil {
 		return nil
 	}
-	return x.ref835a0405
+	return x.refc6220aac
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilZeroSignatureResponse) Free() {
-	if x != nil && x.allocs835a0405 != nil {
-		x.allocs835a0405.(*cgoAllocMap).Free()
-		x.ref835a0405 = nil
+func (x *FilBLSSigner) Free() {
+	if x != nil && x.allocsc6220aac != nil {
+		x.allocsc6220aac.(*cgoAllocMap).Free()
+		x.refc6220aac = nil
 	}
 }
 
-// NewFilZeroSignatureResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilBLSSignerRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilZeroSignatureResponseRef(ref unsafe.Pointer) *FilZeroSignatureResponse {
+func NewFilBLSSignerRef(ref unsafe.Pointer) *FilBLSSigner {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilZeroSignatureResponse)
-	obj.ref835a0405 = (*C.fil_ZeroSignatureResponse)(unsafe.Pointer(ref))
+	obj := new(FilBLSSigner)
+	obj.refc6220aac = (*C.fil_BLSSigner)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilZeroSignatureResponse) PassRef() (*C.fil_ZeroSignatureResponse, *cgoAllocMap) {
+func (x *FilBLSSigner) PassRef() (*C.fil_BLSSigner, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref835a0405 != nil {
-		return x.ref835a0405, nil
+	} else if x.refc6220aac != nil {
+		return x.refc6220aac, nil
 	}
-	mem835a0405 := allocFilZeroSignatureResponseMemory(1)
-	ref835a0405 := (*C.fil_ZeroSignatureResponse)(mem835a0405)
-	allocs835a0405 := new(cgoAllocMap)
-	allocs835a0405.Add(mem835a0405)
+	memc6220aac := allocFilBLSSignerMemory(1)
+	refc6220aac := (*C.fil_BLSSigner)(memc6220aac)
+	allocsc6220aac := new(cgoAllocMap)
+	allocsc6220aac.Add(memc6220aac)
 
-	var csignature_allocs *cgoAllocMap
-	ref835a0405.signature, csignature_allocs = x.Signature.PassValue()
-	allocs835a0405.Borrow(csignature_allocs)
+	var cprivate_key_allocs *cgoAllocMap
+	refc6220aac.private_key, cprivate_key_allocs = x.PrivateKey.PassValue()
+	allocsc6220aac.Borrow(cprivate_key_allocs)
 
-	x.ref835a0405 = ref835a0405
-	x.allocs835a0405 = allocs835a0405
-	return ref835a0405, allocs835a0405
+	x.refc6220aac = refc6220aac
+	x.allocsc6220aac = allocsc6220aac
+	return refc6220aac, allocsc6220aac
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilZeroSignatureResponse) PassValue() (C.fil_ZeroSignatureResponse, *cgoAllocMap) {
-	if x.ref835a0405 != nil {
-		return *x.ref835a0405, nil
+func (x FilBLSSigner) PassValue() (C.fil_BLSSigner, *cgoAllocMap) {
+	if x.refc6220aac != nil {
+		return *x.refc6220aac, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -910,84 +1229,80 @@ func (x FilZeroSignatureResponse) PassValue() (C.fil_ZeroSignatureResponse, *cgo
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilZeroSignatureResponse) Deref() {
-	if x.ref835a0405 == nil {
+func (x *FilBLSSigner) Deref() {
+	if x.refc6220aac == nil {
 		return
 	}
-	x.Signature = *NewFilBLSSignatureRef(unsafe.Pointer(&x.ref835a0405.signature))
+	x.PrivateKey = *NewFilBLSPrivateKeyRef(unsafe.Pointer(&x.refc6220aac.private_key))
 }
 
-// allocFilEmptySectorUpdateDecodeFromResponseMemory allocates memory for type C.fil_EmptySectorUpdateDecodeFromResponse in C.
+// allocFilPrivateKeyPublicKeyResponseMemory allocates memory for type C.fil_PrivateKeyPublicKeyResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilEmptySectorUpdateDecodeFromResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilEmptySectorUpdateDecodeFromResponseValue))
+func allocFilPrivateKeyPublicKeyResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPrivateKeyPublicKeyResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilEmptySectorUpdateDecodeFromResponseValue = unsafe.Sizeof([1]C.fil_EmptySectorUpdateDecodeFromResponse{})
+const sizeOfFilPrivateKeyPublicKeyResponseValue = unsafe.Sizeof([1]C.fil_PrivateKeyPublicKeyResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilEmptySectorUpdateDecodeFromResponse) Ref() *C.fil_EmptySectorUpdateDecodeFromResponse {
+func (x *FilPrivateKeyPublicKeyResponse) Ref() *C.fil_PrivateKeyPublicKeyResponse {
 	if x == nil {
 		return nil
 	}
-	return x.reff02a01b8
+	return x.refee14e59d
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilEmptySectorUpdateDecodeFromResponse) Free() {
-	if x != nil && x.allocsf02a01b8 != nil {
-		x.allocsf02a01b8.(*cgoAllocMap).Free()
-		x.reff02a01b8 = nil
+func (x *FilPrivateKeyPublicKeyResponse) Free() {
+	if x != nil && x.allocsee14e59d != nil {
+		x.allocsee14e59d.(*cgoAllocMap).Free()
+		x.refee14e59d = nil
 	}
 }
 
-// NewFilEmptySectorUpdateDecodeFromResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilPrivateKeyPublicKeyResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilEmptySectorUpdateDecodeFromResponseRef(ref unsafe.Pointer) *FilEmptySectorUpdateDecodeFromResponse {
+func NewFilPrivateKeyPublicKeyResponseRef(ref unsafe.Pointer) *FilPrivateKeyPublicKeyResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilEmptySectorUpdateDecodeFromResponse)
-	obj.reff02a01b8 = (*C.fil_EmptySectorUpdateDecodeFromResponse)(unsafe.Pointer(ref))
+	obj := new(FilPrivateKeyPublicKeyResponse)
+	obj.refee14e59d = (*C.fil_PrivateKeyPublicKeyResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilEmptySectorUpdateDecodeFromResponse) PassRef() (*C.fil_EmptySectorUpdateDecodeFromResponse, *cgoAllocMap) {
+func (x *FilPrivateKeyPublicKeyResponse) PassRef() (*C.fil_PrivateKeyPublicKeyResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.reff02a01b8 != nil {
-		return x.reff02a01b8, nil
+	} else if x.refee14e59d != nil {
+		return x.refee14e59d, nil
 	}
-	memf02a01b8 := allocFilEmptySectorUpdateDecodeFromResponseMemory(1)
-	reff02a01b8 := (*C.fil_EmptySectorUpdateDecodeFromResponse)(memf02a01b8)
-	allocsf02a01b8 := new(cgoAllocMap)
-	allocsf02a01b8.Add(memf02a01b8)
-
-	var cstatus_code_allocs *cgoAllocMap
-	reff02a01b8.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocsf02a01b8.Borrow(cstatus_code_allocs)
+	memee14e59d := allocFilPrivateKeyPublicKeyResponseMemory(1)
+	refee14e59d := (*C.fil_PrivateKeyPublicKeyResponse)(memee14e59d)
+	allocsee14e59d := new(cgoAllocMap)
+	allocsee14e59d.Add(memee14e59d)
 
-	var cerror_msg_allocs *cgoAllocMap
-	reff02a01b8.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocsf02a01b8.Borrow(cerror_msg_allocs)
+	var cpublic_key_allocs *cgoAllocMap
+	refee14e59d.public_key, cpublic_key_allocs = x.PublicKey.PassValue()
+	allocsee14e59d.Borrow(cpublic_key_allocs)
 
-	x.reff02a01b8 = reff02a01b8
-	x.allocsf02a01b8 = allocsf02a01b8
-	return reff02a01b8, allocsf02a01b8
+	x.refee14e59d = refee14e59d
+	x.allocsee14e59d = allocsee14e59d
+	return refee14e59d, allocsee14e59d
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilEmptySectorUpdateDecodeFromResponse) PassValue() (C.fil_EmptySectorUpdateDecodeFromResponse, *cgoAllocMap) {
-	if x.reff02a01b8 != nil {
-		return *x.reff02a01b8, nil
+func (x FilPrivateKeyPublicKeyResponse) PassValue() (C.fil_PrivateKeyPublicKeyResponse, *cgoAllocMap) {
+	if x.refee14e59d != nil {
+		return *x.refee14e59d, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -995,97 +1310,80 @@ func (x FilEmptySectorUpdateDecodeFromResponse) PassValue() (C.fil_EmptySectorUp
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilEmptySectorUpdateDecodeFromResponse) Deref() {
-	if x.reff02a01b8 == nil {
+func (x *FilPrivateKeyPublicKeyResponse) Deref() {
+	if x.refee14e59d == nil {
 		return
 	}
-	x.StatusCode = (FCPResponseStatus)(x.reff02a01b8.status_code)
-	x.ErrorMsg = packPCharString(x.reff02a01b8.error_msg)
+	x.PublicKey = *NewFilBLSPublicKeyRef(unsafe.Pointer(&x.refee14e59d.public_key))
 }
 
-// allocFilEmptySectorUpdateEncodeIntoResponseMemory allocates memory for type C.fil_EmptySectorUpdateEncodeIntoResponse in C.
+// allocFilPrivateKeySignResponseMemory allocates memory for type C.fil_PrivateKeySignResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilEmptySectorUpdateEncodeIntoResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilEmptySectorUpdateEncodeIntoResponseValue))
+func allocFilPrivateKeySignResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPrivateKeySignResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilEmptySectorUpdateEncodeIntoResponseValue = unsafe.Sizeof([1]C.fil_EmptySectorUpdateEncodeIntoResponse{})
+const sizeOfFilPrivateKeySignResponseValue = unsafe.Sizeof([1]C.fil_PrivateKeySignResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilEmptySectorUpdateEncodeIntoResponse) Ref() *C.fil_EmptySectorUpdateEncodeIntoResponse {
+func (x *FilPrivateKeySignResponse) Ref() *C.fil_PrivateKeySignResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref8d3238a7
+	return x.refcdf97b28
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilEmptySectorUpdateEncodeIntoResponse) Free() {
-	if x != nil && x.allocs8d3238a7 != nil {
-		x.allocs8d3238a7.(*cgoAllocMap).Free()
-		x.ref8d3238a7 = nil
+func (x *FilPrivateKeySignResponse) Free() {
+	if x != nil && x.allocscdf97b28 != nil {
+		x.allocscdf97b28.(*cgoAllocMap).Free()
+		x.refcdf97b28 = nil
 	}
 }
 
-// NewFilEmptySectorUpdateEncodeIntoResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilPrivateKeySignResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilEmptySectorUpdateEncodeIntoResponseRef(ref unsafe.Pointer) *FilEmptySectorUpdateEncodeIntoResponse {
+func NewFilPrivateKeySignResponseRef(ref unsafe.Pointer) *FilPrivateKeySignResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilEmptySectorUpdateEncodeIntoResponse)
-	obj.ref8d3238a7 = (*C.fil_EmptySectorUpdateEncodeIntoResponse)(unsafe.Pointer(ref))
+	obj := new(FilPrivateKeySignResponse)
+	obj.refcdf97b28 = (*C.fil_PrivateKeySignResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilEmptySectorUpdateEncodeIntoResponse) PassRef() (*C.fil_EmptySectorUpdateEncodeIntoResponse, *cgoAllocMap) {
+func (x *FilPrivateKeySignResponse) PassRef() (*C.fil_PrivateKeySignResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref8d3238a7 != nil {
-		return x.ref8d3238a7, nil
+	} else if x.refcdf97b28 != nil {
+		return x.refcdf97b28, nil
 	}
-	mem8d3238a7 := allocFilEmptySectorUpdateEncodeIntoResponseMemory(1)
-	ref8d3238a7 := (*C.fil_EmptySectorUpdateEncodeIntoResponse)(mem8d3238a7)
-	allocs8d3238a7 := new(cgoAllocMap)
-	allocs8d3238a7.Add(mem8d3238a7)
-
-	var cerror_msg_allocs *cgoAllocMap
-	ref8d3238a7.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs8d3238a7.Borrow(cerror_msg_allocs)
-
-	var cstatus_code_allocs *cgoAllocMap
-	ref8d3238a7.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs8d3238a7.Borrow(cstatus_code_allocs)
-
-	var ccomm_r_new_allocs *cgoAllocMap
-	ref8d3238a7.comm_r_new, ccomm_r_new_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.CommRNew)), cgoAllocsUnknown
-	allocs8d3238a7.Borrow(ccomm_r_new_allocs)
-
-	var ccomm_r_last_new_allocs *cgoAllocMap
-	ref8d3238a7.comm_r_last_new, ccomm_r_last_new_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.CommRLastNew)), cgoAllocsUnknown
-	allocs8d3238a7.Borrow(ccomm_r_last_new_allocs)
+	memcdf97b28 := allocFilPrivateKeySignResponseMemory(1)
+	refcdf97b28 := (*C.fil_PrivateKeySignResponse)(memcdf97b28)
+	allocscdf97b28 := new(cgoAllocMap)
+	allocscdf97b28.Add(memcdf97b28)
 
-	var ccomm_d_new_allocs *cgoAllocMap
-	ref8d3238a7.comm_d_new, ccomm_d_new_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.CommDNew)), cgoAllocsUnknown
-	allocs8d3238a7.Borrow(ccomm_d_new_allocs)
+	var csignature_allocs *cgoAllocMap
+	refcdf97b28.signature, csignature_allocs = x.Signature.PassValue()
+	allocscdf97b28.Borrow(csignature_allocs)
 
-	x.ref8d3238a7 = ref8d3238a7
-	x.allocs8d3238a7 = allocs8d3238a7
-	return ref8d3238a7, allocs8d3238a7
+	x.refcdf97b28 = refcdf97b28
+	x.allocscdf97b28 = allocscdf97b28
+	return refcdf97b28, allocscdf97b28
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilEmptySectorUpdateEncodeIntoResponse) PassValue() (C.fil_EmptySectorUpdateEncodeIntoResponse, *cgoAllocMap) {
-	if x.ref8d3238a7 != nil {
-		return *x.ref8d3238a7, nil
+func (x FilPrivateKeySignResponse) PassValue() (C.fil_PrivateKeySignResponse, *cgoAllocMap) {
+	if x.refcdf97b28 != nil {
+		return *x.refcdf97b28, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -1093,96 +1391,84 @@ func (x FilEmptySectorUpdateEncodeIntoResponse) PassValue() (C.fil_EmptySectorUp
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilEmptySectorUpdateEncodeIntoResponse) Deref() {
-	if x.ref8d3238a7 == nil {
+func (x *FilPrivateKeySignResponse) Deref() {
+	if x.refcdf97b28 == nil {
 		return
 	}
-	x.ErrorMsg = packPCharString(x.ref8d3238a7.error_msg)
-	x.StatusCode = (FCPResponseStatus)(x.ref8d3238a7.status_code)
-	x.CommRNew = *(*[32]byte)(unsafe.Pointer(&x.ref8d3238a7.comm_r_new))
-	x.CommRLastNew = *(*[32]byte)(unsafe.Pointer(&x.ref8d3238a7.comm_r_last_new))
-	x.CommDNew = *(*[32]byte)(unsafe.Pointer(&x.ref8d3238a7.comm_d_new))
+	x.Signature = *NewFilBLSSignatureRef(unsafe.Pointer(&x.refcdf97b28.signature))
 }
 
-// allocFilEmptySectorUpdateProofResponseMemory allocates memory for type C.fil_EmptySectorUpdateProofResponse in C.
+// allocFilClearCacheResponseMemory allocates memory for type C.fil_ClearCacheResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilEmptySectorUpdateProofResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilEmptySectorUpdateProofResponseValue))
+func allocFilClearCacheResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilClearCacheResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilEmptySectorUpdateProofResponseValue = unsafe.Sizeof([1]C.fil_EmptySectorUpdateProofResponse{})
+const sizeOfFilClearCacheResponseValue = unsafe.Sizeof([1]C.fil_ClearCacheResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilEmptySectorUpdateProofResponse) Ref() *C.fil_EmptySectorUpdateProofResponse {
+func (x *FilClearCacheResponse) Ref() *C.fil_ClearCacheResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref5c2faef
+	return x.refa9a80400
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilEmptySectorUpdateProofResponse) Free() {
-	if x != nil && x.allocs5c2faef != nil {
-		x.allocs5c2faef.(*cgoAllocMap).Free()
-		x.ref5c2faef = nil
+func (x *FilClearCacheResponse) Free() {
+	if x != nil && x.allocsa9a80400 != nil {
+		x.allocsa9a80400.(*cgoAllocMap).Free()
+		x.refa9a80400 = nil
 	}
 }
 
-// NewFilEmptySectorUpdateProofResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilClearCacheResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilEmptySectorUpdateProofResponseRef(ref unsafe.Pointer) *FilEmptySectorUpdateProofResponse {
+func NewFilClearCacheResponseRef(ref unsafe.Pointer) *FilClearCacheResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilEmptySectorUpdateProofResponse)
-	obj.ref5c2faef = (*C.fil_EmptySectorUpdateProofResponse)(unsafe.Pointer(ref))
+	obj := new(FilClearCacheResponse)
+	obj.refa9a80400 = (*C.fil_ClearCacheResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilEmptySectorUpdateProofResponse) PassRef() (*C.fil_EmptySectorUpdateProofResponse, *cgoAllocMap) {
+func (x *FilClearCacheResponse) PassRef() (*C.fil_ClearCacheResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref5c2faef != nil {
-		return x.ref5c2faef, nil
+	} else if x.refa9a80400 != nil {
+		return x.refa9a80400, nil
 	}
-	mem5c2faef := allocFilEmptySectorUpdateProofResponseMemory(1)
-	ref5c2faef := (*C.fil_EmptySectorUpdateProofResponse)(mem5c2faef)
-	allocs5c2faef := new(cgoAllocMap)
-	allocs5c2faef.Add(mem5c2faef)
-
-	var cstatus_code_allocs *cgoAllocMap
-	ref5c2faef.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs5c2faef.Borrow(cstatus_code_allocs)
+	mema9a80400 := allocFilClearCacheResponseMemory(1)
+	refa9a80400 := (*C.fil_ClearCacheResponse)(mema9a80400)
+	allocsa9a80400 := new(cgoAllocMap)
+	allocsa9a80400.Add(mema9a80400)
 
 	var cerror_msg_allocs *cgoAllocMap
-	ref5c2faef.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs5c2faef.Borrow(cerror_msg_allocs)
-
-	var cproof_len_allocs *cgoAllocMap
-	ref5c2faef.proof_len, cproof_len_allocs = (C.size_t)(x.ProofLen), cgoAllocsUnknown
-	allocs5c2faef.Borrow(cproof_len_allocs)
+	refa9a80400.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocsa9a80400.Borrow(cerror_msg_allocs)
 
-	var cproof_ptr_allocs *cgoAllocMap
-	ref5c2faef.proof_ptr, cproof_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.ProofPtr)))
-	allocs5c2faef.Borrow(cproof_ptr_allocs)
+	var cstatus_code_allocs *cgoAllocMap
+	refa9a80400.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocsa9a80400.Borrow(cstatus_code_allocs)
 
-	x.ref5c2faef = ref5c2faef
-	x.allocs5c2faef = allocs5c2faef
-	return ref5c2faef, allocs5c2faef
+	x.refa9a80400 = refa9a80400
+	x.allocsa9a80400 = allocsa9a80400
+	return refa9a80400, allocsa9a80400
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilEmptySectorUpdateProofResponse) PassValue() (C.fil_EmptySectorUpdateProofResponse, *cgoAllocMap) {
-	if x.ref5c2faef != nil {
-		return *x.ref5c2faef, nil
+func (x FilClearCacheResponse) PassValue() (C.fil_ClearCacheResponse, *cgoAllocMap) {
+	if x.refa9a80400 != nil {
+		return *x.refa9a80400, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -1190,91 +1476,89 @@ func (x FilEmptySectorUpdateProofResponse) PassValue() (C.fil_EmptySectorUpdateP
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilEmptySectorUpdateProofResponse) Deref() {
-	if x.ref5c2faef == nil {
+func (x *FilClearCacheResponse) Deref() {
+	if x.refa9a80400 == nil {
 		return
 	}
-	x.StatusCode = (FCPResponseStatus)(x.ref5c2faef.status_code)
-	x.ErrorMsg = packPCharString(x.ref5c2faef.error_msg)
-	x.ProofLen = (uint)(x.ref5c2faef.proof_len)
-	hxff2234b := (*sliceHeader)(unsafe.Pointer(&x.ProofPtr))
-	hxff2234b.Data = unsafe.Pointer(x.ref5c2faef.proof_ptr)
-	hxff2234b.Cap = 0x7fffffff
-	// hxff2234b.Len = ?
-
+	x.ErrorMsg = packPCharString(x.refa9a80400.error_msg)
+	x.StatusCode = (FCPResponseStatus)(x.refa9a80400.status_code)
 }
 
-// allocFilEmptySectorUpdateRemoveEncodedDataResponseMemory allocates memory for type C.fil_EmptySectorUpdateRemoveEncodedDataResponse in C.
+// allocFilComputeCommRResponseMemory allocates memory for type C.fil_ComputeCommRResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilEmptySectorUpdateRemoveEncodedDataResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilEmptySectorUpdateRemoveEncodedDataResponseValue))
+func allocFilComputeCommRResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilComputeCommRResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilEmptySectorUpdateRemoveEncodedDataResponseValue = unsafe.Sizeof([1]C.fil_EmptySectorUpdateRemoveEncodedDataResponse{})
+const sizeOfFilComputeCommRResponseValue = unsafe.Sizeof([1]C.fil_ComputeCommRResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilEmptySectorUpdateRemoveEncodedDataResponse) Ref() *C.fil_EmptySectorUpdateRemoveEncodedDataResponse {
+func (x *FilComputeCommRResponse) Ref() *C.fil_ComputeCommRResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref50783b83
+	return x.reffb946967
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilEmptySectorUpdateRemoveEncodedDataResponse) Free() {
-	if x != nil && x.allocs50783b83 != nil {
-		x.allocs50783b83.(*cgoAllocMap).Free()
-		x.ref50783b83 = nil
+func (x *FilComputeCommRResponse) Free() {
+	if x != nil && x.allocsfb946967 != nil {
+		x.allocsfb946967.(*cgoAllocMap).Free()
+		x.reffb946967 = nil
 	}
 }
 
-// NewFilEmptySectorUpdateRemoveEncodedDataResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilComputeCommRResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilEmptySectorUpdateRemoveEncodedDataResponseRef(ref unsafe.Pointer) *FilEmptySectorUpdateRemoveEncodedDataResponse {
+func NewFilComputeCommRResponseRef(ref unsafe.Pointer) *FilComputeCommRResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilEmptySectorUpdateRemoveEncodedDataResponse)
-	obj.ref50783b83 = (*C.fil_EmptySectorUpdateRemoveEncodedDataResponse)(unsafe.Pointer(ref))
+	obj := new(FilComputeCommRResponse)
+	obj.reffb946967 = (*C.fil_ComputeCommRResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilEmptySectorUpdateRemoveEncodedDataResponse) PassRef() (*C.fil_EmptySectorUpdateRemoveEncodedDataResponse, *cgoAllocMap) {
+func (x *FilComputeCommRResponse) PassRef() (*C.fil_ComputeCommRResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref50783b83 != nil {
-		return x.ref50783b83, nil
+	} else if x.reffb946967 != nil {
+		return x.reffb946967, nil
 	}
-	mem50783b83 := allocFilEmptySectorUpdateRemoveEncodedDataResponseMemory(1)
-	ref50783b83 := (*C.fil_EmptySectorUpdateRemoveEncodedDataResponse)(mem50783b83)
-	allocs50783b83 := new(cgoAllocMap)
-	allocs50783b83.Add(mem50783b83)
+	memfb946967 := allocFilComputeCommRResponseMemory(1)
+	reffb946967 := (*C.fil_ComputeCommRResponse)(memfb946967)
+	allocsfb946967 := new(cgoAllocMap)
+	allocsfb946967.Add(memfb946967)
+
+	var cerror_msg_allocs *cgoAllocMap
+	reffb946967.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocsfb946967.Borrow(cerror_msg_allocs)
 
 	var cstatus_code_allocs *cgoAllocMap
-	ref50783b83.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs50783b83.Borrow(cstatus_code_allocs)
+	reffb946967.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocsfb946967.Borrow(cstatus_code_allocs)
 
-	var cerror_msg_allocs *cgoAllocMap
-	ref50783b83.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs50783b83.Borrow(cerror_msg_allocs)
+	var ccomm_r_allocs *cgoAllocMap
+	reffb946967.comm_r, ccomm_r_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.CommR)), cgoAllocsUnknown
+	allocsfb946967.Borrow(ccomm_r_allocs)
 
-	x.ref50783b83 = ref50783b83
-	x.allocs50783b83 = allocs50783b83
-	return ref50783b83, allocs50783b83
+	x.reffb946967 = reffb946967
+	x.allocsfb946967 = allocsfb946967
+	return reffb946967, allocsfb946967
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilEmptySectorUpdateRemoveEncodedDataResponse) PassValue() (C.fil_EmptySectorUpdateRemoveEncodedDataResponse, *cgoAllocMap) {
-	if x.ref50783b83 != nil {
-		return *x.ref50783b83, nil
+func (x FilComputeCommRResponse) PassValue() (C.fil_ComputeCommRResponse, *cgoAllocMap) {
+	if x.reffb946967 != nil {
+		return *x.reffb946967, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -1282,89 +1566,82 @@ func (x FilEmptySectorUpdateRemoveEncodedDataResponse) PassValue() (C.fil_EmptyS
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilEmptySectorUpdateRemoveEncodedDataResponse) Deref() {
-	if x.ref50783b83 == nil {
+func (x *FilComputeCommRResponse) Deref() {
+	if x.reffb946967 == nil {
 		return
 	}
-	x.StatusCode = (FCPResponseStatus)(x.ref50783b83.status_code)
-	x.ErrorMsg = packPCharString(x.ref50783b83.error_msg)
+	x.ErrorMsg = packPCharString(x.reffb946967.error_msg)
+	x.StatusCode = (FCPResponseStatus)(x.reffb946967.status_code)
+	x.CommR = *(*[32]byte)(unsafe.Pointer(&x.reffb946967.comm_r))
 }
 
-// allocFilVerifyEmptySectorUpdateProofResponseMemory allocates memory for type C.fil_VerifyEmptySectorUpdateProofResponse in C.
+// allocFilZeroSignatureResponseMemory allocates memory for type C.fil_ZeroSignatureResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilVerifyEmptySectorUpdateProofResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilVerifyEmptySectorUpdateProofResponseValue))
+func allocFilZeroSignatureResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilZeroSignatureResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilVerifyEmptySectorUpdateProofResponseValue = unsafe.Sizeof([1]C.fil_VerifyEmptySectorUpdateProofResponse{})
+const sizeOfFilZeroSignatureResponseValue = unsafe.Sizeof([1]C.fil_ZeroSignatureResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilVerifyEmptySectorUpdateProofResponse) Ref() *C.fil_VerifyEmptySectorUpdateProofResponse {
+func (x *FilZeroSignatureResponse) Ref() *C.fil_ZeroSignatureResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref50b7b13
+	return x.ref835a0405
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilVerifyEmptySectorUpdateProofResponse) Free() {
-	if x != nil && x.allocs50b7b13 != nil {
-		x.allocs50b7b13.(*cgoAllocMap).Free()
-		x.ref50b7b13 = nil
+func (x *FilZeroSignatureResponse) Free() {
+	if x != nil && x.allocs835a0405 != nil {
+		x.allocs835a0405.(*cgoAllocMap).Free()
+		x.ref835a0405 = nil
 	}
 }
 
-// NewFilVerifyEmptySectorUpdateProofResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilZeroSignatureResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilVerifyEmptySectorUpdateProofResponseRef(ref unsafe.Pointer) *FilVerifyEmptySectorUpdateProofResponse {
+func NewFilZeroSignatureResponseRef(ref unsafe.Pointer) *FilZeroSignatureResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilVerifyEmptySectorUpdateProofResponse)
-	obj.ref50b7b13 = (*C.fil_VerifyEmptySectorUpdateProofResponse)(unsafe.Pointer(ref))
+	obj := new(FilZeroSignatureResponse)
+	obj.ref835a0405 = (*C.fil_ZeroSignatureResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilVerifyEmptySectorUpdateProofResponse) PassRef() (*C.fil_VerifyEmptySectorUpdateProofResponse, *cgoAllocMap) {
+func (x *FilZeroSignatureResponse) PassRef() (*C.fil_ZeroSignatureResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref50b7b13 != nil {
-		return x.ref50b7b13, nil
+	} else if x.ref835a0405 != nil {
+		return x.ref835a0405, nil
 	}
-	mem50b7b13 := allocFilVerifyEmptySectorUpdateProofResponseMemory(1)
-	ref50b7b13 := (*C.fil_VerifyEmptySectorUpdateProofResponse)(mem50b7b13)
-	allocs50b7b13 := new(cgoAllocMap)
-	allocs50b7b13.Add(mem50b7b13)
-
-	var cstatus_code_allocs *cgoAllocMap
-	ref50b7b13.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs50b7b13.Borrow(cstatus_code_allocs)
-
-	var cerror_msg_allocs *cgoAllocMap
-	ref50b7b13.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs50b7b13.Borrow(cerror_msg_allocs)
+	mem835a0405 := allocFilZeroSignatureResponseMemory(1)
+	ref835a0405 := (*C.fil_ZeroSignatureResponse)(mem835a0405)
+	allocs835a0405 := new(cgoAllocMap)
+	allocs835a0405.Add(mem835a0405)
 
-	var cis_valid_allocs *cgoAllocMap
-	ref50b7b13.is_valid, cis_valid_allocs = (C._Bool)(x.IsValid), cgoAllocsUnknown
-	allocs50b7b13.Borrow(cis_valid_allocs)
+	var csignature_allocs *cgoAllocMap
+	ref835a0405.signature, csignature_allocs = x.Signature.PassValue()
+	allocs835a0405.Borrow(csignature_allocs)
 
-	x.ref50b7b13 = ref50b7b13
-	x.allocs50b7b13 = allocs50b7b13
-	return ref50b7b13, allocs50b7b13
+	x.ref835a0405 = ref835a0405
+	x.allocs835a0405 = allocs835a0405
+	return ref835a0405, allocs835a0405
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilVerifyEmptySectorUpdateProofResponse) PassValue() (C.fil_VerifyEmptySectorUpdateProofResponse, *cgoAllocMap) {
-	if x.ref50b7b13 != nil {
-		return *x.ref50b7b13, nil
+func (x FilZeroSignatureResponse) PassValue() (C.fil_ZeroSignatureResponse, *cgoAllocMap) {
+	if x.ref835a0405 != nil {
+		return *x.ref835a0405, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -1372,90 +1649,84 @@ func (x FilVerifyEmptySectorUpdateProofResponse) PassValue() (C.fil_VerifyEmptyS
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilVerifyEmptySectorUpdateProofResponse) Deref() {
-	if x.ref50b7b13 == nil {
+func (x *FilZeroSignatureResponse) Deref() {
+	if x.ref835a0405 == nil {
 		return
 	}
-	x.StatusCode = (FCPResponseStatus)(x.ref50b7b13.status_code)
-	x.ErrorMsg = packPCharString(x.ref50b7b13.error_msg)
-	x.IsValid = (bool)(x.ref50b7b13.is_valid)
+	x.Signature = *NewFilBLSSignatureRef(unsafe.Pointer(&x.ref835a0405.signature))
 }
 
-// allocFilFauxRepResponseMemory allocates memory for type C.fil_FauxRepResponse in C.
+// allocFilEmptySectorUpdateDecodeFromResponseMemory allocates memory for type C.fil_EmptySectorUpdateDecodeFromResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilFauxRepResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilFauxRepResponseValue))
+func allocFilEmptySectorUpdateDecodeFromResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilEmptySectorUpdateDecodeFromResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilFauxRepResponseValue = unsafe.Sizeof([1]C.fil_FauxRepResponse{})
+const sizeOfFilEmptySectorUpdateDecodeFromResponseValue = unsafe.Sizeof([1]C.fil_EmptySectorUpdateDecodeFromResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilFauxRepResponse) Ref() *C.fil_FauxRepResponse {
+func (x *FilEmptySectorUpdateDecodeFromResponse) Ref() *C.fil_EmptySectorUpdateDecodeFromResponse {
 	if x == nil {
 		return nil
 	}
-	return x.refaa003f71
+	return x.reff02a01b8
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilFauxRepResponse) Free() {
-	if x != nil && x.allocsaa003f71 != nil {
-		x.allocsaa003f71.(*cgoAllocMap).Free()
-		x.refaa003f71 = nil
+func (x *FilEmptySectorUpdateDecodeFromResponse) Free() {
+	if x != nil && x.allocsf02a01b8 != nil {
+		x.allocsf02a01b8.(*cgoAllocMap).Free()
+		x.reff02a01b8 = nil
 	}
 }
 
-// NewFilFauxRepResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilEmptySectorUpdateDecodeFromResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilFauxRepResponseRef(ref unsafe.Pointer) *FilFauxRepResponse {
+func NewFilEmptySectorUpdateDecodeFromResponseRef(ref unsafe.Pointer) *FilEmptySectorUpdateDecodeFromResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilFauxRepResponse)
-	obj.refaa003f71 = (*C.fil_FauxRepResponse)(unsafe.Pointer(ref))
+	obj := new(FilEmptySectorUpdateDecodeFromResponse)
+	obj.reff02a01b8 = (*C.fil_EmptySectorUpdateDecodeFromResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilFauxRepResponse) PassRef() (*C.fil_FauxRepResponse, *cgoAllocMap) {
+func (x *FilEmptySectorUpdateDecodeFromResponse) PassRef() (*C.fil_EmptySectorUpdateDecodeFromResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.refaa003f71 != nil {
-		return x.refaa003f71, nil
+	} else if x.reff02a01b8 != nil {
+		return x.reff02a01b8, nil
 	}
-	memaa003f71 := allocFilFauxRepResponseMemory(1)
-	refaa003f71 := (*C.fil_FauxRepResponse)(memaa003f71)
-	allocsaa003f71 := new(cgoAllocMap)
-	allocsaa003f71.Add(memaa003f71)
-
-	var cerror_msg_allocs *cgoAllocMap
-	refaa003f71.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocsaa003f71.Borrow(cerror_msg_allocs)
+	memf02a01b8 := allocFilEmptySectorUpdateDecodeFromResponseMemory(1)
+	reff02a01b8 := (*C.fil_EmptySectorUpdateDecodeFromResponse)(memf02a01b8)
+	allocsf02a01b8 := new(cgoAllocMap)
+	allocsf02a01b8.Add(memf02a01b8)
 
 	var cstatus_code_allocs *cgoAllocMap
-	refaa003f71.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocsaa003f71.Borrow(cstatus_code_allocs)
+	reff02a01b8.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocsf02a01b8.Borrow(cstatus_code_allocs)
 
-	var ccommitment_allocs *cgoAllocMap
-	refaa003f71.commitment, ccommitment_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.Commitment)), cgoAllocsUnknown
-	allocsaa003f71.Borrow(ccommitment_allocs)
+	var cerror_msg_allocs *cgoAllocMap
+	reff02a01b8.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocsf02a01b8.Borrow(cerror_msg_allocs)
 
-	x.refaa003f71 = refaa003f71
-	x.allocsaa003f71 = allocsaa003f71
-	return refaa003f71, allocsaa003f71
+	x.reff02a01b8 = reff02a01b8
+	x.allocsf02a01b8 = allocsf02a01b8
+	return reff02a01b8, allocsf02a01b8
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilFauxRepResponse) PassValue() (C.fil_FauxRepResponse, *cgoAllocMap) {
-	if x.refaa003f71 != nil {
-		return *x.refaa003f71, nil
+func (x FilEmptySectorUpdateDecodeFromResponse) PassValue() (C.fil_EmptySectorUpdateDecodeFromResponse, *cgoAllocMap) {
+	if x.reff02a01b8 != nil {
+		return *x.reff02a01b8, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -1463,90 +1734,97 @@ func (x FilFauxRepResponse) PassValue() (C.fil_FauxRepResponse, *cgoAllocMap) {
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilFauxRepResponse) Deref() {
-	if x.refaa003f71 == nil {
+func (x *FilEmptySectorUpdateDecodeFromResponse) Deref() {
+	if x.reff02a01b8 == nil {
 		return
 	}
-	x.ErrorMsg = packPCharString(x.refaa003f71.error_msg)
-	x.StatusCode = (FCPResponseStatus)(x.refaa003f71.status_code)
-	x.Commitment = *(*[32]byte)(unsafe.Pointer(&x.refaa003f71.commitment))
+	x.StatusCode = (FCPResponseStatus)(x.reff02a01b8.status_code)
+	x.ErrorMsg = packPCharString(x.reff02a01b8.error_msg)
 }
 
-// allocFilFinalizeTicketResponseMemory allocates memory for type C.fil_FinalizeTicketResponse in C.
+// allocFilEmptySectorUpdateEncodeIntoResponseMemory allocates memory for type C.fil_EmptySectorUpdateEncodeIntoResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilFinalizeTicketResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilFinalizeTicketResponseValue))
+func allocFilEmptySectorUpdateEncodeIntoResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilEmptySectorUpdateEncodeIntoResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilFinalizeTicketResponseValue = unsafe.Sizeof([1]C.fil_FinalizeTicketResponse{})
+const sizeOfFilEmptySectorUpdateEncodeIntoResponseValue = unsafe.Sizeof([1]C.fil_EmptySectorUpdateEncodeIntoResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilFinalizeTicketResponse) Ref() *C.fil_FinalizeTicketResponse {
+func (x *FilEmptySectorUpdateEncodeIntoResponse) Ref() *C.fil_EmptySectorUpdateEncodeIntoResponse {
 	if x == nil {
 		return nil
 	}
-	return x.refb370fa86
+	return x.ref8d3238a7
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilFinalizeTicketResponse) Free() {
-	if x != nil && x.allocsb370fa86 != nil {
-		x.allocsb370fa86.(*cgoAllocMap).Free()
-		x.refb370fa86 = nil
+func (x *FilEmptySectorUpdateEncodeIntoResponse) Free() {
+	if x != nil && x.allocs8d3238a7 != nil {
+		x.allocs8d3238a7.(*cgoAllocMap).Free()
+		x.ref8d3238a7 = nil
 	}
 }
 
-// NewFilFinalizeTicketResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilEmptySectorUpdateEncodeIntoResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilFinalizeTicketResponseRef(ref unsafe.Pointer) *FilFinalizeTicketResponse {
+func NewFilEmptySectorUpdateEncodeIntoResponseRef(ref unsafe.Pointer) *FilEmptySectorUpdateEncodeIntoResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilFinalizeTicketResponse)
-	obj.refb370fa86 = (*C.fil_FinalizeTicketResponse)(unsafe.Pointer(ref))
+	obj := new(FilEmptySectorUpdateEncodeIntoResponse)
+	obj.ref8d3238a7 = (*C.fil_EmptySectorUpdateEncodeIntoResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilFinalizeTicketResponse) PassRef() (*C.fil_FinalizeTicketResponse, *cgoAllocMap) {
+func (x *FilEmptySectorUpdateEncodeIntoResponse) PassRef() (*C.fil_EmptySectorUpdateEncodeIntoResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.refb370fa86 != nil {
-		return x.refb370fa86, nil
+	} else if x.ref8d3238a7 != nil {
+		return x.ref8d3238a7, nil
 	}
-	memb370fa86 := allocFilFinalizeTicketResponseMemory(1)
-	refb370fa86 := (*C.fil_FinalizeTicketResponse)(memb370fa86)
-	allocsb370fa86 := new(cgoAllocMap)
-	allocsb370fa86.Add(memb370fa86)
+	mem8d3238a7 := allocFilEmptySectorUpdateEncodeIntoResponseMemory(1)
+	ref8d3238a7 := (*C.fil_EmptySectorUpdateEncodeIntoResponse)(mem8d3238a7)
+	allocs8d3238a7 := new(cgoAllocMap)
+	allocs8d3238a7.Add(mem8d3238a7)
+
+	var cerror_msg_allocs *cgoAllocMap
+	ref8d3238a7.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs8d3238a7.Borrow(cerror_msg_allocs)
 
 	var cstatus_code_allocs *cgoAllocMap
-	refb370fa86.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocsb370fa86.Borrow(cstatus_code_allocs)
+	ref8d3238a7.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs8d3238a7.Borrow(cstatus_code_allocs)
 
-	var cerror_msg_allocs *cgoAllocMap
-	refb370fa86.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocsb370fa86.Borrow(cerror_msg_allocs)
+	var ccomm_r_new_allocs *cgoAllocMap
+	ref8d3238a7.comm_r_new, ccomm_r_new_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.CommRNew)), cgoAllocsUnknown
+	allocs8d3238a7.Borrow(ccomm_r_new_allocs)
 
-	var cticket_allocs *cgoAllocMap
-	refb370fa86.ticket, cticket_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.Ticket)), cgoAllocsUnknown
-	allocsb370fa86.Borrow(cticket_allocs)
+	var ccomm_r_last_new_allocs *cgoAllocMap
+	ref8d3238a7.comm_r_last_new, ccomm_r_last_new_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.CommRLastNew)), cgoAllocsUnknown
+	allocs8d3238a7.Borrow(ccomm_r_last_new_allocs)
 
-	x.refb370fa86 = refb370fa86
-	x.allocsb370fa86 = allocsb370fa86
-	return refb370fa86, allocsb370fa86
+	var ccomm_d_new_allocs *cgoAllocMap
+	ref8d3238a7.comm_d_new, ccomm_d_new_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.CommDNew)), cgoAllocsUnknown
+	allocs8d3238a7.Borrow(ccomm_d_new_allocs)
+
+	x.ref8d3238a7 = ref8d3238a7
+	x.allocs8d3238a7 = allocs8d3238a7
+	return ref8d3238a7, allocs8d3238a7
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilFinalizeTicketResponse) PassValue() (C.fil_FinalizeTicketResponse, *cgoAllocMap) {
-	if x.refb370fa86 != nil {
-		return *x.refb370fa86, nil
+func (x FilEmptySectorUpdateEncodeIntoResponse) PassValue() (C.fil_EmptySectorUpdateEncodeIntoResponse, *cgoAllocMap) {
+	if x.ref8d3238a7 != nil {
+		return *x.ref8d3238a7, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -1554,90 +1832,96 @@ func (x FilFinalizeTicketResponse) PassValue() (C.fil_FinalizeTicketResponse, *c
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilFinalizeTicketResponse) Deref() {
-	if x.refb370fa86 == nil {
+func (x *FilEmptySectorUpdateEncodeIntoResponse) Deref() {
+	if x.ref8d3238a7 == nil {
 		return
 	}
-	x.StatusCode = (FCPResponseStatus)(x.refb370fa86.status_code)
-	x.ErrorMsg = packPCharString(x.refb370fa86.error_msg)
-	x.Ticket = *(*[32]byte)(unsafe.Pointer(&x.refb370fa86.ticket))
+	x.ErrorMsg = packPCharString(x.ref8d3238a7.error_msg)
+	x.StatusCode = (FCPResponseStatus)(x.ref8d3238a7.status_code)
+	x.CommRNew = *(*[32]byte)(unsafe.Pointer(&x.ref8d3238a7.comm_r_new))
+	x.CommRLastNew = *(*[32]byte)(unsafe.Pointer(&x.ref8d3238a7.comm_r_last_new))
+	x.CommDNew = *(*[32]byte)(unsafe.Pointer(&x.ref8d3238a7.comm_d_new))
 }
 
-// allocFilGenerateDataCommitmentResponseMemory allocates memory for type C.fil_GenerateDataCommitmentResponse in C.
+// allocFilEmptySectorUpdateProofResponseMemory allocates memory for type C.fil_EmptySectorUpdateProofResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilGenerateDataCommitmentResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateDataCommitmentResponseValue))
+func allocFilEmptySectorUpdateProofResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilEmptySectorUpdateProofResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilGenerateDataCommitmentResponseValue = unsafe.Sizeof([1]C.fil_GenerateDataCommitmentResponse{})
+const sizeOfFilEmptySectorUpdateProofResponseValue = unsafe.Sizeof([1]C.fil_EmptySectorUpdateProofResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilGenerateDataCommitmentResponse) Ref() *C.fil_GenerateDataCommitmentResponse {
+func (x *FilEmptySectorUpdateProofResponse) Ref() *C.fil_EmptySectorUpdateProofResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref87da7dd9
+	return x.ref5c2faef
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilGenerateDataCommitmentResponse) Free() {
-	if x != nil && x.allocs87da7dd9 != nil {
-		x.allocs87da7dd9.(*cgoAllocMap).Free()
-		x.ref87da7dd9 = nil
+func (x *FilEmptySectorUpdateProofResponse) Free() {
+	if x != nil && x.allocs5c2faef != nil {
+		x.allocs5c2faef.(*cgoAllocMap).Free()
+		x.ref5c2faef = nil
 	}
 }
 
-// NewFilGenerateDataCommitmentResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilEmptySectorUpdateProofResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilGenerateDataCommitmentResponseRef(ref unsafe.Pointer) *FilGenerateDataCommitmentResponse {
+func NewFilEmptySectorUpdateProofResponseRef(ref unsafe.Pointer) *FilEmptySectorUpdateProofResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilGenerateDataCommitmentResponse)
-	obj.ref87da7dd9 = (*C.fil_GenerateDataCommitmentResponse)(unsafe.Pointer(ref))
+	obj := new(FilEmptySectorUpdateProofResponse)
+	obj.ref5c2faef = (*C.fil_EmptySectorUpdateProofResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilGenerateDataCommitmentResponse) PassRef() (*C.fil_GenerateDataCommitmentResponse, *cgoAllocMap) {
+func (x *FilEmptySectorUpdateProofResponse) PassRef() (*C.fil_EmptySectorUpdateProofResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref87da7dd9 != nil {
-		return x.ref87da7dd9, nil
+	} else if x.ref5c2faef != nil {
+		return x.ref5c2faef, nil
 	}
-	mem87da7dd9 := allocFilGenerateDataCommitmentResponseMemory(1)
-	ref87da7dd9 := (*C.fil_GenerateDataCommitmentResponse)(mem87da7dd9)
-	allocs87da7dd9 := new(cgoAllocMap)
-	allocs87da7dd9.Add(mem87da7dd9)
+	mem5c2faef := allocFilEmptySectorUpdateProofResponseMemory(1)
+	ref5c2faef := (*C.fil_EmptySectorUpdateProofResponse)(mem5c2faef)
+	allocs5c2faef := new(cgoAllocMap)
+	allocs5c2faef.Add(mem5c2faef)
 
 	var cstatus_code_allocs *cgoAllocMap
-	ref87da7dd9.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs87da7dd9.Borrow(cstatus_code_allocs)
+	ref5c2faef.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs5c2faef.Borrow(cstatus_code_allocs)
 
 	var cerror_msg_allocs *cgoAllocMap
-	ref87da7dd9.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs87da7dd9.Borrow(cerror_msg_allocs)
+	ref5c2faef.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs5c2faef.Borrow(cerror_msg_allocs)
 
-	var ccomm_d_allocs *cgoAllocMap
-	ref87da7dd9.comm_d, ccomm_d_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.CommD)), cgoAllocsUnknown
-	allocs87da7dd9.Borrow(ccomm_d_allocs)
+	var cproof_len_allocs *cgoAllocMap
+	ref5c2faef.proof_len, cproof_len_allocs = (C.size_t)(x.ProofLen), cgoAllocsUnknown
+	allocs5c2faef.Borrow(cproof_len_allocs)
 
-	x.ref87da7dd9 = ref87da7dd9
-	x.allocs87da7dd9 = allocs87da7dd9
-	return ref87da7dd9, allocs87da7dd9
+	var cproof_ptr_allocs *cgoAllocMap
+	ref5c2faef.proof_ptr, cproof_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.ProofPtr)))
+	allocs5c2faef.Borrow(cproof_ptr_allocs)
+
+	x.ref5c2faef = ref5c2faef
+	x.allocs5c2faef = allocs5c2faef
+	return ref5c2faef, allocs5c2faef
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilGenerateDataCommitmentResponse) PassValue() (C.fil_GenerateDataCommitmentResponse, *cgoAllocMap) {
-	if x.ref87da7dd9 != nil {
-		return *x.ref87da7dd9, nil
+func (x FilEmptySectorUpdateProofResponse) PassValue() (C.fil_EmptySectorUpdateProofResponse, *cgoAllocMap) {
+	if x.ref5c2faef != nil {
+		return *x.ref5c2faef, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -1645,86 +1929,91 @@ func (x FilGenerateDataCommitmentResponse) PassValue() (C.fil_GenerateDataCommit
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilGenerateDataCommitmentResponse) Deref() {
-	if x.ref87da7dd9 == nil {
+func (x *FilEmptySectorUpdateProofResponse) Deref() {
+	if x.ref5c2faef == nil {
 		return
 	}
-	x.StatusCode = (FCPResponseStatus)(x.ref87da7dd9.status_code)
-	x.ErrorMsg = packPCharString(x.ref87da7dd9.error_msg)
-	x.CommD = *(*[32]byte)(unsafe.Pointer(&x.ref87da7dd9.comm_d))
+	x.StatusCode = (FCPResponseStatus)(x.ref5c2faef.status_code)
+	x.ErrorMsg = packPCharString(x.ref5c2faef.error_msg)
+	x.ProofLen = (uint)(x.ref5c2faef.proof_len)
+	hxff2234b := (*sliceHeader)(unsafe.Pointer(&x.ProofPtr))
+	hxff2234b.Data = unsafe.Pointer(x.ref5c2faef.proof_ptr)
+	hxff2234b.Cap = 0x7fffffff
+	// hxff2234b.Len = ?
+
 }
 
-// allocFilPartitionProofMemory allocates memory for type C.fil_PartitionProof in C.
+// allocFilEmptySectorUpdateRemoveEncodedDataResponseMemory allocates memory for type C.fil_EmptySectorUpdateRemoveEncodedDataResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilPartitionProofMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPartitionProofValue))
+func allocFilEmptySectorUpdateRemoveEncodedDataResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilEmptySectorUpdateRemoveEncodedDataResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilPartitionProofValue = unsafe.Sizeof([1]C.fil_PartitionProof{})
+const sizeOfFilEmptySectorUpdateRemoveEncodedDataResponseValue = unsafe.Sizeof([1]C.fil_EmptySectorUpdateRemoveEncodedDataResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilPartitionProof) Ref() *C.fil_PartitionProof {
+func (x *FilEmptySectorUpdateRemoveEncodedDataResponse) Ref() *C.fil_EmptySectorUpdateRemoveEncodedDataResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref566a2be6
+	return x.ref50783b83
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilPartitionProof) Free() {
-	if x != nil && x.allocs566a2be6 != nil {
-		x.allocs566a2be6.(*cgoAllocMap).Free()
-		x.ref566a2be6 = nil
+func (x *FilEmptySectorUpdateRemoveEncodedDataResponse) Free() {
+	if x != nil && x.allocs50783b83 != nil {
+		x.allocs50783b83.(*cgoAllocMap).Free()
+		x.ref50783b83 = nil
 	}
 }
 
-// NewFilPartitionProofRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilEmptySectorUpdateRemoveEncodedDataResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilPartitionProofRef(ref unsafe.Pointer) *FilPartitionProof {
+func NewFilEmptySectorUpdateRemoveEncodedDataResponseRef(ref unsafe.Pointer) *FilEmptySectorUpdateRemoveEncodedDataResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilPartitionProof)
-	obj.ref566a2be6 = (*C.fil_PartitionProof)(unsafe.Pointer(ref))
+	obj := new(FilEmptySectorUpdateRemoveEncodedDataResponse)
+	obj.ref50783b83 = (*C.fil_EmptySectorUpdateRemoveEncodedDataResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilPartitionProof) PassRef() (*C.fil_PartitionProof, *cgoAllocMap) {
+func (x *FilEmptySectorUpdateRemoveEncodedDataResponse) PassRef() (*C.fil_EmptySectorUpdateRemoveEncodedDataResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref566a2be6 != nil {
-		return x.ref566a2be6, nil
+	} else if x.ref50783b83 != nil {
+		return x.ref50783b83, nil
 	}
-	mem566a2be6 := allocFilPartitionProofMemory(1)
-	ref566a2be6 := (*C.fil_PartitionProof)(mem566a2be6)
-	allocs566a2be6 := new(cgoAllocMap)
-	allocs566a2be6.Add(mem566a2be6)
+	mem50783b83 := allocFilEmptySectorUpdateRemoveEncodedDataResponseMemory(1)
+	ref50783b83 := (*C.fil_EmptySectorUpdateRemoveEncodedDataResponse)(mem50783b83)
+	allocs50783b83 := new(cgoAllocMap)
+	allocs50783b83.Add(mem50783b83)
 
-	var cproof_len_allocs *cgoAllocMap
-	ref566a2be6.proof_len, cproof_len_allocs = (C.size_t)(x.ProofLen), cgoAllocsUnknown
-	allocs566a2be6.Borrow(cproof_len_allocs)
+	var cstatus_code_allocs *cgoAllocMap
+	ref50783b83.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs50783b83.Borrow(cstatus_code_allocs)
 
-	var cproof_ptr_allocs *cgoAllocMap
-	ref566a2be6.proof_ptr, cproof_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.ProofPtr)))
-	allocs566a2be6.Borrow(cproof_ptr_allocs)
+	var cerror_msg_allocs *cgoAllocMap
+	ref50783b83.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs50783b83.Borrow(cerror_msg_allocs)
 
-	x.ref566a2be6 = ref566a2be6
-	x.allocs566a2be6 = allocs566a2be6
-	return ref566a2be6, allocs566a2be6
+	x.ref50783b83 = ref50783b83
+	x.allocs50783b83 = allocs50783b83
+	return ref50783b83, allocs50783b83
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilPartitionProof) PassValue() (C.fil_PartitionProof, *cgoAllocMap) {
-	if x.ref566a2be6 != nil {
-		return *x.ref566a2be6, nil
+func (x FilEmptySectorUpdateRemoveEncodedDataResponse) PassValue() (C.fil_EmptySectorUpdateRemoveEncodedDataResponse, *cgoAllocMap) {
+	if x.ref50783b83 != nil {
+		return *x.ref50783b83, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -1732,135 +2021,89 @@ func (x FilPartitionProof) PassValue() (C.fil_PartitionProof, *cgoAllocMap) {
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilPartitionProof) Deref() {
-	if x.ref566a2be6 == nil {
+func (x *FilEmptySectorUpdateRemoveEncodedDataResponse) Deref() {
+	if x.ref50783b83 == nil {
 		return
 	}
-	x.ProofLen = (uint)(x.ref566a2be6.proof_len)
-	hxff73280 := (*sliceHeader)(unsafe.Pointer(&x.ProofPtr))
-	hxff73280.Data = unsafe.Pointer(x.ref566a2be6.proof_ptr)
-	hxff73280.Cap = 0x7fffffff
-	// hxff73280.Len = ?
-
+	x.StatusCode = (FCPResponseStatus)(x.ref50783b83.status_code)
+	x.ErrorMsg = packPCharString(x.ref50783b83.error_msg)
 }
 
-// allocFilPartitionProofResponseMemory allocates memory for type C.fil_PartitionProofResponse in C.
+// allocFilVerifyEmptySectorUpdateProofResponseMemory allocates memory for type C.fil_VerifyEmptySectorUpdateProofResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilPartitionProofResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPartitionProofResponseValue))
+func allocFilVerifyEmptySectorUpdateProofResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilVerifyEmptySectorUpdateProofResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilPartitionProofResponseValue = unsafe.Sizeof([1]C.fil_PartitionProofResponse{})
-
-// unpackSFilPartitionProof transforms a sliced Go data structure into plain C format.
-func unpackSFilPartitionProof(x []FilPartitionProof) (unpacked *C.fil_PartitionProof, allocs *cgoAllocMap) {
-	if x == nil {
-		return nil, nil
-	}
-	allocs = new(cgoAllocMap)
-	defer runtime.SetFinalizer(allocs, func(a *cgoAllocMap) {
-		go a.Free()
-	})
-
-	len0 := len(x)
-	mem0 := allocFilPartitionProofMemory(len0)
-	allocs.Add(mem0)
-	h0 := &sliceHeader{
-		Data: mem0,
-		Cap:  len0,
-		Len:  len0,
-	}
-	v0 := *(*[]C.fil_PartitionProof)(unsafe.Pointer(h0))
-	for i0 := range x {
-		allocs0 := new(cgoAllocMap)
-		v0[i0], allocs0 = x[i0].PassValue()
-		allocs.Borrow(allocs0)
-	}
-	h := (*sliceHeader)(unsafe.Pointer(&v0))
-	unpacked = (*C.fil_PartitionProof)(h.Data)
-	return
-}
-
-// packSFilPartitionProof reads sliced Go data structure out from plain C format.
-func packSFilPartitionProof(v []FilPartitionProof, ptr0 *C.fil_PartitionProof) {
-	const m = 0x7fffffff
-	for i0 := range v {
-		ptr1 := (*(*[m / sizeOfFilPartitionProofValue]C.fil_PartitionProof)(unsafe.Pointer(ptr0)))[i0]
-		v[i0] = *NewFilPartitionProofRef(unsafe.Pointer(&ptr1))
-	}
-}
+const sizeOfFilVerifyEmptySectorUpdateProofResponseValue = unsafe.Sizeof([1]C.fil_VerifyEmptySectorUpdateProofResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilPartitionProofResponse) Ref() *C.fil_PartitionProofResponse {
+func (x *FilVerifyEmptySectorUpdateProofResponse) Ref() *C.fil_VerifyEmptySectorUpdateProofResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref51343e7a
+	return x.ref50b7b13
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilPartitionProofResponse) Free() {
-	if x != nil && x.allocs51343e7a != nil {
-		x.allocs51343e7a.(*cgoAllocMap).Free()
-		x.ref51343e7a = nil
+func (x *FilVerifyEmptySectorUpdateProofResponse) Free() {
+	if x != nil && x.allocs50b7b13 != nil {
+		x.allocs50b7b13.(*cgoAllocMap).Free()
+		x.ref50b7b13 = nil
 	}
 }
 
-// NewFilPartitionProofResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilVerifyEmptySectorUpdateProofResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilPartitionProofResponseRef(ref unsafe.Pointer) *FilPartitionProofResponse {
+func NewFilVerifyEmptySectorUpdateProofResponseRef(ref unsafe.Pointer) *FilVerifyEmptySectorUpdateProofResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilPartitionProofResponse)
-	obj.ref51343e7a = (*C.fil_PartitionProofResponse)(unsafe.Pointer(ref))
+	obj := new(FilVerifyEmptySectorUpdateProofResponse)
+	obj.ref50b7b13 = (*C.fil_VerifyEmptySectorUpdateProofResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilPartitionProofResponse) PassRef() (*C.fil_PartitionProofResponse, *cgoAllocMap) {
+func (x *FilVerifyEmptySectorUpdateProofResponse) PassRef() (*C.fil_VerifyEmptySectorUpdateProofResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref51343e7a != nil {
-		return x.ref51343e7a, nil
+	} else if x.ref50b7b13 != nil {
+		return x.ref50b7b13, nil
 	}
-	mem51343e7a := allocFilPartitionProofResponseMemory(1)
-	ref51343e7a := (*C.fil_PartitionProofResponse)(mem51343e7a)
-	allocs51343e7a := new(cgoAllocMap)
-	allocs51343e7a.Add(mem51343e7a)
+	mem50b7b13 := allocFilVerifyEmptySectorUpdateProofResponseMemory(1)
+	ref50b7b13 := (*C.fil_VerifyEmptySectorUpdateProofResponse)(mem50b7b13)
+	allocs50b7b13 := new(cgoAllocMap)
+	allocs50b7b13.Add(mem50b7b13)
 
 	var cstatus_code_allocs *cgoAllocMap
-	ref51343e7a.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs51343e7a.Borrow(cstatus_code_allocs)
+	ref50b7b13.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs50b7b13.Borrow(cstatus_code_allocs)
 
 	var cerror_msg_allocs *cgoAllocMap
-	ref51343e7a.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs51343e7a.Borrow(cerror_msg_allocs)
-
-	var cproofs_len_allocs *cgoAllocMap
-	ref51343e7a.proofs_len, cproofs_len_allocs = (C.size_t)(x.ProofsLen), cgoAllocsUnknown
-	allocs51343e7a.Borrow(cproofs_len_allocs)
+	ref50b7b13.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs50b7b13.Borrow(cerror_msg_allocs)
 
-	var cproofs_ptr_allocs *cgoAllocMap
-	ref51343e7a.proofs_ptr, cproofs_ptr_allocs = unpackSFilPartitionProof(x.ProofsPtr)
-	allocs51343e7a.Borrow(cproofs_ptr_allocs)
+	var cis_valid_allocs *cgoAllocMap
+	ref50b7b13.is_valid, cis_valid_allocs = (C._Bool)(x.IsValid), cgoAllocsUnknown
+	allocs50b7b13.Borrow(cis_valid_allocs)
 
-	x.ref51343e7a = ref51343e7a
-	x.allocs51343e7a = allocs51343e7a
-	return ref51343e7a, allocs51343e7a
+	x.ref50b7b13 = ref50b7b13
+	x.allocs50b7b13 = allocs50b7b13
+	return ref50b7b13, allocs50b7b13
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilPartitionProofResponse) PassValue() (C.fil_PartitionProofResponse, *cgoAllocMap) {
-	if x.ref51343e7a != nil {
-		return *x.ref51343e7a, nil
+func (x FilVerifyEmptySectorUpdateProofResponse) PassValue() (C.fil_VerifyEmptySectorUpdateProofResponse, *cgoAllocMap) {
+	if x.ref50b7b13 != nil {
+		return *x.ref50b7b13, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -1868,136 +2111,90 @@ func (x FilPartitionProofResponse) PassValue() (C.fil_PartitionProofResponse, *c
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilPartitionProofResponse) Deref() {
-	if x.ref51343e7a == nil {
+func (x *FilVerifyEmptySectorUpdateProofResponse) Deref() {
+	if x.ref50b7b13 == nil {
 		return
 	}
-	x.StatusCode = (FCPResponseStatus)(x.ref51343e7a.status_code)
-	x.ErrorMsg = packPCharString(x.ref51343e7a.error_msg)
-	x.ProofsLen = (uint)(x.ref51343e7a.proofs_len)
-	packSFilPartitionProof(x.ProofsPtr, x.ref51343e7a.proofs_ptr)
-}
-
-// allocFilGenerateFallbackSectorChallengesResponseMemory allocates memory for type C.fil_GenerateFallbackSectorChallengesResponse in C.
-// The caller is responsible for freeing the this memory via C.free.
-func allocFilGenerateFallbackSectorChallengesResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateFallbackSectorChallengesResponseValue))
-	if mem == nil {
-		panic(fmt.Sprintln("memory alloc error: ", err))
-	}
-	return mem
-}
-
-const sizeOfFilGenerateFallbackSectorChallengesResponseValue = unsafe.Sizeof([1]C.fil_GenerateFallbackSectorChallengesResponse{})
-
-// copyPUint64TBytes copies the data from Go slice as *C.uint64_t.
-func copyPUint64TBytes(slice *sliceHeader) (*C.uint64_t, *cgoAllocMap) {
-	allocs := new(cgoAllocMap)
-	defer runtime.SetFinalizer(allocs, func(a *cgoAllocMap) {
-		go a.Free()
-	})
-
-	mem0 := unsafe.Pointer(C.CBytes(*(*[]byte)(unsafe.Pointer(&sliceHeader{
-		Data: slice.Data,
-		Len:  int(sizeOfUint64TValue) * slice.Len,
-		Cap:  int(sizeOfUint64TValue) * slice.Len,
-	}))))
-	allocs.Add(mem0)
-
-	return (*C.uint64_t)(mem0), allocs
+	x.StatusCode = (FCPResponseStatus)(x.ref50b7b13.status_code)
+	x.ErrorMsg = packPCharString(x.ref50b7b13.error_msg)
+	x.IsValid = (bool)(x.ref50b7b13.is_valid)
 }
 
-// allocUint64TMemory allocates memory for type C.uint64_t in C.
+// allocFilFauxRepResponseMemory allocates memory for type C.fil_FauxRepResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocUint64TMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfUint64TValue))
+func allocFilFauxRepResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilFauxRepResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfUint64TValue = unsafe.Sizeof([1]C.uint64_t{})
+const sizeOfFilFauxRepResponseValue = unsafe.Sizeof([1]C.fil_FauxRepResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilGenerateFallbackSectorChallengesResponse) Ref() *C.fil_GenerateFallbackSectorChallengesResponse {
+func (x *FilFauxRepResponse) Ref() *C.fil_FauxRepResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref7047a3fa
+	return x.refaa003f71
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilGenerateFallbackSectorChallengesResponse) Free() {
-	if x != nil && x.allocs7047a3fa != nil {
-		x.allocs7047a3fa.(*cgoAllocMap).Free()
-		x.ref7047a3fa = nil
+func (x *FilFauxRepResponse) Free() {
+	if x != nil && x.allocsaa003f71 != nil {
+		x.allocsaa003f71.(*cgoAllocMap).Free()
+		x.refaa003f71 = nil
 	}
 }
 
-// NewFilGenerateFallbackSectorChallengesResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilFauxRepResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilGenerateFallbackSectorChallengesResponseRef(ref unsafe.Pointer) *FilGenerateFallbackSectorChallengesResponse {
+func NewFilFauxRepResponseRef(ref unsafe.Pointer) *FilFauxRepResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilGenerateFallbackSectorChallengesResponse)
-	obj.ref7047a3fa = (*C.fil_GenerateFallbackSectorChallengesResponse)(unsafe.Pointer(ref))
+	obj := new(FilFauxRepResponse)
+	obj.refaa003f71 = (*C.fil_FauxRepResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilGenerateFallbackSectorChallengesResponse) PassRef() (*C.fil_GenerateFallbackSectorChallengesResponse, *cgoAllocMap) {
+func (x *FilFauxRepResponse) PassRef() (*C.fil_FauxRepResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref7047a3fa != nil {
-		return x.ref7047a3fa, nil
+	} else if x.refaa003f71 != nil {
+		return x.refaa003f71, nil
 	}
-	mem7047a3fa := allocFilGenerateFallbackSectorChallengesResponseMemory(1)
-	ref7047a3fa := (*C.fil_GenerateFallbackSectorChallengesResponse)(mem7047a3fa)
-	allocs7047a3fa := new(cgoAllocMap)
-	allocs7047a3fa.Add(mem7047a3fa)
+	memaa003f71 := allocFilFauxRepResponseMemory(1)
+	refaa003f71 := (*C.fil_FauxRepResponse)(memaa003f71)
+	allocsaa003f71 := new(cgoAllocMap)
+	allocsaa003f71.Add(memaa003f71)
 
 	var cerror_msg_allocs *cgoAllocMap
-	ref7047a3fa.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs7047a3fa.Borrow(cerror_msg_allocs)
+	refaa003f71.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocsaa003f71.Borrow(cerror_msg_allocs)
 
 	var cstatus_code_allocs *cgoAllocMap
-	ref7047a3fa.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs7047a3fa.Borrow(cstatus_code_allocs)
-
-	var cids_ptr_allocs *cgoAllocMap
-	ref7047a3fa.ids_ptr, cids_ptr_allocs = copyPUint64TBytes((*sliceHeader)(unsafe.Pointer(&x.IdsPtr)))
-	allocs7047a3fa.Borrow(cids_ptr_allocs)
-
-	var cids_len_allocs *cgoAllocMap
-	ref7047a3fa.ids_len, cids_len_allocs = (C.size_t)(x.IdsLen), cgoAllocsUnknown
-	allocs7047a3fa.Borrow(cids_len_allocs)
-
-	var cchallenges_ptr_allocs *cgoAllocMap
-	ref7047a3fa.challenges_ptr, cchallenges_ptr_allocs = copyPUint64TBytes((*sliceHeader)(unsafe.Pointer(&x.ChallengesPtr)))
-	allocs7047a3fa.Borrow(cchallenges_ptr_allocs)
-
-	var cchallenges_len_allocs *cgoAllocMap
-	ref7047a3fa.challenges_len, cchallenges_len_allocs = (C.size_t)(x.ChallengesLen), cgoAllocsUnknown
-	allocs7047a3fa.Borrow(cchallenges_len_allocs)
+	refaa003f71.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocsaa003f71.Borrow(cstatus_code_allocs)
 
-	var cchallenges_stride_allocs *cgoAllocMap
-	ref7047a3fa.challenges_stride, cchallenges_stride_allocs = (C.size_t)(x.ChallengesStride), cgoAllocsUnknown
-	allocs7047a3fa.Borrow(cchallenges_stride_allocs)
+	var ccommitment_allocs *cgoAllocMap
+	refaa003f71.commitment, ccommitment_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.Commitment)), cgoAllocsUnknown
+	allocsaa003f71.Borrow(ccommitment_allocs)
 
-	x.ref7047a3fa = ref7047a3fa
-	x.allocs7047a3fa = allocs7047a3fa
-	return ref7047a3fa, allocs7047a3fa
+	x.refaa003f71 = refaa003f71
+	x.allocsaa003f71 = allocsaa003f71
+	return refaa003f71, allocsaa003f71
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilGenerateFallbackSectorChallengesResponse) PassValue() (C.fil_GenerateFallbackSectorChallengesResponse, *cgoAllocMap) {
-	if x.ref7047a3fa != nil {
-		return *x.ref7047a3fa, nil
+func (x FilFauxRepResponse) PassValue() (C.fil_FauxRepResponse, *cgoAllocMap) {
+	if x.refaa003f71 != nil {
+		return *x.refaa003f71, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -2005,106 +2202,1352 @@ func (x FilGenerateFallbackSectorChallengesResponse) PassValue() (C.fil_Generate
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilGenerateFallbackSectorChallengesResponse) Deref() {
-	if x.ref7047a3fa == nil {
+func (x *FilFauxRepResponse) Deref() {
+	if x.refaa003f71 == nil {
 		return
 	}
-	x.ErrorMsg = packPCharString(x.ref7047a3fa.error_msg)
-	x.StatusCode = (FCPResponseStatus)(x.ref7047a3fa.status_code)
-	hxfa9955c := (*sliceHeader)(unsafe.Pointer(&x.IdsPtr))
-	hxfa9955c.Data = unsafe.Pointer(x.ref7047a3fa.ids_ptr)
-	hxfa9955c.Cap = 0x7fffffff
-	// hxfa9955c.Len = ?
-
-	x.IdsLen = (uint)(x.ref7047a3fa.ids_len)
-	hxfa3f05c := (*sliceHeader)(unsafe.Pointer(&x.ChallengesPtr))
-	hxfa3f05c.Data = unsafe.Pointer(x.ref7047a3fa.challenges_ptr)
-	hxfa3f05c.Cap = 0x7fffffff
-	// hxfa3f05c.Len = ?
-
-	x.ChallengesLen = (uint)(x.ref7047a3fa.challenges_len)
-	x.ChallengesStride = (uint)(x.ref7047a3fa.challenges_stride)
+	x.ErrorMsg = packPCharString(x.refaa003f71.error_msg)
+	x.StatusCode = (FCPResponseStatus)(x.refaa003f71.status_code)
+	x.Commitment = *(*[32]byte)(unsafe.Pointer(&x.refaa003f71.commitment))
 }
 
-// allocFilGeneratePieceCommitmentResponseMemory allocates memory for type C.fil_GeneratePieceCommitmentResponse in C.
+// allocFilFinalizeTicketResponseMemory allocates memory for type C.fil_FinalizeTicketResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilFinalizeTicketResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilFinalizeTicketResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilFinalizeTicketResponseValue = unsafe.Sizeof([1]C.fil_FinalizeTicketResponse{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilFinalizeTicketResponse) Ref() *C.fil_FinalizeTicketResponse {
+	if x == nil {
+		return nil
+	}
+	return x.refb370fa86
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilFinalizeTicketResponse) Free() {
+	if x != nil && x.allocsb370fa86 != nil {
+		x.allocsb370fa86.(*cgoAllocMap).Free()
+		x.refb370fa86 = nil
+	}
+}
+
+// NewFilFinalizeTicketResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilFinalizeTicketResponseRef(ref unsafe.Pointer) *FilFinalizeTicketResponse {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilFinalizeTicketResponse)
+	obj.refb370fa86 = (*C.fil_FinalizeTicketResponse)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilFinalizeTicketResponse) PassRef() (*C.fil_FinalizeTicketResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.refb370fa86 != nil {
+		return x.refb370fa86, nil
+	}
+	memb370fa86 := allocFilFinalizeTicketResponseMemory(1)
+	refb370fa86 := (*C.fil_FinalizeTicketResponse)(memb370fa86)
+	allocsb370fa86 := new(cgoAllocMap)
+	allocsb370fa86.Add(memb370fa86)
+
+	var cstatus_code_allocs *cgoAllocMap
+	refb370fa86.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocsb370fa86.Borrow(cstatus_code_allocs)
+
+	var cerror_msg_allocs *cgoAllocMap
+	refb370fa86.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocsb370fa86.Borrow(cerror_msg_allocs)
+
+	var cticket_allocs *cgoAllocMap
+	refb370fa86.ticket, cticket_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.Ticket)), cgoAllocsUnknown
+	allocsb370fa86.Borrow(cticket_allocs)
+
+	x.refb370fa86 = refb370fa86
+	x.allocsb370fa86 = allocsb370fa86
+	return refb370fa86, allocsb370fa86
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilFinalizeTicketResponse) PassValue() (C.fil_FinalizeTicketResponse, *cgoAllocMap) {
+	if x.refb370fa86 != nil {
+		return *x.refb370fa86, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilFinalizeTicketResponse) Deref() {
+	if x.refb370fa86 == nil {
+		return
+	}
+	x.StatusCode = (FCPResponseStatus)(x.refb370fa86.status_code)
+	x.ErrorMsg = packPCharString(x.refb370fa86.error_msg)
+	x.Ticket = *(*[32]byte)(unsafe.Pointer(&x.refb370fa86.ticket))
+}
+
+// allocFilGenerateDataCommitmentResponseMemory allocates memory for type C.fil_GenerateDataCommitmentResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilGenerateDataCommitmentResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateDataCommitmentResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilGenerateDataCommitmentResponseValue = unsafe.Sizeof([1]C.fil_GenerateDataCommitmentResponse{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilGenerateDataCommitmentResponse) Ref() *C.fil_GenerateDataCommitmentResponse {
+	if x == nil {
+		return nil
+	}
+	return x.ref87da7dd9
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilGenerateDataCommitmentResponse) Free() {
+	if x != nil && x.allocs87da7dd9 != nil {
+		x.allocs87da7dd9.(*cgoAllocMap).Free()
+		x.ref87da7dd9 = nil
+	}
+}
+
+// NewFilGenerateDataCommitmentResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilGenerateDataCommitmentResponseRef(ref unsafe.Pointer) *FilGenerateDataCommitmentResponse {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilGenerateDataCommitmentResponse)
+	obj.ref87da7dd9 = (*C.fil_GenerateDataCommitmentResponse)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilGenerateDataCommitmentResponse) PassRef() (*C.fil_GenerateDataCommitmentResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref87da7dd9 != nil {
+		return x.ref87da7dd9, nil
+	}
+	mem87da7dd9 := allocFilGenerateDataCommitmentResponseMemory(1)
+	ref87da7dd9 := (*C.fil_GenerateDataCommitmentResponse)(mem87da7dd9)
+	allocs87da7dd9 := new(cgoAllocMap)
+	allocs87da7dd9.Add(mem87da7dd9)
+
+	var cstatus_code_allocs *cgoAllocMap
+	ref87da7dd9.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs87da7dd9.Borrow(cstatus_code_allocs)
+
+	var cerror_msg_allocs *cgoAllocMap
+	ref87da7dd9.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs87da7dd9.Borrow(cerror_msg_allocs)
+
+	var ccomm_d_allocs *cgoAllocMap
+	ref87da7dd9.comm_d, ccomm_d_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.CommD)), cgoAllocsUnknown
+	allocs87da7dd9.Borrow(ccomm_d_allocs)
+
+	x.ref87da7dd9 = ref87da7dd9
+	x.allocs87da7dd9 = allocs87da7dd9
+	return ref87da7dd9, allocs87da7dd9
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilGenerateDataCommitmentResponse) PassValue() (C.fil_GenerateDataCommitmentResponse, *cgoAllocMap) {
+	if x.ref87da7dd9 != nil {
+		return *x.ref87da7dd9, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilGenerateDataCommitmentResponse) Deref() {
+	if x.ref87da7dd9 == nil {
+		return
+	}
+	x.StatusCode = (FCPResponseStatus)(x.ref87da7dd9.status_code)
+	x.ErrorMsg = packPCharString(x.ref87da7dd9.error_msg)
+	x.CommD = *(*[32]byte)(unsafe.Pointer(&x.ref87da7dd9.comm_d))
+}
+
+// allocFilPartitionProofMemory allocates memory for type C.fil_PartitionProof in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilPartitionProofMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPartitionProofValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilPartitionProofValue = unsafe.Sizeof([1]C.fil_PartitionProof{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilPartitionProof) Ref() *C.fil_PartitionProof {
+	if x == nil {
+		return nil
+	}
+	return x.ref566a2be6
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilPartitionProof) Free() {
+	if x != nil && x.allocs566a2be6 != nil {
+		x.allocs566a2be6.(*cgoAllocMap).Free()
+		x.ref566a2be6 = nil
+	}
+}
+
+// NewFilPartitionProofRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilPartitionProofRef(ref unsafe.Pointer) *FilPartitionProof {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilPartitionProof)
+	obj.ref566a2be6 = (*C.fil_PartitionProof)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilPartitionProof) PassRef() (*C.fil_PartitionProof, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref566a2be6 != nil {
+		return x.ref566a2be6, nil
+	}
+	mem566a2be6 := allocFilPartitionProofMemory(1)
+	ref566a2be6 := (*C.fil_PartitionProof)(mem566a2be6)
+	allocs566a2be6 := new(cgoAllocMap)
+	allocs566a2be6.Add(mem566a2be6)
+
+	var cproof_len_allocs *cgoAllocMap
+	ref566a2be6.proof_len, cproof_len_allocs = (C.size_t)(x.ProofLen), cgoAllocsUnknown
+	allocs566a2be6.Borrow(cproof_len_allocs)
+
+	var cproof_ptr_allocs *cgoAllocMap
+	ref566a2be6.proof_ptr, cproof_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.ProofPtr)))
+	allocs566a2be6.Borrow(cproof_ptr_allocs)
+
+	x.ref566a2be6 = ref566a2be6
+	x.allocs566a2be6 = allocs566a2be6
+	return ref566a2be6, allocs566a2be6
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilPartitionProof) PassValue() (C.fil_PartitionProof, *cgoAllocMap) {
+	if x.ref566a2be6 != nil {
+		return *x.ref566a2be6, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilPartitionProof) Deref() {
+	if x.ref566a2be6 == nil {
+		return
+	}
+	x.ProofLen = (uint)(x.ref566a2be6.proof_len)
+	hxff73280 := (*sliceHeader)(unsafe.Pointer(&x.ProofPtr))
+	hxff73280.Data = unsafe.Pointer(x.ref566a2be6.proof_ptr)
+	hxff73280.Cap = 0x7fffffff
+	// hxff73280.Len = ?
+
+}
+
+// allocFilPartitionProofResponseMemory allocates memory for type C.fil_PartitionProofResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilPartitionProofResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPartitionProofResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilPartitionProofResponseValue = unsafe.Sizeof([1]C.fil_PartitionProofResponse{})
+
+// unpackSFilPartitionProof transforms a sliced Go data structure into plain C format.
+func unpackSFilPartitionProof(x []FilPartitionProof) (unpacked *C.fil_PartitionProof, allocs *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	}
+	allocs = new(cgoAllocMap)
+	defer runtime.SetFinalizer(allocs, func(a *cgoAllocMap) {
+		go a.Free()
+	})
+
+	len0 := len(x)
+	mem0 := allocFilPartitionProofMemory(len0)
+	allocs.Add(mem0)
+	h0 := &sliceHeader{
+		Data: mem0,
+		Cap:  len0,
+		Len:  len0,
+	}
+	v0 := *(*[]C.fil_PartitionProof)(unsafe.Pointer(h0))
+	for i0 := range x {
+		allocs0 := new(cgoAllocMap)
+		v0[i0], allocs0 = x[i0].PassValue()
+		allocs.Borrow(allocs0)
+	}
+	h := (*sliceHeader)(unsafe.Pointer(&v0))
+	unpacked = (*C.fil_PartitionProof)(h.Data)
+	return
+}
+
+// packSFilPartitionProof reads sliced Go data structure out from plain C format.
+func packSFilPartitionProof(v []FilPartitionProof, ptr0 *C.fil_PartitionProof) {
+	const m = 0x7fffffff
+	for i0 := range v {
+		ptr1 := (*(*[m / sizeOfFilPartitionProofValue]C.fil_PartitionProof)(unsafe.Pointer(ptr0)))[i0]
+		v[i0] = *NewFilPartitionProofRef(unsafe.Pointer(&ptr1))
+	}
+}
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilPartitionProofResponse) Ref() *C.fil_PartitionProofResponse {
+	if x == nil {
+		return nil
+	}
+	return x.ref51343e7a
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilPartitionProofResponse) Free() {
+	if x != nil && x.allocs51343e7a != nil {
+		x.allocs51343e7a.(*cgoAllocMap).Free()
+		x.ref51343e7a = nil
+	}
+}
+
+// NewFilPartitionProofResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilPartitionProofResponseRef(ref unsafe.Pointer) *FilPartitionProofResponse {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilPartitionProofResponse)
+	obj.ref51343e7a = (*C.fil_PartitionProofResponse)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilPartitionProofResponse) PassRef() (*C.fil_PartitionProofResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref51343e7a != nil {
+		return x.ref51343e7a, nil
+	}
+	mem51343e7a := allocFilPartitionProofResponseMemory(1)
+	ref51343e7a := (*C.fil_PartitionProofResponse)(mem51343e7a)
+	allocs51343e7a := new(cgoAllocMap)
+	allocs51343e7a.Add(mem51343e7a)
+
+	var cstatus_code_allocs *cgoAllocMap
+	ref51343e7a.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs51343e7a.Borrow(cstatus_code_allocs)
+
+	var cerror_msg_allocs *cgoAllocMap
+	ref51343e7a.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs51343e7a.Borrow(cerror_msg_allocs)
+
+	var cproofs_len_allocs *cgoAllocMap
+	ref51343e7a.proofs_len, cproofs_len_allocs = (C.size_t)(x.ProofsLen), cgoAllocsUnknown
+	allocs51343e7a.Borrow(cproofs_len_allocs)
+
+	var cproofs_ptr_allocs *cgoAllocMap
+	ref51343e7a.proofs_ptr, cproofs_ptr_allocs = unpackSFilPartitionProof(x.ProofsPtr)
+	allocs51343e7a.Borrow(cproofs_ptr_allocs)
+
+	x.ref51343e7a = ref51343e7a
+	x.allocs51343e7a = allocs51343e7a
+	return ref51343e7a, allocs51343e7a
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilPartitionProofResponse) PassValue() (C.fil_PartitionProofResponse, *cgoAllocMap) {
+	if x.ref51343e7a != nil {
+		return *x.ref51343e7a, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilPartitionProofResponse) Deref() {
+	if x.ref51343e7a == nil {
+		return
+	}
+	x.StatusCode = (FCPResponseStatus)(x.ref51343e7a.status_code)
+	x.ErrorMsg = packPCharString(x.ref51343e7a.error_msg)
+	x.ProofsLen = (uint)(x.ref51343e7a.proofs_len)
+	packSFilPartitionProof(x.ProofsPtr, x.ref51343e7a.proofs_ptr)
+}
+
+// allocFilGenerateFallbackSectorChallengesResponseMemory allocates memory for type C.fil_GenerateFallbackSectorChallengesResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilGenerateFallbackSectorChallengesResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateFallbackSectorChallengesResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilGenerateFallbackSectorChallengesResponseValue = unsafe.Sizeof([1]C.fil_GenerateFallbackSectorChallengesResponse{})
+
+// copyPUint64TBytes copies the data from Go slice as *C.uint64_t.
+func copyPUint64TBytes(slice *sliceHeader) (*C.uint64_t, *cgoAllocMap) {
+	allocs := new(cgoAllocMap)
+	defer runtime.SetFinalizer(allocs, func(a *cgoAllocMap) {
+		go a.Free()
+	})
+
+	mem0 := unsafe.Pointer(C.CBytes(*(*[]byte)(unsafe.Pointer(&sliceHeader{
+		Data: slice.Data,
+		Len:  int(sizeOfUint64TValue) * slice.Len,
+		Cap:  int(sizeOfUint64TValue) * slice.Len,
+	}))))
+	allocs.Add(mem0)
+
+	return (*C.uint64_t)(mem0), allocs
+}
+
+// allocUint64TMemory allocates memory for type C.uint64_t in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocUint64TMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfUint64TValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfUint64TValue = unsafe.Sizeof([1]C.uint64_t{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilGenerateFallbackSectorChallengesResponse) Ref() *C.fil_GenerateFallbackSectorChallengesResponse {
+	if x == nil {
+		return nil
+	}
+	return x.ref7047a3fa
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilGenerateFallbackSectorChallengesResponse) Free() {
+	if x != nil && x.allocs7047a3fa != nil {
+		x.allocs7047a3fa.(*cgoAllocMap).Free()
+		x.ref7047a3fa = nil
+	}
+}
+
+// NewFilGenerateFallbackSectorChallengesResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilGenerateFallbackSectorChallengesResponseRef(ref unsafe.Pointer) *FilGenerateFallbackSectorChallengesResponse {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilGenerateFallbackSectorChallengesResponse)
+	obj.ref7047a3fa = (*C.fil_GenerateFallbackSectorChallengesResponse)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilGenerateFallbackSectorChallengesResponse) PassRef() (*C.fil_GenerateFallbackSectorChallengesResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref7047a3fa != nil {
+		return x.ref7047a3fa, nil
+	}
+	mem7047a3fa := allocFilGenerateFallbackSectorChallengesResponseMemory(1)
+	ref7047a3fa := (*C.fil_GenerateFallbackSectorChallengesResponse)(mem7047a3fa)
+	allocs7047a3fa := new(cgoAllocMap)
+	allocs7047a3fa.Add(mem7047a3fa)
+
+	var cerror_msg_allocs *cgoAllocMap
+	ref7047a3fa.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs7047a3fa.Borrow(cerror_msg_allocs)
+
+	var cstatus_code_allocs *cgoAllocMap
+	ref7047a3fa.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs7047a3fa.Borrow(cstatus_code_allocs)
+
+	var cids_ptr_allocs *cgoAllocMap
+	ref7047a3fa.ids_ptr, cids_ptr_allocs = copyPUint64TBytes((*sliceHeader)(unsafe.Pointer(&x.IdsPtr)))
+	allocs7047a3fa.Borrow(cids_ptr_allocs)
+
+	var cids_len_allocs *cgoAllocMap
+	ref7047a3fa.ids_len, cids_len_allocs = (C.size_t)(x.IdsLen), cgoAllocsUnknown
+	allocs7047a3fa.Borrow(cids_len_allocs)
+
+	var cchallenges_ptr_allocs *cgoAllocMap
+	ref7047a3fa.challenges_ptr, cchallenges_ptr_allocs = copyPUint64TBytes((*sliceHeader)(unsafe.Pointer(&x.ChallengesPtr)))
+	allocs7047a3fa.Borrow(cchallenges_ptr_allocs)
+
+	var cchallenges_len_allocs *cgoAllocMap
+	ref7047a3fa.challenges_len, cchallenges_len_allocs = (C.size_t)(x.ChallengesLen), cgoAllocsUnknown
+	allocs7047a3fa.Borrow(cchallenges_len_allocs)
+
+	var cchallenges_stride_allocs *cgoAllocMap
+	ref7047a3fa.challenges_stride, cchallenges_stride_allocs = (C.size_t)(x.ChallengesStride), cgoAllocsUnknown
+	allocs7047a3fa.Borrow(cchallenges_stride_allocs)
+
+	x.ref7047a3fa = ref7047a3fa
+	x.allocs7047a3fa = allocs7047a3fa
+	return ref7047a3fa, allocs7047a3fa
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilGenerateFallbackSectorChallengesResponse) PassValue() (C.fil_GenerateFallbackSectorChallengesResponse, *cgoAllocMap) {
+	if x.ref7047a3fa != nil {
+		return *x.ref7047a3fa, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilGenerateFallbackSectorChallengesResponse) Deref() {
+	if x.ref7047a3fa == nil {
+		return
+	}
+	x.ErrorMsg = packPCharString(x.ref7047a3fa.error_msg)
+	x.StatusCode = (FCPResponseStatus)(x.ref7047a3fa.status_code)
+	hxfa9955c := (*sliceHeader)(unsafe.Pointer(&x.IdsPtr))
+	hxfa9955c.Data = unsafe.Pointer(x.ref7047a3fa.ids_ptr)
+	hxfa9955c.Cap = 0x7fffffff
+	// hxfa9955c.Len = ?
+
+	x.IdsLen = (uint)(x.ref7047a3fa.ids_len)
+	hxfa3f05c := (*sliceHeader)(unsafe.Pointer(&x.ChallengesPtr))
+	hxfa3f05c.Data = unsafe.Pointer(x.ref7047a3fa.challenges_ptr)
+	hxfa3f05c.Cap = 0x7fffffff
+	// hxfa3f05c.Len = ?
+
+	x.ChallengesLen = (uint)(x.ref7047a3fa.challenges_len)
+	x.ChallengesStride = (uint)(x.ref7047a3fa.challenges_stride)
+}
+
+// allocFilGeneratePieceCommitmentResponseMemory allocates memory for type C.fil_GeneratePieceCommitmentResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilGeneratePieceCommitmentResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGeneratePieceCommitmentResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilGeneratePieceCommitmentResponseValue = unsafe.Sizeof([1]C.fil_GeneratePieceCommitmentResponse{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilGeneratePieceCommitmentResponse) Ref() *C.fil_GeneratePieceCommitmentResponse {
+	if x == nil {
+		return nil
+	}
+	return x.ref4b00fda4
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilGeneratePieceCommitmentResponse) Free() {
+	if x != nil && x.allocs4b00fda4 != nil {
+		x.allocs4b00fda4.(*cgoAllocMap).Free()
+		x.ref4b00fda4 = nil
+	}
+}
+
+// NewFilGeneratePieceCommitmentResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilGeneratePieceCommitmentResponseRef(ref unsafe.Pointer) *FilGeneratePieceCommitmentResponse {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilGeneratePieceCommitmentResponse)
+	obj.ref4b00fda4 = (*C.fil_GeneratePieceCommitmentResponse)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilGeneratePieceCommitmentResponse) PassRef() (*C.fil_GeneratePieceCommitmentResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref4b00fda4 != nil {
+		return x.ref4b00fda4, nil
+	}
+	mem4b00fda4 := allocFilGeneratePieceCommitmentResponseMemory(1)
+	ref4b00fda4 := (*C.fil_GeneratePieceCommitmentResponse)(mem4b00fda4)
+	allocs4b00fda4 := new(cgoAllocMap)
+	allocs4b00fda4.Add(mem4b00fda4)
+
+	var cstatus_code_allocs *cgoAllocMap
+	ref4b00fda4.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs4b00fda4.Borrow(cstatus_code_allocs)
+
+	var cerror_msg_allocs *cgoAllocMap
+	ref4b00fda4.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs4b00fda4.Borrow(cerror_msg_allocs)
+
+	var ccomm_p_allocs *cgoAllocMap
+	ref4b00fda4.comm_p, ccomm_p_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.CommP)), cgoAllocsUnknown
+	allocs4b00fda4.Borrow(ccomm_p_allocs)
+
+	var cnum_bytes_aligned_allocs *cgoAllocMap
+	ref4b00fda4.num_bytes_aligned, cnum_bytes_aligned_allocs = (C.uint64_t)(x.NumBytesAligned), cgoAllocsUnknown
+	allocs4b00fda4.Borrow(cnum_bytes_aligned_allocs)
+
+	x.ref4b00fda4 = ref4b00fda4
+	x.allocs4b00fda4 = allocs4b00fda4
+	return ref4b00fda4, allocs4b00fda4
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilGeneratePieceCommitmentResponse) PassValue() (C.fil_GeneratePieceCommitmentResponse, *cgoAllocMap) {
+	if x.ref4b00fda4 != nil {
+		return *x.ref4b00fda4, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilGeneratePieceCommitmentResponse) Deref() {
+	if x.ref4b00fda4 == nil {
+		return
+	}
+	x.StatusCode = (FCPResponseStatus)(x.ref4b00fda4.status_code)
+	x.ErrorMsg = packPCharString(x.ref4b00fda4.error_msg)
+	x.CommP = *(*[32]byte)(unsafe.Pointer(&x.ref4b00fda4.comm_p))
+	x.NumBytesAligned = (uint64)(x.ref4b00fda4.num_bytes_aligned)
+}
+
+// allocFilGenerateSDRResponseMemory allocates memory for type C.fil_GenerateSDRResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilGenerateSDRResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateSDRResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilGenerateSDRResponseValue = unsafe.Sizeof([1]C.fil_GenerateSDRResponse{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilGenerateSDRResponse) Ref() *C.fil_GenerateSDRResponse {
+	if x == nil {
+		return nil
+	}
+	return x.ref19054da
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilGenerateSDRResponse) Free() {
+	if x != nil && x.allocs19054da != nil {
+		x.allocs19054da.(*cgoAllocMap).Free()
+		x.ref19054da = nil
+	}
+}
+
+// NewFilGenerateSDRResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilGenerateSDRResponseRef(ref unsafe.Pointer) *FilGenerateSDRResponse {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilGenerateSDRResponse)
+	obj.ref19054da = (*C.fil_GenerateSDRResponse)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilGenerateSDRResponse) PassRef() (*C.fil_GenerateSDRResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref19054da != nil {
+		return x.ref19054da, nil
+	}
+	mem19054da := allocFilGenerateSDRResponseMemory(1)
+	ref19054da := (*C.fil_GenerateSDRResponse)(mem19054da)
+	allocs19054da := new(cgoAllocMap)
+	allocs19054da.Add(mem19054da)
+
+	var cerror_msg_allocs *cgoAllocMap
+	ref19054da.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs19054da.Borrow(cerror_msg_allocs)
+
+	var cstatus_code_allocs *cgoAllocMap
+	ref19054da.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs19054da.Borrow(cstatus_code_allocs)
+
+	x.ref19054da = ref19054da
+	x.allocs19054da = allocs19054da
+	return ref19054da, allocs19054da
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilGenerateSDRResponse) PassValue() (C.fil_GenerateSDRResponse, *cgoAllocMap) {
+	if x.ref19054da != nil {
+		return *x.ref19054da, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilGenerateSDRResponse) Deref() {
+	if x.ref19054da == nil {
+		return
+	}
+	x.ErrorMsg = packPCharString(x.ref19054da.error_msg)
+	x.StatusCode = (FCPResponseStatus)(x.ref19054da.status_code)
+}
+
+// allocFilVanillaProofMemory allocates memory for type C.fil_VanillaProof in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilVanillaProofMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilVanillaProofValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilVanillaProofValue = unsafe.Sizeof([1]C.fil_VanillaProof{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilVanillaProof) Ref() *C.fil_VanillaProof {
+	if x == nil {
+		return nil
+	}
+	return x.refb3e7638c
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilVanillaProof) Free() {
+	if x != nil && x.allocsb3e7638c != nil {
+		x.allocsb3e7638c.(*cgoAllocMap).Free()
+		x.refb3e7638c = nil
+	}
+}
+
+// NewFilVanillaProofRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilVanillaProofRef(ref unsafe.Pointer) *FilVanillaProof {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilVanillaProof)
+	obj.refb3e7638c = (*C.fil_VanillaProof)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilVanillaProof) PassRef() (*C.fil_VanillaProof, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.refb3e7638c != nil {
+		return x.refb3e7638c, nil
+	}
+	memb3e7638c := allocFilVanillaProofMemory(1)
+	refb3e7638c := (*C.fil_VanillaProof)(memb3e7638c)
+	allocsb3e7638c := new(cgoAllocMap)
+	allocsb3e7638c.Add(memb3e7638c)
+
+	var cproof_len_allocs *cgoAllocMap
+	refb3e7638c.proof_len, cproof_len_allocs = (C.size_t)(x.ProofLen), cgoAllocsUnknown
+	allocsb3e7638c.Borrow(cproof_len_allocs)
+
+	var cproof_ptr_allocs *cgoAllocMap
+	refb3e7638c.proof_ptr, cproof_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.ProofPtr)))
+	allocsb3e7638c.Borrow(cproof_ptr_allocs)
+
+	x.refb3e7638c = refb3e7638c
+	x.allocsb3e7638c = allocsb3e7638c
+	return refb3e7638c, allocsb3e7638c
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilVanillaProof) PassValue() (C.fil_VanillaProof, *cgoAllocMap) {
+	if x.refb3e7638c != nil {
+		return *x.refb3e7638c, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilVanillaProof) Deref() {
+	if x.refb3e7638c == nil {
+		return
+	}
+	x.ProofLen = (uint)(x.refb3e7638c.proof_len)
+	hxf0d18b7 := (*sliceHeader)(unsafe.Pointer(&x.ProofPtr))
+	hxf0d18b7.Data = unsafe.Pointer(x.refb3e7638c.proof_ptr)
+	hxf0d18b7.Cap = 0x7fffffff
+	// hxf0d18b7.Len = ?
+
+}
+
+// allocFilGenerateSingleVanillaProofResponseMemory allocates memory for type C.fil_GenerateSingleVanillaProofResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilGenerateSingleVanillaProofResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateSingleVanillaProofResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilGenerateSingleVanillaProofResponseValue = unsafe.Sizeof([1]C.fil_GenerateSingleVanillaProofResponse{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilGenerateSingleVanillaProofResponse) Ref() *C.fil_GenerateSingleVanillaProofResponse {
+	if x == nil {
+		return nil
+	}
+	return x.reff9d21b04
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilGenerateSingleVanillaProofResponse) Free() {
+	if x != nil && x.allocsf9d21b04 != nil {
+		x.allocsf9d21b04.(*cgoAllocMap).Free()
+		x.reff9d21b04 = nil
+	}
+}
+
+// NewFilGenerateSingleVanillaProofResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilGenerateSingleVanillaProofResponseRef(ref unsafe.Pointer) *FilGenerateSingleVanillaProofResponse {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilGenerateSingleVanillaProofResponse)
+	obj.reff9d21b04 = (*C.fil_GenerateSingleVanillaProofResponse)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilGenerateSingleVanillaProofResponse) PassRef() (*C.fil_GenerateSingleVanillaProofResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.reff9d21b04 != nil {
+		return x.reff9d21b04, nil
+	}
+	memf9d21b04 := allocFilGenerateSingleVanillaProofResponseMemory(1)
+	reff9d21b04 := (*C.fil_GenerateSingleVanillaProofResponse)(memf9d21b04)
+	allocsf9d21b04 := new(cgoAllocMap)
+	allocsf9d21b04.Add(memf9d21b04)
+
+	var cerror_msg_allocs *cgoAllocMap
+	reff9d21b04.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocsf9d21b04.Borrow(cerror_msg_allocs)
+
+	var cvanilla_proof_allocs *cgoAllocMap
+	reff9d21b04.vanilla_proof, cvanilla_proof_allocs = x.VanillaProof.PassValue()
+	allocsf9d21b04.Borrow(cvanilla_proof_allocs)
+
+	var cstatus_code_allocs *cgoAllocMap
+	reff9d21b04.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocsf9d21b04.Borrow(cstatus_code_allocs)
+
+	x.reff9d21b04 = reff9d21b04
+	x.allocsf9d21b04 = allocsf9d21b04
+	return reff9d21b04, allocsf9d21b04
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilGenerateSingleVanillaProofResponse) PassValue() (C.fil_GenerateSingleVanillaProofResponse, *cgoAllocMap) {
+	if x.reff9d21b04 != nil {
+		return *x.reff9d21b04, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilGenerateSingleVanillaProofResponse) Deref() {
+	if x.reff9d21b04 == nil {
+		return
+	}
+	x.ErrorMsg = packPCharString(x.reff9d21b04.error_msg)
+	x.VanillaProof = *NewFilVanillaProofRef(unsafe.Pointer(&x.reff9d21b04.vanilla_proof))
+	x.StatusCode = (FCPResponseStatus)(x.reff9d21b04.status_code)
+}
+
+// allocFilPartitionSnarkProofMemory allocates memory for type C.fil_PartitionSnarkProof in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilPartitionSnarkProofMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPartitionSnarkProofValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilPartitionSnarkProofValue = unsafe.Sizeof([1]C.fil_PartitionSnarkProof{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilPartitionSnarkProof) Ref() *C.fil_PartitionSnarkProof {
+	if x == nil {
+		return nil
+	}
+	return x.ref4de03739
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilPartitionSnarkProof) Free() {
+	if x != nil && x.allocs4de03739 != nil {
+		x.allocs4de03739.(*cgoAllocMap).Free()
+		x.ref4de03739 = nil
+	}
+}
+
+// NewFilPartitionSnarkProofRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilPartitionSnarkProofRef(ref unsafe.Pointer) *FilPartitionSnarkProof {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilPartitionSnarkProof)
+	obj.ref4de03739 = (*C.fil_PartitionSnarkProof)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilPartitionSnarkProof) PassRef() (*C.fil_PartitionSnarkProof, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref4de03739 != nil {
+		return x.ref4de03739, nil
+	}
+	mem4de03739 := allocFilPartitionSnarkProofMemory(1)
+	ref4de03739 := (*C.fil_PartitionSnarkProof)(mem4de03739)
+	allocs4de03739 := new(cgoAllocMap)
+	allocs4de03739.Add(mem4de03739)
+
+	var cregistered_proof_allocs *cgoAllocMap
+	ref4de03739.registered_proof, cregistered_proof_allocs = (C.fil_RegisteredPoStProof)(x.RegisteredProof), cgoAllocsUnknown
+	allocs4de03739.Borrow(cregistered_proof_allocs)
+
+	var cproof_len_allocs *cgoAllocMap
+	ref4de03739.proof_len, cproof_len_allocs = (C.size_t)(x.ProofLen), cgoAllocsUnknown
+	allocs4de03739.Borrow(cproof_len_allocs)
+
+	var cproof_ptr_allocs *cgoAllocMap
+	ref4de03739.proof_ptr, cproof_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.ProofPtr)))
+	allocs4de03739.Borrow(cproof_ptr_allocs)
+
+	x.ref4de03739 = ref4de03739
+	x.allocs4de03739 = allocs4de03739
+	return ref4de03739, allocs4de03739
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilPartitionSnarkProof) PassValue() (C.fil_PartitionSnarkProof, *cgoAllocMap) {
+	if x.ref4de03739 != nil {
+		return *x.ref4de03739, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilPartitionSnarkProof) Deref() {
+	if x.ref4de03739 == nil {
+		return
+	}
+	x.RegisteredProof = (FilRegisteredPoStProof)(x.ref4de03739.registered_proof)
+	x.ProofLen = (uint)(x.ref4de03739.proof_len)
+	hxf2fab0d := (*sliceHeader)(unsafe.Pointer(&x.ProofPtr))
+	hxf2fab0d.Data = unsafe.Pointer(x.ref4de03739.proof_ptr)
+	hxf2fab0d.Cap = 0x7fffffff
+	// hxf2fab0d.Len = ?
+
+}
+
+// allocFilGenerateSingleWindowPoStWithVanillaResponseMemory allocates memory for type C.fil_GenerateSingleWindowPoStWithVanillaResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilGeneratePieceCommitmentResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGeneratePieceCommitmentResponseValue))
+func allocFilGenerateSingleWindowPoStWithVanillaResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateSingleWindowPoStWithVanillaResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilGenerateSingleWindowPoStWithVanillaResponseValue = unsafe.Sizeof([1]C.fil_GenerateSingleWindowPoStWithVanillaResponse{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilGenerateSingleWindowPoStWithVanillaResponse) Ref() *C.fil_GenerateSingleWindowPoStWithVanillaResponse {
+	if x == nil {
+		return nil
+	}
+	return x.ref96c012c3
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilGenerateSingleWindowPoStWithVanillaResponse) Free() {
+	if x != nil && x.allocs96c012c3 != nil {
+		x.allocs96c012c3.(*cgoAllocMap).Free()
+		x.ref96c012c3 = nil
+	}
+}
+
+// NewFilGenerateSingleWindowPoStWithVanillaResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilGenerateSingleWindowPoStWithVanillaResponseRef(ref unsafe.Pointer) *FilGenerateSingleWindowPoStWithVanillaResponse {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilGenerateSingleWindowPoStWithVanillaResponse)
+	obj.ref96c012c3 = (*C.fil_GenerateSingleWindowPoStWithVanillaResponse)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilGenerateSingleWindowPoStWithVanillaResponse) PassRef() (*C.fil_GenerateSingleWindowPoStWithVanillaResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref96c012c3 != nil {
+		return x.ref96c012c3, nil
+	}
+	mem96c012c3 := allocFilGenerateSingleWindowPoStWithVanillaResponseMemory(1)
+	ref96c012c3 := (*C.fil_GenerateSingleWindowPoStWithVanillaResponse)(mem96c012c3)
+	allocs96c012c3 := new(cgoAllocMap)
+	allocs96c012c3.Add(mem96c012c3)
+
+	var cerror_msg_allocs *cgoAllocMap
+	ref96c012c3.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs96c012c3.Borrow(cerror_msg_allocs)
+
+	var cpartition_proof_allocs *cgoAllocMap
+	ref96c012c3.partition_proof, cpartition_proof_allocs = x.PartitionProof.PassValue()
+	allocs96c012c3.Borrow(cpartition_proof_allocs)
+
+	var cfaulty_sectors_len_allocs *cgoAllocMap
+	ref96c012c3.faulty_sectors_len, cfaulty_sectors_len_allocs = (C.size_t)(x.FaultySectorsLen), cgoAllocsUnknown
+	allocs96c012c3.Borrow(cfaulty_sectors_len_allocs)
+
+	var cfaulty_sectors_ptr_allocs *cgoAllocMap
+	ref96c012c3.faulty_sectors_ptr, cfaulty_sectors_ptr_allocs = copyPUint64TBytes((*sliceHeader)(unsafe.Pointer(&x.FaultySectorsPtr)))
+	allocs96c012c3.Borrow(cfaulty_sectors_ptr_allocs)
+
+	var cstatus_code_allocs *cgoAllocMap
+	ref96c012c3.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs96c012c3.Borrow(cstatus_code_allocs)
+
+	x.ref96c012c3 = ref96c012c3
+	x.allocs96c012c3 = allocs96c012c3
+	return ref96c012c3, allocs96c012c3
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilGenerateSingleWindowPoStWithVanillaResponse) PassValue() (C.fil_GenerateSingleWindowPoStWithVanillaResponse, *cgoAllocMap) {
+	if x.ref96c012c3 != nil {
+		return *x.ref96c012c3, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilGenerateSingleWindowPoStWithVanillaResponse) Deref() {
+	if x.ref96c012c3 == nil {
+		return
+	}
+	x.ErrorMsg = packPCharString(x.ref96c012c3.error_msg)
+	x.PartitionProof = *NewFilPartitionSnarkProofRef(unsafe.Pointer(&x.ref96c012c3.partition_proof))
+	x.FaultySectorsLen = (uint)(x.ref96c012c3.faulty_sectors_len)
+	hxf69fe70 := (*sliceHeader)(unsafe.Pointer(&x.FaultySectorsPtr))
+	hxf69fe70.Data = unsafe.Pointer(x.ref96c012c3.faulty_sectors_ptr)
+	hxf69fe70.Cap = 0x7fffffff
+	// hxf69fe70.Len = ?
+
+	x.StatusCode = (FCPResponseStatus)(x.ref96c012c3.status_code)
+}
+
+// allocFilPoStProofMemory allocates memory for type C.fil_PoStProof in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilPoStProofMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPoStProofValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilPoStProofValue = unsafe.Sizeof([1]C.fil_PoStProof{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilPoStProof) Ref() *C.fil_PoStProof {
+	if x == nil {
+		return nil
+	}
+	return x.ref3451bfa
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilPoStProof) Free() {
+	if x != nil && x.allocs3451bfa != nil {
+		x.allocs3451bfa.(*cgoAllocMap).Free()
+		x.ref3451bfa = nil
+	}
+}
+
+// NewFilPoStProofRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilPoStProofRef(ref unsafe.Pointer) *FilPoStProof {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilPoStProof)
+	obj.ref3451bfa = (*C.fil_PoStProof)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilPoStProof) PassRef() (*C.fil_PoStProof, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref3451bfa != nil {
+		return x.ref3451bfa, nil
+	}
+	mem3451bfa := allocFilPoStProofMemory(1)
+	ref3451bfa := (*C.fil_PoStProof)(mem3451bfa)
+	allocs3451bfa := new(cgoAllocMap)
+	allocs3451bfa.Add(mem3451bfa)
+
+	var cregistered_proof_allocs *cgoAllocMap
+	ref3451bfa.registered_proof, cregistered_proof_allocs = (C.fil_RegisteredPoStProof)(x.RegisteredProof), cgoAllocsUnknown
+	allocs3451bfa.Borrow(cregistered_proof_allocs)
+
+	var cproof_len_allocs *cgoAllocMap
+	ref3451bfa.proof_len, cproof_len_allocs = (C.size_t)(x.ProofLen), cgoAllocsUnknown
+	allocs3451bfa.Borrow(cproof_len_allocs)
+
+	var cproof_ptr_allocs *cgoAllocMap
+	ref3451bfa.proof_ptr, cproof_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.ProofPtr)))
+	allocs3451bfa.Borrow(cproof_ptr_allocs)
+
+	x.ref3451bfa = ref3451bfa
+	x.allocs3451bfa = allocs3451bfa
+	return ref3451bfa, allocs3451bfa
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilPoStProof) PassValue() (C.fil_PoStProof, *cgoAllocMap) {
+	if x.ref3451bfa != nil {
+		return *x.ref3451bfa, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilPoStProof) Deref() {
+	if x.ref3451bfa == nil {
+		return
+	}
+	x.RegisteredProof = (FilRegisteredPoStProof)(x.ref3451bfa.registered_proof)
+	x.ProofLen = (uint)(x.ref3451bfa.proof_len)
+	hxf65bf54 := (*sliceHeader)(unsafe.Pointer(&x.ProofPtr))
+	hxf65bf54.Data = unsafe.Pointer(x.ref3451bfa.proof_ptr)
+	hxf65bf54.Cap = 0x7fffffff
+	// hxf65bf54.Len = ?
+
+}
+
+// allocFilGenerateWindowPoStResponseMemory allocates memory for type C.fil_GenerateWindowPoStResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilGenerateWindowPoStResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateWindowPoStResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilGeneratePieceCommitmentResponseValue = unsafe.Sizeof([1]C.fil_GeneratePieceCommitmentResponse{})
+const sizeOfFilGenerateWindowPoStResponseValue = unsafe.Sizeof([1]C.fil_GenerateWindowPoStResponse{})
+
+// unpackSFilPoStProof transforms a sliced Go data structure into plain C format.
+func unpackSFilPoStProof(x []FilPoStProof) (unpacked *C.fil_PoStProof, allocs *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	}
+	allocs = new(cgoAllocMap)
+	defer runtime.SetFinalizer(allocs, func(a *cgoAllocMap) {
+		go a.Free()
+	})
+
+	len0 := len(x)
+	mem0 := allocFilPoStProofMemory(len0)
+	allocs.Add(mem0)
+	h0 := &sliceHeader{
+		Data: mem0,
+		Cap:  len0,
+		Len:  len0,
+	}
+	v0 := *(*[]C.fil_PoStProof)(unsafe.Pointer(h0))
+	for i0 := range x {
+		allocs0 := new(cgoAllocMap)
+		v0[i0], allocs0 = x[i0].PassValue()
+		allocs.Borrow(allocs0)
+	}
+	h := (*sliceHeader)(unsafe.Pointer(&v0))
+	unpacked = (*C.fil_PoStProof)(h.Data)
+	return
+}
+
+// packSFilPoStProof reads sliced Go data structure out from plain C format.
+func packSFilPoStProof(v []FilPoStProof, ptr0 *C.fil_PoStProof) {
+	const m = 0x7fffffff
+	for i0 := range v {
+		ptr1 := (*(*[m / sizeOfFilPoStProofValue]C.fil_PoStProof)(unsafe.Pointer(ptr0)))[i0]
+		v[i0] = *NewFilPoStProofRef(unsafe.Pointer(&ptr1))
+	}
+}
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilGeneratePieceCommitmentResponse) Ref() *C.fil_GeneratePieceCommitmentResponse {
+func (x *FilGenerateWindowPoStResponse) Ref() *C.fil_GenerateWindowPoStResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref4b00fda4
+	return x.ref2a5f3ba8
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilGeneratePieceCommitmentResponse) Free() {
-	if x != nil && x.allocs4b00fda4 != nil {
-		x.allocs4b00fda4.(*cgoAllocMap).Free()
-		x.ref4b00fda4 = nil
+func (x *FilGenerateWindowPoStResponse) Free() {
+	if x != nil && x.allocs2a5f3ba8 != nil {
+		x.allocs2a5f3ba8.(*cgoAllocMap).Free()
+		x.ref2a5f3ba8 = nil
 	}
 }
 
-// NewFilGeneratePieceCommitmentResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilGenerateWindowPoStResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilGeneratePieceCommitmentResponseRef(ref unsafe.Pointer) *FilGeneratePieceCommitmentResponse {
+func NewFilGenerateWindowPoStResponseRef(ref unsafe.Pointer) *FilGenerateWindowPoStResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilGeneratePieceCommitmentResponse)
-	obj.ref4b00fda4 = (*C.fil_GeneratePieceCommitmentResponse)(unsafe.Pointer(ref))
+	obj := new(FilGenerateWindowPoStResponse)
+	obj.ref2a5f3ba8 = (*C.fil_GenerateWindowPoStResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilGeneratePieceCommitmentResponse) PassRef() (*C.fil_GeneratePieceCommitmentResponse, *cgoAllocMap) {
+func (x *FilGenerateWindowPoStResponse) PassRef() (*C.fil_GenerateWindowPoStResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref4b00fda4 != nil {
-		return x.ref4b00fda4, nil
+	} else if x.ref2a5f3ba8 != nil {
+		return x.ref2a5f3ba8, nil
 	}
-	mem4b00fda4 := allocFilGeneratePieceCommitmentResponseMemory(1)
-	ref4b00fda4 := (*C.fil_GeneratePieceCommitmentResponse)(mem4b00fda4)
-	allocs4b00fda4 := new(cgoAllocMap)
-	allocs4b00fda4.Add(mem4b00fda4)
-
-	var cstatus_code_allocs *cgoAllocMap
-	ref4b00fda4.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs4b00fda4.Borrow(cstatus_code_allocs)
+	mem2a5f3ba8 := allocFilGenerateWindowPoStResponseMemory(1)
+	ref2a5f3ba8 := (*C.fil_GenerateWindowPoStResponse)(mem2a5f3ba8)
+	allocs2a5f3ba8 := new(cgoAllocMap)
+	allocs2a5f3ba8.Add(mem2a5f3ba8)
 
 	var cerror_msg_allocs *cgoAllocMap
-	ref4b00fda4.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs4b00fda4.Borrow(cerror_msg_allocs)
+	ref2a5f3ba8.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs2a5f3ba8.Borrow(cerror_msg_allocs)
 
-	var ccomm_p_allocs *cgoAllocMap
-	ref4b00fda4.comm_p, ccomm_p_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.CommP)), cgoAllocsUnknown
-	allocs4b00fda4.Borrow(ccomm_p_allocs)
+	var cproofs_len_allocs *cgoAllocMap
+	ref2a5f3ba8.proofs_len, cproofs_len_allocs = (C.size_t)(x.ProofsLen), cgoAllocsUnknown
+	allocs2a5f3ba8.Borrow(cproofs_len_allocs)
 
-	var cnum_bytes_aligned_allocs *cgoAllocMap
-	ref4b00fda4.num_bytes_aligned, cnum_bytes_aligned_allocs = (C.uint64_t)(x.NumBytesAligned), cgoAllocsUnknown
-	allocs4b00fda4.Borrow(cnum_bytes_aligned_allocs)
+	var cproofs_ptr_allocs *cgoAllocMap
+	ref2a5f3ba8.proofs_ptr, cproofs_ptr_allocs = unpackSFilPoStProof(x.ProofsPtr)
+	allocs2a5f3ba8.Borrow(cproofs_ptr_allocs)
 
-	x.ref4b00fda4 = ref4b00fda4
-	x.allocs4b00fda4 = allocs4b00fda4
-	return ref4b00fda4, allocs4b00fda4
+	var cfaulty_sectors_len_allocs *cgoAllocMap
+	ref2a5f3ba8.faulty_sectors_len, cfaulty_sectors_len_allocs = (C.size_t)(x.FaultySectorsLen), cgoAllocsUnknown
+	allocs2a5f3ba8.Borrow(cfaulty_sectors_len_allocs)
+
+	var cfaulty_sectors_ptr_allocs *cgoAllocMap
+	ref2a5f3ba8.faulty_sectors_ptr, cfaulty_sectors_ptr_allocs = copyPUint64TBytes((*sliceHeader)(unsafe.Pointer(&x.FaultySectorsPtr)))
+	allocs2a5f3ba8.Borrow(cfaulty_sectors_ptr_allocs)
+
+	var cstatus_code_allocs *cgoAllocMap
+	ref2a5f3ba8.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs2a5f3ba8.Borrow(cstatus_code_allocs)
+
+	x.ref2a5f3ba8 = ref2a5f3ba8
+	x.allocs2a5f3ba8 = allocs2a5f3ba8
+	return ref2a5f3ba8, allocs2a5f3ba8
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilGeneratePieceCommitmentResponse) PassValue() (C.fil_GeneratePieceCommitmentResponse, *cgoAllocMap) {
-	if x.ref4b00fda4 != nil {
-		return *x.ref4b00fda4, nil
+func (x FilGenerateWindowPoStResponse) PassValue() (C.fil_GenerateWindowPoStResponse, *cgoAllocMap) {
+	if x.ref2a5f3ba8 != nil {
+		return *x.ref2a5f3ba8, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -2112,87 +3555,101 @@ func (x FilGeneratePieceCommitmentResponse) PassValue() (C.fil_GeneratePieceComm
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilGeneratePieceCommitmentResponse) Deref() {
-	if x.ref4b00fda4 == nil {
+func (x *FilGenerateWindowPoStResponse) Deref() {
+	if x.ref2a5f3ba8 == nil {
 		return
 	}
-	x.StatusCode = (FCPResponseStatus)(x.ref4b00fda4.status_code)
-	x.ErrorMsg = packPCharString(x.ref4b00fda4.error_msg)
-	x.CommP = *(*[32]byte)(unsafe.Pointer(&x.ref4b00fda4.comm_p))
-	x.NumBytesAligned = (uint64)(x.ref4b00fda4.num_bytes_aligned)
+	x.ErrorMsg = packPCharString(x.ref2a5f3ba8.error_msg)
+	x.ProofsLen = (uint)(x.ref2a5f3ba8.proofs_len)
+	packSFilPoStProof(x.ProofsPtr, x.ref2a5f3ba8.proofs_ptr)
+	x.FaultySectorsLen = (uint)(x.ref2a5f3ba8.faulty_sectors_len)
+	hxf3b8dbd := (*sliceHeader)(unsafe.Pointer(&x.FaultySectorsPtr))
+	hxf3b8dbd.Data = unsafe.Pointer(x.ref2a5f3ba8.faulty_sectors_ptr)
+	hxf3b8dbd.Cap = 0x7fffffff
+	// hxf3b8dbd.Len = ?
+
+	x.StatusCode = (FCPResponseStatus)(x.ref2a5f3ba8.status_code)
 }
 
-// allocFilVanillaProofMemory allocates memory for type C.fil_VanillaProof in C.
+// allocFilGenerateWinningPoStResponseMemory allocates memory for type C.fil_GenerateWinningPoStResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilVanillaProofMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilVanillaProofValue))
+func allocFilGenerateWinningPoStResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateWinningPoStResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilVanillaProofValue = unsafe.Sizeof([1]C.fil_VanillaProof{})
+const sizeOfFilGenerateWinningPoStResponseValue = unsafe.Sizeof([1]C.fil_GenerateWinningPoStResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilVanillaProof) Ref() *C.fil_VanillaProof {
+func (x *FilGenerateWinningPoStResponse) Ref() *C.fil_GenerateWinningPoStResponse {
 	if x == nil {
 		return nil
 	}
-	return x.refb3e7638c
+	return x.ref1405b8ec
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilVanillaProof) Free() {
-	if x != nil && x.allocsb3e7638c != nil {
-		x.allocsb3e7638c.(*cgoAllocMap).Free()
-		x.refb3e7638c = nil
+func (x *FilGenerateWinningPoStResponse) Free() {
+	if x != nil && x.allocs1405b8ec != nil {
+		x.allocs1405b8ec.(*cgoAllocMap).Free()
+		x.ref1405b8ec = nil
 	}
 }
 
-// NewFilVanillaProofRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilGenerateWinningPoStResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilVanillaProofRef(ref unsafe.Pointer) *FilVanillaProof {
+func NewFilGenerateWinningPoStResponseRef(ref unsafe.Pointer) *FilGenerateWinningPoStResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilVanillaProof)
-	obj.refb3e7638c = (*C.fil_VanillaProof)(unsafe.Pointer(ref))
+	obj := new(FilGenerateWinningPoStResponse)
+	obj.ref1405b8ec = (*C.fil_GenerateWinningPoStResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilVanillaProof) PassRef() (*C.fil_VanillaProof, *cgoAllocMap) {
+func (x *FilGenerateWinningPoStResponse) PassRef() (*C.fil_GenerateWinningPoStResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.refb3e7638c != nil {
-		return x.refb3e7638c, nil
+	} else if x.ref1405b8ec != nil {
+		return x.ref1405b8ec, nil
 	}
-	memb3e7638c := allocFilVanillaProofMemory(1)
-	refb3e7638c := (*C.fil_VanillaProof)(memb3e7638c)
-	allocsb3e7638c := new(cgoAllocMap)
-	allocsb3e7638c.Add(memb3e7638c)
+	mem1405b8ec := allocFilGenerateWinningPoStResponseMemory(1)
+	ref1405b8ec := (*C.fil_GenerateWinningPoStResponse)(mem1405b8ec)
+	allocs1405b8ec := new(cgoAllocMap)
+	allocs1405b8ec.Add(mem1405b8ec)
 
-	var cproof_len_allocs *cgoAllocMap
-	refb3e7638c.proof_len, cproof_len_allocs = (C.size_t)(x.ProofLen), cgoAllocsUnknown
-	allocsb3e7638c.Borrow(cproof_len_allocs)
+	var cerror_msg_allocs *cgoAllocMap
+	ref1405b8ec.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs1405b8ec.Borrow(cerror_msg_allocs)
 
-	var cproof_ptr_allocs *cgoAllocMap
-	refb3e7638c.proof_ptr, cproof_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.ProofPtr)))
-	allocsb3e7638c.Borrow(cproof_ptr_allocs)
+	var cproofs_len_allocs *cgoAllocMap
+	ref1405b8ec.proofs_len, cproofs_len_allocs = (C.size_t)(x.ProofsLen), cgoAllocsUnknown
+	allocs1405b8ec.Borrow(cproofs_len_allocs)
 
-	x.refb3e7638c = refb3e7638c
-	x.allocsb3e7638c = allocsb3e7638c
-	return refb3e7638c, allocsb3e7638c
+	var cproofs_ptr_allocs *cgoAllocMap
+	ref1405b8ec.proofs_ptr, cproofs_ptr_allocs = unpackSFilPoStProof(x.ProofsPtr)
+	allocs1405b8ec.Borrow(cproofs_ptr_allocs)
+
+	var cstatus_code_allocs *cgoAllocMap
+	ref1405b8ec.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs1405b8ec.Borrow(cstatus_code_allocs)
+
+	x.ref1405b8ec = ref1405b8ec
+	x.allocs1405b8ec = allocs1405b8ec
+	return ref1405b8ec, allocs1405b8ec
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilVanillaProof) PassValue() (C.fil_VanillaProof, *cgoAllocMap) {
-	if x.refb3e7638c != nil {
-		return *x.refb3e7638c, nil
+func (x FilGenerateWinningPoStResponse) PassValue() (C.fil_GenerateWinningPoStResponse, *cgoAllocMap) {
+	if x.ref1405b8ec != nil {
+		return *x.ref1405b8ec, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -2200,93 +3657,95 @@ func (x FilVanillaProof) PassValue() (C.fil_VanillaProof, *cgoAllocMap) {
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilVanillaProof) Deref() {
-	if x.refb3e7638c == nil {
+func (x *FilGenerateWinningPoStResponse) Deref() {
+	if x.ref1405b8ec == nil {
 		return
 	}
-	x.ProofLen = (uint)(x.refb3e7638c.proof_len)
-	hxf0d18b7 := (*sliceHeader)(unsafe.Pointer(&x.ProofPtr))
-	hxf0d18b7.Data = unsafe.Pointer(x.refb3e7638c.proof_ptr)
-	hxf0d18b7.Cap = 0x7fffffff
-	// hxf0d18b7.Len = ?
-
+	x.ErrorMsg = packPCharString(x.ref1405b8ec.error_msg)
+	x.ProofsLen = (uint)(x.ref1405b8ec.proofs_len)
+	packSFilPoStProof(x.ProofsPtr, x.ref1405b8ec.proofs_ptr)
+	x.StatusCode = (FCPResponseStatus)(x.ref1405b8ec.status_code)
 }
 
-// allocFilGenerateSingleVanillaProofResponseMemory allocates memory for type C.fil_GenerateSingleVanillaProofResponse in C.
+// allocFilGenerateWinningPoStSectorChallengeMemory allocates memory for type C.fil_GenerateWinningPoStSectorChallenge in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilGenerateSingleVanillaProofResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateSingleVanillaProofResponseValue))
+func allocFilGenerateWinningPoStSectorChallengeMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateWinningPoStSectorChallengeValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilGenerateSingleVanillaProofResponseValue = unsafe.Sizeof([1]C.fil_GenerateSingleVanillaProofResponse{})
+const sizeOfFilGenerateWinningPoStSectorChallengeValue = unsafe.Sizeof([1]C.fil_GenerateWinningPoStSectorChallenge{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilGenerateSingleVanillaProofResponse) Ref() *C.fil_GenerateSingleVanillaProofResponse {
+func (x *FilGenerateWinningPoStSectorChallenge) Ref() *C.fil_GenerateWinningPoStSectorChallenge {
 	if x == nil {
 		return nil
 	}
-	return x.reff9d21b04
+	return x.ref69d2a405
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilGenerateSingleVanillaProofResponse) Free() {
-	if x != nil && x.allocsf9d21b04 != nil {
-		x.allocsf9d21b04.(*cgoAllocMap).Free()
-		x.reff9d21b04 = nil
+func (x *FilGenerateWinningPoStSectorChallenge) Free() {
+	if x != nil && x.allocs69d2a405 != nil {
+		x.allocs69d2a405.(*cgoAllocMap).Free()
+		x.ref69d2a405 = nil
 	}
 }
 
-// NewFilGenerateSingleVanillaProofResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilGenerateWinningPoStSectorChallengeRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilGenerateSingleVanillaProofResponseRef(ref unsafe.Pointer) *FilGenerateSingleVanillaProofResponse {
+func NewFilGenerateWinningPoStSectorChallengeRef(ref unsafe.Pointer) *FilGenerateWinningPoStSectorChallenge {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilGenerateSingleVanillaProofResponse)
-	obj.reff9d21b04 = (*C.fil_GenerateSingleVanillaProofResponse)(unsafe.Pointer(ref))
+	obj := new(FilGenerateWinningPoStSectorChallenge)
+	obj.ref69d2a405 = (*C.fil_GenerateWinningPoStSectorChallenge)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilGenerateSingleVanillaProofResponse) PassRef() (*C.fil_GenerateSingleVanillaProofResponse, *cgoAllocMap) {
+func (x *FilGenerateWinningPoStSectorChallenge) PassRef() (*C.fil_GenerateWinningPoStSectorChallenge, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.reff9d21b04 != nil {
-		return x.reff9d21b04, nil
+	} else if x.ref69d2a405 != nil {
+		return x.ref69d2a405, nil
 	}
-	memf9d21b04 := allocFilGenerateSingleVanillaProofResponseMemory(1)
-	reff9d21b04 := (*C.fil_GenerateSingleVanillaProofResponse)(memf9d21b04)
-	allocsf9d21b04 := new(cgoAllocMap)
-	allocsf9d21b04.Add(memf9d21b04)
+	mem69d2a405 := allocFilGenerateWinningPoStSectorChallengeMemory(1)
+	ref69d2a405 := (*C.fil_GenerateWinningPoStSectorChallenge)(mem69d2a405)
+	allocs69d2a405 := new(cgoAllocMap)
+	allocs69d2a405.Add(mem69d2a405)
 
 	var cerror_msg_allocs *cgoAllocMap
-	reff9d21b04.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocsf9d21b04.Borrow(cerror_msg_allocs)
-
-	var cvanilla_proof_allocs *cgoAllocMap
-	reff9d21b04.vanilla_proof, cvanilla_proof_allocs = x.VanillaProof.PassValue()
-	allocsf9d21b04.Borrow(cvanilla_proof_allocs)
+	ref69d2a405.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs69d2a405.Borrow(cerror_msg_allocs)
 
 	var cstatus_code_allocs *cgoAllocMap
-	reff9d21b04.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocsf9d21b04.Borrow(cstatus_code_allocs)
+	ref69d2a405.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs69d2a405.Borrow(cstatus_code_allocs)
+
+	var cids_ptr_allocs *cgoAllocMap
+	ref69d2a405.ids_ptr, cids_ptr_allocs = copyPUint64TBytes((*sliceHeader)(unsafe.Pointer(&x.IdsPtr)))
+	allocs69d2a405.Borrow(cids_ptr_allocs)
+
+	var cids_len_allocs *cgoAllocMap
+	ref69d2a405.ids_len, cids_len_allocs = (C.size_t)(x.IdsLen), cgoAllocsUnknown
+	allocs69d2a405.Borrow(cids_len_allocs)
 
-	x.reff9d21b04 = reff9d21b04
-	x.allocsf9d21b04 = allocsf9d21b04
-	return reff9d21b04, allocsf9d21b04
+	x.ref69d2a405 = ref69d2a405
+	x.allocs69d2a405 = allocs69d2a405
+	return ref69d2a405, allocs69d2a405
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilGenerateSingleVanillaProofResponse) PassValue() (C.fil_GenerateSingleVanillaProofResponse, *cgoAllocMap) {
-	if x.reff9d21b04 != nil {
-		return *x.reff9d21b04, nil
+func (x FilGenerateWinningPoStSectorChallenge) PassValue() (C.fil_GenerateWinningPoStSectorChallenge, *cgoAllocMap) {
+	if x.ref69d2a405 != nil {
+		return *x.ref69d2a405, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -2294,90 +3753,95 @@ func (x FilGenerateSingleVanillaProofResponse) PassValue() (C.fil_GenerateSingle
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilGenerateSingleVanillaProofResponse) Deref() {
-	if x.reff9d21b04 == nil {
+func (x *FilGenerateWinningPoStSectorChallenge) Deref() {
+	if x.ref69d2a405 == nil {
 		return
 	}
-	x.ErrorMsg = packPCharString(x.reff9d21b04.error_msg)
-	x.VanillaProof = *NewFilVanillaProofRef(unsafe.Pointer(&x.reff9d21b04.vanilla_proof))
-	x.StatusCode = (FCPResponseStatus)(x.reff9d21b04.status_code)
+	x.ErrorMsg = packPCharString(x.ref69d2a405.error_msg)
+	x.StatusCode = (FCPResponseStatus)(x.ref69d2a405.status_code)
+	hxf7a6dff := (*sliceHeader)(unsafe.Pointer(&x.IdsPtr))
+	hxf7a6dff.Data = unsafe.Pointer(x.ref69d2a405.ids_ptr)
+	hxf7a6dff.Cap = 0x7fffffff
+	// hxf7a6dff.Len = ?
+
+	x.IdsLen = (uint)(x.ref69d2a405.ids_len)
 }
 
-// allocFilPartitionSnarkProofMemory allocates memory for type C.fil_PartitionSnarkProof in C.
+// allocFilGetNumPartitionForFallbackPoStResponseMemory allocates memory for type C.fil_GetNumPartitionForFallbackPoStResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilPartitionSnarkProofMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPartitionSnarkProofValue))
+func allocFilGetNumPartitionForFallbackPoStResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGetNumPartitionForFallbackPoStResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilPartitionSnarkProofValue = unsafe.Sizeof([1]C.fil_PartitionSnarkProof{})
+const sizeOfFilGetNumPartitionForFallbackPoStResponseValue = unsafe.Sizeof([1]C.fil_GetNumPartitionForFallbackPoStResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilPartitionSnarkProof) Ref() *C.fil_PartitionSnarkProof {
+func (x *FilGetNumPartitionForFallbackPoStResponse) Ref() *C.fil_GetNumPartitionForFallbackPoStResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref4de03739
+	return x.refc0084478
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilPartitionSnarkProof) Free() {
-	if x != nil && x.allocs4de03739 != nil {
-		x.allocs4de03739.(*cgoAllocMap).Free()
-		x.ref4de03739 = nil
+func (x *FilGetNumPartitionForFallbackPoStResponse) Free() {
+	if x != nil && x.allocsc0084478 != nil {
+		x.allocsc0084478.(*cgoAllocMap).Free()
+		x.refc0084478 = nil
 	}
 }
 
-// NewFilPartitionSnarkProofRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilGetNumPartitionForFallbackPoStResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilPartitionSnarkProofRef(ref unsafe.Pointer) *FilPartitionSnarkProof {
+func NewFilGetNumPartitionForFallbackPoStResponseRef(ref unsafe.Pointer) *FilGetNumPartitionForFallbackPoStResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilPartitionSnarkProof)
-	obj.ref4de03739 = (*C.fil_PartitionSnarkProof)(unsafe.Pointer(ref))
+	obj := new(FilGetNumPartitionForFallbackPoStResponse)
+	obj.refc0084478 = (*C.fil_GetNumPartitionForFallbackPoStResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilPartitionSnarkProof) PassRef() (*C.fil_PartitionSnarkProof, *cgoAllocMap) {
+func (x *FilGetNumPartitionForFallbackPoStResponse) PassRef() (*C.fil_GetNumPartitionForFallbackPoStResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref4de03739 != nil {
-		return x.ref4de03739, nil
+	} else if x.refc0084478 != nil {
+		return x.refc0084478, nil
 	}
-	mem4de03739 := allocFilPartitionSnarkProofMemory(1)
-	ref4de03739 := (*C.fil_PartitionSnarkProof)(mem4de03739)
-	allocs4de03739 := new(cgoAllocMap)
-	allocs4de03739.Add(mem4de03739)
+	memc0084478 := allocFilGetNumPartitionForFallbackPoStResponseMemory(1)
+	refc0084478 := (*C.fil_GetNumPartitionForFallbackPoStResponse)(memc0084478)
+	allocsc0084478 := new(cgoAllocMap)
+	allocsc0084478.Add(memc0084478)
 
-	var cregistered_proof_allocs *cgoAllocMap
-	ref4de03739.registered_proof, cregistered_proof_allocs = (C.fil_RegisteredPoStProof)(x.RegisteredProof), cgoAllocsUnknown
-	allocs4de03739.Borrow(cregistered_proof_allocs)
+	var cerror_msg_allocs *cgoAllocMap
+	refc0084478.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocsc0084478.Borrow(cerror_msg_allocs)
 
-	var cproof_len_allocs *cgoAllocMap
-	ref4de03739.proof_len, cproof_len_allocs = (C.size_t)(x.ProofLen), cgoAllocsUnknown
-	allocs4de03739.Borrow(cproof_len_allocs)
+	var cstatus_code_allocs *cgoAllocMap
+	refc0084478.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocsc0084478.Borrow(cstatus_code_allocs)
 
-	var cproof_ptr_allocs *cgoAllocMap
-	ref4de03739.proof_ptr, cproof_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.ProofPtr)))
-	allocs4de03739.Borrow(cproof_ptr_allocs)
+	var cnum_partition_allocs *cgoAllocMap
+	refc0084478.num_partition, cnum_partition_allocs = (C.size_t)(x.NumPartition), cgoAllocsUnknown
+	allocsc0084478.Borrow(cnum_partition_allocs)
 
-	x.ref4de03739 = ref4de03739
-	x.allocs4de03739 = allocs4de03739
-	return ref4de03739, allocs4de03739
+	x.refc0084478 = refc0084478
+	x.allocsc0084478 = allocsc0084478
+	return refc0084478, allocsc0084478
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilPartitionSnarkProof) PassValue() (C.fil_PartitionSnarkProof, *cgoAllocMap) {
-	if x.ref4de03739 != nil {
-		return *x.ref4de03739, nil
+func (x FilGetNumPartitionForFallbackPoStResponse) PassValue() (C.fil_GetNumPartitionForFallbackPoStResponse, *cgoAllocMap) {
+	if x.refc0084478 != nil {
+		return *x.refc0084478, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -2385,199 +3849,226 @@ func (x FilPartitionSnarkProof) PassValue() (C.fil_PartitionSnarkProof, *cgoAllo
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilPartitionSnarkProof) Deref() {
-	if x.ref4de03739 == nil {
+func (x *FilGetNumPartitionForFallbackPoStResponse) Deref() {
+	if x.refc0084478 == nil {
 		return
 	}
-	x.RegisteredProof = (FilRegisteredPoStProof)(x.ref4de03739.registered_proof)
-	x.ProofLen = (uint)(x.ref4de03739.proof_len)
-	hxf2fab0d := (*sliceHeader)(unsafe.Pointer(&x.ProofPtr))
-	hxf2fab0d.Data = unsafe.Pointer(x.ref4de03739.proof_ptr)
-	hxf2fab0d.Cap = 0x7fffffff
-	// hxf2fab0d.Len = ?
+	x.ErrorMsg = packPCharString(x.refc0084478.error_msg)
+	x.StatusCode = (FCPResponseStatus)(x.refc0084478.status_code)
+	x.NumPartition = (uint)(x.refc0084478.num_partition)
+}
 
+// allocFilGpuDeviceResponseMemory allocates memory for type C.fil_GpuDeviceResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilGpuDeviceResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGpuDeviceResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
 }
 
-// allocFilGenerateSingleWindowPoStWithVanillaResponseMemory allocates memory for type C.fil_GenerateSingleWindowPoStWithVanillaResponse in C.
+const sizeOfFilGpuDeviceResponseValue = unsafe.Sizeof([1]C.fil_GpuDeviceResponse{})
+
+// allocPCharMemory allocates memory for type *C.char in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilGenerateSingleWindowPoStWithVanillaResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateSingleWindowPoStWithVanillaResponseValue))
+func allocPCharMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfPCharValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilGenerateSingleWindowPoStWithVanillaResponseValue = unsafe.Sizeof([1]C.fil_GenerateSingleWindowPoStWithVanillaResponse{})
+const sizeOfPCharValue = unsafe.Sizeof([1]*C.char{})
+
+// unpackSString transforms a sliced Go data structure into plain C format.
+func unpackSString(x []string) (unpacked **C.char, allocs *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	}
+	allocs = new(cgoAllocMap)
+	defer runtime.SetFinalizer(allocs, func(a *cgoAllocMap) {
+		go a.Free()
+	})
+
+	len0 := len(x)
+	mem0 := allocPCharMemory(len0)
+	allocs.Add(mem0)
+	h0 := &sliceHeader{
+		Data: mem0,
+		Cap:  len0,
+		Len:  len0,
+	}
+	v0 := *(*[]*C.char)(unsafe.Pointer(h0))
+	for i0 := range x {
+		v0[i0], _ = unpackPCharString(x[i0])
+	}
+	h := (*sliceHeader)(unsafe.Pointer(&v0))
+	unpacked = (**C.char)(h.Data)
+	return
+}
+
+// packSString reads sliced Go data structure out from plain C format.
+func packSString(v []string, ptr0 **C.char) {
+	const m = 0x7fffffff
+	for i0 := range v {
+		ptr1 := (*(*[m / sizeOfPtr]*C.char)(unsafe.Pointer(ptr0)))[i0]
+		v[i0] = packPCharString(ptr1)
+	}
+}
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilGenerateSingleWindowPoStWithVanillaResponse) Ref() *C.fil_GenerateSingleWindowPoStWithVanillaResponse {
+func (x *FilGpuDeviceResponse) Ref() *C.fil_GpuDeviceResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref96c012c3
+	return x.ref58f92915
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilGenerateSingleWindowPoStWithVanillaResponse) Free() {
-	if x != nil && x.allocs96c012c3 != nil {
-		x.allocs96c012c3.(*cgoAllocMap).Free()
-		x.ref96c012c3 = nil
+func (x *FilGpuDeviceResponse) Free() {
+	if x != nil && x.allocs58f92915 != nil {
+		x.allocs58f92915.(*cgoAllocMap).Free()
+		x.ref58f92915 = nil
 	}
 }
 
-// NewFilGenerateSingleWindowPoStWithVanillaResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilGpuDeviceResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilGenerateSingleWindowPoStWithVanillaResponseRef(ref unsafe.Pointer) *FilGenerateSingleWindowPoStWithVanillaResponse {
+func NewFilGpuDeviceResponseRef(ref unsafe.Pointer) *FilGpuDeviceResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilGenerateSingleWindowPoStWithVanillaResponse)
-	obj.ref96c012c3 = (*C.fil_GenerateSingleWindowPoStWithVanillaResponse)(unsafe.Pointer(ref))
+	obj := new(FilGpuDeviceResponse)
+	obj.ref58f92915 = (*C.fil_GpuDeviceResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilGenerateSingleWindowPoStWithVanillaResponse) PassRef() (*C.fil_GenerateSingleWindowPoStWithVanillaResponse, *cgoAllocMap) {
+func (x *FilGpuDeviceResponse) PassRef() (*C.fil_GpuDeviceResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref96c012c3 != nil {
-		return x.ref96c012c3, nil
+	} else if x.ref58f92915 != nil {
+		return x.ref58f92915, nil
 	}
-	mem96c012c3 := allocFilGenerateSingleWindowPoStWithVanillaResponseMemory(1)
-	ref96c012c3 := (*C.fil_GenerateSingleWindowPoStWithVanillaResponse)(mem96c012c3)
-	allocs96c012c3 := new(cgoAllocMap)
-	allocs96c012c3.Add(mem96c012c3)
-
-	var cerror_msg_allocs *cgoAllocMap
-	ref96c012c3.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs96c012c3.Borrow(cerror_msg_allocs)
+	mem58f92915 := allocFilGpuDeviceResponseMemory(1)
+	ref58f92915 := (*C.fil_GpuDeviceResponse)(mem58f92915)
+	allocs58f92915 := new(cgoAllocMap)
+	allocs58f92915.Add(mem58f92915)
 
-	var cpartition_proof_allocs *cgoAllocMap
-	ref96c012c3.partition_proof, cpartition_proof_allocs = x.PartitionProof.PassValue()
-	allocs96c012c3.Borrow(cpartition_proof_allocs)
+	var cstatus_code_allocs *cgoAllocMap
+	ref58f92915.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs58f92915.Borrow(cstatus_code_allocs)
 
-	var cfaulty_sectors_len_allocs *cgoAllocMap
-	ref96c012c3.faulty_sectors_len, cfaulty_sectors_len_allocs = (C.size_t)(x.FaultySectorsLen), cgoAllocsUnknown
-	allocs96c012c3.Borrow(cfaulty_sectors_len_allocs)
+	var cerror_msg_allocs *cgoAllocMap
+	ref58f92915.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs58f92915.Borrow(cerror_msg_allocs)
 
-	var cfaulty_sectors_ptr_allocs *cgoAllocMap
-	ref96c012c3.faulty_sectors_ptr, cfaulty_sectors_ptr_allocs = copyPUint64TBytes((*sliceHeader)(unsafe.Pointer(&x.FaultySectorsPtr)))
-	allocs96c012c3.Borrow(cfaulty_sectors_ptr_allocs)
+	var cdevices_len_allocs *cgoAllocMap
+	ref58f92915.devices_len, cdevices_len_allocs = (C.size_t)(x.DevicesLen), cgoAllocsUnknown
+	allocs58f92915.Borrow(cdevices_len_allocs)
 
-	var cstatus_code_allocs *cgoAllocMap
-	ref96c012c3.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs96c012c3.Borrow(cstatus_code_allocs)
+	var cdevices_ptr_allocs *cgoAllocMap
+	ref58f92915.devices_ptr, cdevices_ptr_allocs = unpackSString(x.DevicesPtr)
+	allocs58f92915.Borrow(cdevices_ptr_allocs)
 
-	x.ref96c012c3 = ref96c012c3
-	x.allocs96c012c3 = allocs96c012c3
-	return ref96c012c3, allocs96c012c3
+	x.ref58f92915 = ref58f92915
+	x.allocs58f92915 = allocs58f92915
+	return ref58f92915, allocs58f92915
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilGenerateSingleWindowPoStWithVanillaResponse) PassValue() (C.fil_GenerateSingleWindowPoStWithVanillaResponse, *cgoAllocMap) {
-	if x.ref96c012c3 != nil {
-		return *x.ref96c012c3, nil
+func (x FilGpuDeviceResponse) PassValue() (C.fil_GpuDeviceResponse, *cgoAllocMap) {
+	if x.ref58f92915 != nil {
+		return *x.ref58f92915, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
 }
 
-// Deref uses the underlying reference to C object and fills the wrapping struct with values.
-// Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilGenerateSingleWindowPoStWithVanillaResponse) Deref() {
-	if x.ref96c012c3 == nil {
-		return
-	}
-	x.ErrorMsg = packPCharString(x.ref96c012c3.error_msg)
-	x.PartitionProof = *NewFilPartitionSnarkProofRef(unsafe.Pointer(&x.ref96c012c3.partition_proof))
-	x.FaultySectorsLen = (uint)(x.ref96c012c3.faulty_sectors_len)
-	hxf69fe70 := (*sliceHeader)(unsafe.Pointer(&x.FaultySectorsPtr))
-	hxf69fe70.Data = unsafe.Pointer(x.ref96c012c3.faulty_sectors_ptr)
-	hxf69fe70.Cap = 0x7fffffff
-	// hxf69fe70.Len = ?
-
-	x.StatusCode = (FCPResponseStatus)(x.ref96c012c3.status_code)
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilGpuDeviceResponse) Deref() {
+	if x.ref58f92915 == nil {
+		return
+	}
+	x.StatusCode = (FCPResponseStatus)(x.ref58f92915.status_code)
+	x.ErrorMsg = packPCharString(x.ref58f92915.error_msg)
+	x.DevicesLen = (uint)(x.ref58f92915.devices_len)
+	packSString(x.DevicesPtr, x.ref58f92915.devices_ptr)
 }
 
-// allocFilPoStProofMemory allocates memory for type C.fil_PoStProof in C.
+// allocFilBLSDigestMemory allocates memory for type C.fil_BLSDigest in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilPoStProofMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPoStProofValue))
+func allocFilBLSDigestMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilBLSDigestValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilPoStProofValue = unsafe.Sizeof([1]C.fil_PoStProof{})
+const sizeOfFilBLSDigestValue = unsafe.Sizeof([1]C.fil_BLSDigest{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilPoStProof) Ref() *C.fil_PoStProof {
+func (x *FilBLSDigest) Ref() *C.fil_BLSDigest {
 	if x == nil {
 		return nil
 	}
-	return x.ref3451bfa
+	return x.ref215fc78c
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilPoStProof) Free() {
-	if x != nil && x.allocs3451bfa != nil {
-		x.allocs3451bfa.(*cgoAllocMap).Free()
-		x.ref3451bfa = nil
+func (x *FilBLSDigest) Free() {
+	if x != nil && x.allocs215fc78c != nil {
+		x.allocs215fc78c.(*cgoAllocMap).Free()
+		x.ref215fc78c = nil
 	}
 }
 
-// NewFilPoStProofRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilBLSDigestRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilPoStProofRef(ref unsafe.Pointer) *FilPoStProof {
+func NewFilBLSDigestRef(ref unsafe.Pointer) *FilBLSDigest {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilPoStProof)
-	obj.ref3451bfa = (*C.fil_PoStProof)(unsafe.Pointer(ref))
+	obj := new(FilBLSDigest)
+	obj.ref215fc78c = (*C.fil_BLSDigest)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilPoStProof) PassRef() (*C.fil_PoStProof, *cgoAllocMap) {
+func (x *FilBLSDigest) PassRef() (*C.fil_BLSDigest, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref3451bfa != nil {
-		return x.ref3451bfa, nil
+	} else if x.ref215fc78c != nil {
+		return x.ref215fc78c, nil
 	}
-	mem3451bfa := allocFilPoStProofMemory(1)
-	ref3451bfa := (*C.fil_PoStProof)(mem3451bfa)
-	allocs3451bfa := new(cgoAllocMap)
-	allocs3451bfa.Add(mem3451bfa)
-
-	var cregistered_proof_allocs *cgoAllocMap
-	ref3451bfa.registered_proof, cregistered_proof_allocs = (C.fil_RegisteredPoStProof)(x.RegisteredProof), cgoAllocsUnknown
-	allocs3451bfa.Borrow(cregistered_proof_allocs)
-
-	var cproof_len_allocs *cgoAllocMap
-	ref3451bfa.proof_len, cproof_len_allocs = (C.size_t)(x.ProofLen), cgoAllocsUnknown
-	allocs3451bfa.Borrow(cproof_len_allocs)
+	mem215fc78c := allocFilBLSDigestMemory(1)
+	ref215fc78c := (*C.fil_BLSDigest)(mem215fc78c)
+	allocs215fc78c := new(cgoAllocMap)
+	allocs215fc78c.Add(mem215fc78c)
 
-	var cproof_ptr_allocs *cgoAllocMap
-	ref3451bfa.proof_ptr, cproof_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.ProofPtr)))
-	allocs3451bfa.Borrow(cproof_ptr_allocs)
+	var cinner_allocs *cgoAllocMap
+	ref215fc78c.inner, cinner_allocs = *(*[96]C.uint8_t)(unsafe.Pointer(&x.Inner)), cgoAllocsUnknown
+	allocs215fc78c.Borrow(cinner_allocs)
 
-	x.ref3451bfa = ref3451bfa
-	x.allocs3451bfa = allocs3451bfa
-	return ref3451bfa, allocs3451bfa
+	x.ref215fc78c = ref215fc78c
+	x.allocs215fc78c = allocs215fc78c
+	return ref215fc78c, allocs215fc78c
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilPoStProof) PassValue() (C.fil_PoStProof, *cgoAllocMap) {
-	if x.ref3451bfa != nil {
-		return *x.ref3451bfa, nil
+func (x FilBLSDigest) PassValue() (C.fil_BLSDigest, *cgoAllocMap) {
+	if x.ref215fc78c != nil {
+		return *x.ref215fc78c, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -2585,144 +4076,80 @@ func (x FilPoStProof) PassValue() (C.fil_PoStProof, *cgoAllocMap) {
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilPoStProof) Deref() {
-	if x.ref3451bfa == nil {
+func (x *FilBLSDigest) Deref() {
+	if x.ref215fc78c == nil {
 		return
 	}
-	x.RegisteredProof = (FilRegisteredPoStProof)(x.ref3451bfa.registered_proof)
-	x.ProofLen = (uint)(x.ref3451bfa.proof_len)
-	hxf65bf54 := (*sliceHeader)(unsafe.Pointer(&x.ProofPtr))
-	hxf65bf54.Data = unsafe.Pointer(x.ref3451bfa.proof_ptr)
-	hxf65bf54.Cap = 0x7fffffff
-	// hxf65bf54.Len = ?
-
+	x.Inner = *(*[96]byte)(unsafe.Pointer(&x.ref215fc78c.inner))
 }
 
-// allocFilGenerateWindowPoStResponseMemory allocates memory for type C.fil_GenerateWindowPoStResponse in C.
+// allocFilHashResponseMemory allocates memory for type C.fil_HashResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilGenerateWindowPoStResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateWindowPoStResponseValue))
+func allocFilHashResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilHashResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilGenerateWindowPoStResponseValue = unsafe.Sizeof([1]C.fil_GenerateWindowPoStResponse{})
-
-// unpackSFilPoStProof transforms a sliced Go data structure into plain C format.
-func unpackSFilPoStProof(x []FilPoStProof) (unpacked *C.fil_PoStProof, allocs *cgoAllocMap) {
-	if x == nil {
-		return nil, nil
-	}
-	allocs = new(cgoAllocMap)
-	defer runtime.SetFinalizer(allocs, func(a *cgoAllocMap) {
-		go a.Free()
-	})
-
-	len0 := len(x)
-	mem0 := allocFilPoStProofMemory(len0)
-	allocs.Add(mem0)
-	h0 := &sliceHeader{
-		Data: mem0,
-		Cap:  len0,
-		Len:  len0,
-	}
-	v0 := *(*[]C.fil_PoStProof)(unsafe.Pointer(h0))
-	for i0 := range x {
-		allocs0 := new(cgoAllocMap)
-		v0[i0], allocs0 = x[i0].PassValue()
-		allocs.Borrow(allocs0)
-	}
-	h := (*sliceHeader)(unsafe.Pointer(&v0))
-	unpacked = (*C.fil_PoStProof)(h.Data)
-	return
-}
-
-// packSFilPoStProof reads sliced Go data structure out from plain C format.
-func packSFilPoStProof(v []FilPoStProof, ptr0 *C.fil_PoStProof) {
-	const m = 0x7fffffff
-	for i0 := range v {
-		ptr1 := (*(*[m / sizeOfFilPoStProofValue]C.fil_PoStProof)(unsafe.Pointer(ptr0)))[i0]
-		v[i0] = *NewFilPoStProofRef(unsafe.Pointer(&ptr1))
-	}
-}
+const sizeOfFilHashResponseValue = unsafe.Sizeof([1]C.fil_HashResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilGenerateWindowPoStResponse) Ref() *C.fil_GenerateWindowPoStResponse {
+func (x *FilHashResponse) Ref() *C.fil_HashResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref2a5f3ba8
+	return x.refc52a22ef
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilGenerateWindowPoStResponse) Free() {
-	if x != nil && x.allocs2a5f3ba8 != nil {
-		x.allocs2a5f3ba8.(*cgoAllocMap).Free()
-		x.ref2a5f3ba8 = nil
+func (x *FilHashResponse) Free() {
+	if x != nil && x.allocsc52a22ef != nil {
+		x.allocsc52a22ef.(*cgoAllocMap).Free()
+		x.refc52a22ef = nil
 	}
 }
 
-// NewFilGenerateWindowPoStResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilHashResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilGenerateWindowPoStResponseRef(ref unsafe.Pointer) *FilGenerateWindowPoStResponse {
+func NewFilHashResponseRef(ref unsafe.Pointer) *FilHashResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilGenerateWindowPoStResponse)
-	obj.ref2a5f3ba8 = (*C.fil_GenerateWindowPoStResponse)(unsafe.Pointer(ref))
+	obj := new(FilHashResponse)
+	obj.refc52a22ef = (*C.fil_HashResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilGenerateWindowPoStResponse) PassRef() (*C.fil_GenerateWindowPoStResponse, *cgoAllocMap) {
+func (x *FilHashResponse) PassRef() (*C.fil_HashResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref2a5f3ba8 != nil {
-		return x.ref2a5f3ba8, nil
+	} else if x.refc52a22ef != nil {
+		return x.refc52a22ef, nil
 	}
-	mem2a5f3ba8 := allocFilGenerateWindowPoStResponseMemory(1)
-	ref2a5f3ba8 := (*C.fil_GenerateWindowPoStResponse)(mem2a5f3ba8)
-	allocs2a5f3ba8 := new(cgoAllocMap)
-	allocs2a5f3ba8.Add(mem2a5f3ba8)
-
-	var cerror_msg_allocs *cgoAllocMap
-	ref2a5f3ba8.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs2a5f3ba8.Borrow(cerror_msg_allocs)
-
-	var cproofs_len_allocs *cgoAllocMap
-	ref2a5f3ba8.proofs_len, cproofs_len_allocs = (C.size_t)(x.ProofsLen), cgoAllocsUnknown
-	allocs2a5f3ba8.Borrow(cproofs_len_allocs)
-
-	var cproofs_ptr_allocs *cgoAllocMap
-	ref2a5f3ba8.proofs_ptr, cproofs_ptr_allocs = unpackSFilPoStProof(x.ProofsPtr)
-	allocs2a5f3ba8.Borrow(cproofs_ptr_allocs)
-
-	var cfaulty_sectors_len_allocs *cgoAllocMap
-	ref2a5f3ba8.faulty_sectors_len, cfaulty_sectors_len_allocs = (C.size_t)(x.FaultySectorsLen), cgoAllocsUnknown
-	allocs2a5f3ba8.Borrow(cfaulty_sectors_len_allocs)
-
-	var cfaulty_sectors_ptr_allocs *cgoAllocMap
-	ref2a5f3ba8.faulty_sectors_ptr, cfaulty_sectors_ptr_allocs = copyPUint64TBytes((*sliceHeader)(unsafe.Pointer(&x.FaultySectorsPtr)))
-	allocs2a5f3ba8.Borrow(cfaulty_sectors_ptr_allocs)
+	memc52a22ef := allocFilHashResponseMemory(1)
+	refc52a22ef := (*C.fil_HashResponse)(memc52a22ef)
+	allocsc52a22ef := new(cgoAllocMap)
+	allocsc52a22ef.Add(memc52a22ef)
 
-	var cstatus_code_allocs *cgoAllocMap
-	ref2a5f3ba8.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs2a5f3ba8.Borrow(cstatus_code_allocs)
+	var cdigest_allocs *cgoAllocMap
+	refc52a22ef.digest, cdigest_allocs = x.Digest.PassValue()
+	allocsc52a22ef.Borrow(cdigest_allocs)
 
-	x.ref2a5f3ba8 = ref2a5f3ba8
-	x.allocs2a5f3ba8 = allocs2a5f3ba8
-	return ref2a5f3ba8, allocs2a5f3ba8
+	x.refc52a22ef = refc52a22ef
+	x.allocsc52a22ef = allocsc52a22ef
+	return refc52a22ef, allocsc52a22ef
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilGenerateWindowPoStResponse) PassValue() (C.fil_GenerateWindowPoStResponse, *cgoAllocMap) {
-	if x.ref2a5f3ba8 != nil {
-		return *x.ref2a5f3ba8, nil
+func (x FilHashResponse) PassValue() (C.fil_HashResponse, *cgoAllocMap) {
+	if x.refc52a22ef != nil {
+		return *x.refc52a22ef, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -2730,101 +4157,84 @@ func (x FilGenerateWindowPoStResponse) PassValue() (C.fil_GenerateWindowPoStResp
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilGenerateWindowPoStResponse) Deref() {
-	if x.ref2a5f3ba8 == nil {
+func (x *FilHashResponse) Deref() {
+	if x.refc52a22ef == nil {
 		return
 	}
-	x.ErrorMsg = packPCharString(x.ref2a5f3ba8.error_msg)
-	x.ProofsLen = (uint)(x.ref2a5f3ba8.proofs_len)
-	packSFilPoStProof(x.ProofsPtr, x.ref2a5f3ba8.proofs_ptr)
-	x.FaultySectorsLen = (uint)(x.ref2a5f3ba8.faulty_sectors_len)
-	hxf3b8dbd := (*sliceHeader)(unsafe.Pointer(&x.FaultySectorsPtr))
-	hxf3b8dbd.Data = unsafe.Pointer(x.ref2a5f3ba8.faulty_sectors_ptr)
-	hxf3b8dbd.Cap = 0x7fffffff
-	// hxf3b8dbd.Len = ?
-
-	x.StatusCode = (FCPResponseStatus)(x.ref2a5f3ba8.status_code)
+	x.Digest = *NewFilBLSDigestRef(unsafe.Pointer(&x.refc52a22ef.digest))
 }
 
-// allocFilGenerateWinningPoStResponseMemory allocates memory for type C.fil_GenerateWinningPoStResponse in C.
+// allocFilHashVerifyBatchResponseMemory allocates memory for type C.fil_HashVerifyBatchResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilGenerateWinningPoStResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateWinningPoStResponseValue))
+func allocFilHashVerifyBatchResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilHashVerifyBatchResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilGenerateWinningPoStResponseValue = unsafe.Sizeof([1]C.fil_GenerateWinningPoStResponse{})
+const sizeOfFilHashVerifyBatchResponseValue = unsafe.Sizeof([1]C.fil_HashVerifyBatchResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilGenerateWinningPoStResponse) Ref() *C.fil_GenerateWinningPoStResponse {
+func (x *FilHashVerifyBatchResponse) Ref() *C.fil_HashVerifyBatchResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref1405b8ec
+	return x.ref79b20783
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilGenerateWinningPoStResponse) Free() {
-	if x != nil && x.allocs1405b8ec != nil {
-		x.allocs1405b8ec.(*cgoAllocMap).Free()
-		x.ref1405b8ec = nil
+func (x *FilHashVerifyBatchResponse) Free() {
+	if x != nil && x.allocs79b20783 != nil {
+		x.allocs79b20783.(*cgoAllocMap).Free()
+		x.ref79b20783 = nil
 	}
 }
 
-// NewFilGenerateWinningPoStResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilHashVerifyBatchResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilGenerateWinningPoStResponseRef(ref unsafe.Pointer) *FilGenerateWinningPoStResponse {
+func NewFilHashVerifyBatchResponseRef(ref unsafe.Pointer) *FilHashVerifyBatchResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilGenerateWinningPoStResponse)
-	obj.ref1405b8ec = (*C.fil_GenerateWinningPoStResponse)(unsafe.Pointer(ref))
-	return obj
-}
-
-// PassRef returns the underlying C object, otherwise it will allocate one and set its values
-// from this wrapping struct, counting allocations into an allocation map.
-func (x *FilGenerateWinningPoStResponse) PassRef() (*C.fil_GenerateWinningPoStResponse, *cgoAllocMap) {
-	if x == nil {
-		return nil, nil
-	} else if x.ref1405b8ec != nil {
-		return x.ref1405b8ec, nil
-	}
-	mem1405b8ec := allocFilGenerateWinningPoStResponseMemory(1)
-	ref1405b8ec := (*C.fil_GenerateWinningPoStResponse)(mem1405b8ec)
-	allocs1405b8ec := new(cgoAllocMap)
-	allocs1405b8ec.Add(mem1405b8ec)
-
-	var cerror_msg_allocs *cgoAllocMap
-	ref1405b8ec.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs1405b8ec.Borrow(cerror_msg_allocs)
+	obj := new(FilHashVerifyBatchResponse)
+	obj.ref79b20783 = (*C.fil_HashVerifyBatchResponse)(unsafe.Pointer(ref))
+	return obj
+}
 
-	var cproofs_len_allocs *cgoAllocMap
-	ref1405b8ec.proofs_len, cproofs_len_allocs = (C.size_t)(x.ProofsLen), cgoAllocsUnknown
-	allocs1405b8ec.Borrow(cproofs_len_allocs)
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilHashVerifyBatchResponse) PassRef() (*C.fil_HashVerifyBatchResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref79b20783 != nil {
+		return x.ref79b20783, nil
+	}
+	mem79b20783 := allocFilHashVerifyBatchResponseMemory(1)
+	ref79b20783 := (*C.fil_HashVerifyBatchResponse)(mem79b20783)
+	allocs79b20783 := new(cgoAllocMap)
+	allocs79b20783.Add(mem79b20783)
 
-	var cproofs_ptr_allocs *cgoAllocMap
-	ref1405b8ec.proofs_ptr, cproofs_ptr_allocs = unpackSFilPoStProof(x.ProofsPtr)
-	allocs1405b8ec.Borrow(cproofs_ptr_allocs)
+	var cresults_len_allocs *cgoAllocMap
+	ref79b20783.results_len, cresults_len_allocs = (C.size_t)(x.ResultsLen), cgoAllocsUnknown
+	allocs79b20783.Borrow(cresults_len_allocs)
 
-	var cstatus_code_allocs *cgoAllocMap
-	ref1405b8ec.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs1405b8ec.Borrow(cstatus_code_allocs)
+	var cresults_ptr_allocs *cgoAllocMap
+	ref79b20783.results_ptr, cresults_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.ResultsPtr)))
+	allocs79b20783.Borrow(cresults_ptr_allocs)
 
-	x.ref1405b8ec = ref1405b8ec
-	x.allocs1405b8ec = allocs1405b8ec
-	return ref1405b8ec, allocs1405b8ec
+	x.ref79b20783 = ref79b20783
+	x.allocs79b20783 = allocs79b20783
+	return ref79b20783, allocs79b20783
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilGenerateWinningPoStResponse) PassValue() (C.fil_GenerateWinningPoStResponse, *cgoAllocMap) {
-	if x.ref1405b8ec != nil {
-		return *x.ref1405b8ec, nil
+func (x FilHashVerifyBatchResponse) PassValue() (C.fil_HashVerifyBatchResponse, *cgoAllocMap) {
+	if x.ref79b20783 != nil {
+		return *x.ref79b20783, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -2832,95 +4242,89 @@ func (x FilGenerateWinningPoStResponse) PassValue() (C.fil_GenerateWinningPoStRe
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilGenerateWinningPoStResponse) Deref() {
-	if x.ref1405b8ec == nil {
+func (x *FilHashVerifyBatchResponse) Deref() {
+	if x.ref79b20783 == nil {
 		return
 	}
-	x.ErrorMsg = packPCharString(x.ref1405b8ec.error_msg)
-	x.ProofsLen = (uint)(x.ref1405b8ec.proofs_len)
-	packSFilPoStProof(x.ProofsPtr, x.ref1405b8ec.proofs_ptr)
-	x.StatusCode = (FCPResponseStatus)(x.ref1405b8ec.status_code)
+	x.ResultsLen = (uint)(x.ref79b20783.results_len)
+	hxfe48d67 := (*sliceHeader)(unsafe.Pointer(&x.ResultsPtr))
+	hxfe48d67.Data = unsafe.Pointer(x.ref79b20783.results_ptr)
+	hxfe48d67.Cap = 0x7fffffff
+	// hxfe48d67.Len = ?
+
 }
 
-// allocFilGenerateWinningPoStSectorChallengeMemory allocates memory for type C.fil_GenerateWinningPoStSectorChallenge in C.
+// allocFilInitLogFdResponseMemory allocates memory for type C.fil_InitLogFdResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilGenerateWinningPoStSectorChallengeMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGenerateWinningPoStSectorChallengeValue))
+func allocFilInitLogFdResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilInitLogFdResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilGenerateWinningPoStSectorChallengeValue = unsafe.Sizeof([1]C.fil_GenerateWinningPoStSectorChallenge{})
+const sizeOfFilInitLogFdResponseValue = unsafe.Sizeof([1]C.fil_InitLogFdResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilGenerateWinningPoStSectorChallenge) Ref() *C.fil_GenerateWinningPoStSectorChallenge {
+func (x *FilInitLogFdResponse) Ref() *C.fil_InitLogFdResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref69d2a405
+	return x.ref3c1a0a08
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilGenerateWinningPoStSectorChallenge) Free() {
-	if x != nil && x.allocs69d2a405 != nil {
-		x.allocs69d2a405.(*cgoAllocMap).Free()
-		x.ref69d2a405 = nil
+func (x *FilInitLogFdResponse) Free() {
+	if x != nil && x.allocs3c1a0a08 != nil {
+		x.allocs3c1a0a08.(*cgoAllocMap).Free()
+		x.ref3c1a0a08 = nil
 	}
 }
 
-// NewFilGenerateWinningPoStSectorChallengeRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilInitLogFdResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilGenerateWinningPoStSectorChallengeRef(ref unsafe.Pointer) *FilGenerateWinningPoStSectorChallenge {
+func NewFilInitLogFdResponseRef(ref unsafe.Pointer) *FilInitLogFdResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilGenerateWinningPoStSectorChallenge)
-	obj.ref69d2a405 = (*C.fil_GenerateWinningPoStSectorChallenge)(unsafe.Pointer(ref))
+	obj := new(FilInitLogFdResponse)
+	obj.ref3c1a0a08 = (*C.fil_InitLogFdResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilGenerateWinningPoStSectorChallenge) PassRef() (*C.fil_GenerateWinningPoStSectorChallenge, *cgoAllocMap) {
+func (x *FilInitLogFdResponse) PassRef() (*C.fil_InitLogFdResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref69d2a405 != nil {
-		return x.ref69d2a405, nil
+	} else if x.ref3c1a0a08 != nil {
+		return x.ref3c1a0a08, nil
 	}
-	mem69d2a405 := allocFilGenerateWinningPoStSectorChallengeMemory(1)
-	ref69d2a405 := (*C.fil_GenerateWinningPoStSectorChallenge)(mem69d2a405)
-	allocs69d2a405 := new(cgoAllocMap)
-	allocs69d2a405.Add(mem69d2a405)
-
-	var cerror_msg_allocs *cgoAllocMap
-	ref69d2a405.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs69d2a405.Borrow(cerror_msg_allocs)
+	mem3c1a0a08 := allocFilInitLogFdResponseMemory(1)
+	ref3c1a0a08 := (*C.fil_InitLogFdResponse)(mem3c1a0a08)
+	allocs3c1a0a08 := new(cgoAllocMap)
+	allocs3c1a0a08.Add(mem3c1a0a08)
 
 	var cstatus_code_allocs *cgoAllocMap
-	ref69d2a405.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs69d2a405.Borrow(cstatus_code_allocs)
-
-	var cids_ptr_allocs *cgoAllocMap
-	ref69d2a405.ids_ptr, cids_ptr_allocs = copyPUint64TBytes((*sliceHeader)(unsafe.Pointer(&x.IdsPtr)))
-	allocs69d2a405.Borrow(cids_ptr_allocs)
+	ref3c1a0a08.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs3c1a0a08.Borrow(cstatus_code_allocs)
 
-	var cids_len_allocs *cgoAllocMap
-	ref69d2a405.ids_len, cids_len_allocs = (C.size_t)(x.IdsLen), cgoAllocsUnknown
-	allocs69d2a405.Borrow(cids_len_allocs)
+	var cerror_msg_allocs *cgoAllocMap
+	ref3c1a0a08.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs3c1a0a08.Borrow(cerror_msg_allocs)
 
-	x.ref69d2a405 = ref69d2a405
-	x.allocs69d2a405 = allocs69d2a405
-	return ref69d2a405, allocs69d2a405
+	x.ref3c1a0a08 = ref3c1a0a08
+	x.allocs3c1a0a08 = allocs3c1a0a08
+	return ref3c1a0a08, allocs3c1a0a08
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilGenerateWinningPoStSectorChallenge) PassValue() (C.fil_GenerateWinningPoStSectorChallenge, *cgoAllocMap) {
-	if x.ref69d2a405 != nil {
-		return *x.ref69d2a405, nil
+func (x FilInitLogFdResponse) PassValue() (C.fil_InitLogFdResponse, *cgoAllocMap) {
+	if x.ref3c1a0a08 != nil {
+		return *x.ref3c1a0a08, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -2928,95 +4332,89 @@ func (x FilGenerateWinningPoStSectorChallenge) PassValue() (C.fil_GenerateWinnin
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilGenerateWinningPoStSectorChallenge) Deref() {
-	if x.ref69d2a405 == nil {
+func (x *FilInitLogFdResponse) Deref() {
+	if x.ref3c1a0a08 == nil {
 		return
 	}
-	x.ErrorMsg = packPCharString(x.ref69d2a405.error_msg)
-	x.StatusCode = (FCPResponseStatus)(x.ref69d2a405.status_code)
-	hxf7a6dff := (*sliceHeader)(unsafe.Pointer(&x.IdsPtr))
-	hxf7a6dff.Data = unsafe.Pointer(x.ref69d2a405.ids_ptr)
-	hxf7a6dff.Cap = 0x7fffffff
-	// hxf7a6dff.Len = ?
-
-	x.IdsLen = (uint)(x.ref69d2a405.ids_len)
+	x.StatusCode = (FCPResponseStatus)(x.ref3c1a0a08.status_code)
+	x.ErrorMsg = packPCharString(x.ref3c1a0a08.error_msg)
 }
 
-// allocFilGetNumPartitionForFallbackPoStResponseMemory allocates memory for type C.fil_GetNumPartitionForFallbackPoStResponse in C.
+// allocFilMergeWindowPoStPartitionProofsResponseMemory allocates memory for type C.fil_MergeWindowPoStPartitionProofsResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilGetNumPartitionForFallbackPoStResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGetNumPartitionForFallbackPoStResponseValue))
+func allocFilMergeWindowPoStPartitionProofsResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilMergeWindowPoStPartitionProofsResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilGetNumPartitionForFallbackPoStResponseValue = unsafe.Sizeof([1]C.fil_GetNumPartitionForFallbackPoStResponse{})
+const sizeOfFilMergeWindowPoStPartitionProofsResponseValue = unsafe.Sizeof([1]C.fil_MergeWindowPoStPartitionProofsResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilGetNumPartitionForFallbackPoStResponse) Ref() *C.fil_GetNumPartitionForFallbackPoStResponse {
+func (x *FilMergeWindowPoStPartitionProofsResponse) Ref() *C.fil_MergeWindowPoStPartitionProofsResponse {
 	if x == nil {
 		return nil
 	}
-	return x.refc0084478
+	return x.ref3369154e
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilGetNumPartitionForFallbackPoStResponse) Free() {
-	if x != nil && x.allocsc0084478 != nil {
-		x.allocsc0084478.(*cgoAllocMap).Free()
-		x.refc0084478 = nil
+func (x *FilMergeWindowPoStPartitionProofsResponse) Free() {
+	if x != nil && x.allocs3369154e != nil {
+		x.allocs3369154e.(*cgoAllocMap).Free()
+		x.ref3369154e = nil
 	}
 }
 
-// NewFilGetNumPartitionForFallbackPoStResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilMergeWindowPoStPartitionProofsResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilGetNumPartitionForFallbackPoStResponseRef(ref unsafe.Pointer) *FilGetNumPartitionForFallbackPoStResponse {
+func NewFilMergeWindowPoStPartitionProofsResponseRef(ref unsafe.Pointer) *FilMergeWindowPoStPartitionProofsResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilGetNumPartitionForFallbackPoStResponse)
-	obj.refc0084478 = (*C.fil_GetNumPartitionForFallbackPoStResponse)(unsafe.Pointer(ref))
+	obj := new(FilMergeWindowPoStPartitionProofsResponse)
+	obj.ref3369154e = (*C.fil_MergeWindowPoStPartitionProofsResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilGetNumPartitionForFallbackPoStResponse) PassRef() (*C.fil_GetNumPartitionForFallbackPoStResponse, *cgoAllocMap) {
+func (x *FilMergeWindowPoStPartitionProofsResponse) PassRef() (*C.fil_MergeWindowPoStPartitionProofsResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.refc0084478 != nil {
-		return x.refc0084478, nil
+	} else if x.ref3369154e != nil {
+		return x.ref3369154e, nil
 	}
-	memc0084478 := allocFilGetNumPartitionForFallbackPoStResponseMemory(1)
-	refc0084478 := (*C.fil_GetNumPartitionForFallbackPoStResponse)(memc0084478)
-	allocsc0084478 := new(cgoAllocMap)
-	allocsc0084478.Add(memc0084478)
+	mem3369154e := allocFilMergeWindowPoStPartitionProofsResponseMemory(1)
+	ref3369154e := (*C.fil_MergeWindowPoStPartitionProofsResponse)(mem3369154e)
+	allocs3369154e := new(cgoAllocMap)
+	allocs3369154e.Add(mem3369154e)
 
 	var cerror_msg_allocs *cgoAllocMap
-	refc0084478.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocsc0084478.Borrow(cerror_msg_allocs)
+	ref3369154e.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs3369154e.Borrow(cerror_msg_allocs)
 
-	var cstatus_code_allocs *cgoAllocMap
-	refc0084478.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocsc0084478.Borrow(cstatus_code_allocs)
+	var cproof_allocs *cgoAllocMap
+	ref3369154e.proof, cproof_allocs = x.Proof.PassValue()
+	allocs3369154e.Borrow(cproof_allocs)
 
-	var cnum_partition_allocs *cgoAllocMap
-	refc0084478.num_partition, cnum_partition_allocs = (C.size_t)(x.NumPartition), cgoAllocsUnknown
-	allocsc0084478.Borrow(cnum_partition_allocs)
+	var cstatus_code_allocs *cgoAllocMap
+	ref3369154e.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs3369154e.Borrow(cstatus_code_allocs)
 
-	x.refc0084478 = refc0084478
-	x.allocsc0084478 = allocsc0084478
-	return refc0084478, allocsc0084478
+	x.ref3369154e = ref3369154e
+	x.allocs3369154e = allocs3369154e
+	return ref3369154e, allocs3369154e
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilGetNumPartitionForFallbackPoStResponse) PassValue() (C.fil_GetNumPartitionForFallbackPoStResponse, *cgoAllocMap) {
-	if x.refc0084478 != nil {
-		return *x.refc0084478, nil
+func (x FilMergeWindowPoStPartitionProofsResponse) PassValue() (C.fil_MergeWindowPoStPartitionProofsResponse, *cgoAllocMap) {
+	if x.ref3369154e != nil {
+		return *x.ref3369154e, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -3024,142 +4422,82 @@ func (x FilGetNumPartitionForFallbackPoStResponse) PassValue() (C.fil_GetNumPart
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilGetNumPartitionForFallbackPoStResponse) Deref() {
-	if x.refc0084478 == nil {
+func (x *FilMergeWindowPoStPartitionProofsResponse) Deref() {
+	if x.ref3369154e == nil {
 		return
 	}
-	x.ErrorMsg = packPCharString(x.refc0084478.error_msg)
-	x.StatusCode = (FCPResponseStatus)(x.refc0084478.status_code)
-	x.NumPartition = (uint)(x.refc0084478.num_partition)
-}
-
-// allocFilGpuDeviceResponseMemory allocates memory for type C.fil_GpuDeviceResponse in C.
-// The caller is responsible for freeing the this memory via C.free.
-func allocFilGpuDeviceResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilGpuDeviceResponseValue))
-	if mem == nil {
-		panic(fmt.Sprintln("memory alloc error: ", err))
-	}
-	return mem
+	x.ErrorMsg = packPCharString(x.ref3369154e.error_msg)
+	x.Proof = *NewFilPoStProofRef(unsafe.Pointer(&x.ref3369154e.proof))
+	x.StatusCode = (FCPResponseStatus)(x.ref3369154e.status_code)
 }
 
-const sizeOfFilGpuDeviceResponseValue = unsafe.Sizeof([1]C.fil_GpuDeviceResponse{})
-
-// allocPCharMemory allocates memory for type *C.char in C.
+// allocFilPopProveResponseMemory allocates memory for type C.fil_PopProveResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocPCharMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfPCharValue))
+func allocFilPopProveResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPopProveResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfPCharValue = unsafe.Sizeof([1]*C.char{})
-
-// unpackSString transforms a sliced Go data structure into plain C format.
-func unpackSString(x []string) (unpacked **C.char, allocs *cgoAllocMap) {
-	if x == nil {
-		return nil, nil
-	}
-	allocs = new(cgoAllocMap)
-	defer runtime.SetFinalizer(allocs, func(a *cgoAllocMap) {
-		go a.Free()
-	})
-
-	len0 := len(x)
-	mem0 := allocPCharMemory(len0)
-	allocs.Add(mem0)
-	h0 := &sliceHeader{
-		Data: mem0,
-		Cap:  len0,
-		Len:  len0,
-	}
-	v0 := *(*[]*C.char)(unsafe.Pointer(h0))
-	for i0 := range x {
-		v0[i0], _ = unpackPCharString(x[i0])
-	}
-	h := (*sliceHeader)(unsafe.Pointer(&v0))
-	unpacked = (**C.char)(h.Data)
-	return
-}
-
-// packSString reads sliced Go data structure out from plain C format.
-func packSString(v []string, ptr0 **C.char) {
-	const m = 0x7fffffff
-	for i0 := range v {
-		ptr1 := (*(*[m / sizeOfPtr]*C.char)(unsafe.Pointer(ptr0)))[i0]
-		v[i0] = packPCharString(ptr1)
-	}
-}
+const sizeOfFilPopProveResponseValue = unsafe.Sizeof([1]C.fil_PopProveResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilGpuDeviceResponse) Ref() *C.fil_GpuDeviceResponse {
+func (x *FilPopProveResponse) Ref() *C.fil_PopProveResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref58f92915
+	return x.ref1f41bfe9
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
-// Does nothing if struct is nil or has no allocation map.
-func (x *FilGpuDeviceResponse) Free() {
-	if x != nil && x.allocs58f92915 != nil {
-		x.allocs58f92915.(*cgoAllocMap).Free()
-		x.ref58f92915 = nil
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilPopProveResponse) Free() {
+	if x != nil && x.allocs1f41bfe9 != nil {
+		x.allocs1f41bfe9.(*cgoAllocMap).Free()
+		x.ref1f41bfe9 = nil
 	}
 }
 
-// NewFilGpuDeviceResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilPopProveResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilGpuDeviceResponseRef(ref unsafe.Pointer) *FilGpuDeviceResponse {
+func NewFilPopProveResponseRef(ref unsafe.Pointer) *FilPopProveResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilGpuDeviceResponse)
-	obj.ref58f92915 = (*C.fil_GpuDeviceResponse)(unsafe.Pointer(ref))
+	obj := new(FilPopProveResponse)
+	obj.ref1f41bfe9 = (*C.fil_PopProveResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilGpuDeviceResponse) PassRef() (*C.fil_GpuDeviceResponse, *cgoAllocMap) {
+func (x *FilPopProveResponse) PassRef() (*C.fil_PopProveResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref58f92915 != nil {
-		return x.ref58f92915, nil
+	} else if x.ref1f41bfe9 != nil {
+		return x.ref1f41bfe9, nil
 	}
-	mem58f92915 := allocFilGpuDeviceResponseMemory(1)
-	ref58f92915 := (*C.fil_GpuDeviceResponse)(mem58f92915)
-	allocs58f92915 := new(cgoAllocMap)
-	allocs58f92915.Add(mem58f92915)
-
-	var cstatus_code_allocs *cgoAllocMap
-	ref58f92915.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs58f92915.Borrow(cstatus_code_allocs)
+	mem1f41bfe9 := allocFilPopProveResponseMemory(1)
+	ref1f41bfe9 := (*C.fil_PopProveResponse)(mem1f41bfe9)
+	allocs1f41bfe9 := new(cgoAllocMap)
+	allocs1f41bfe9.Add(mem1f41bfe9)
 
-	var cerror_msg_allocs *cgoAllocMap
-	ref58f92915.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs58f92915.Borrow(cerror_msg_allocs)
-
-	var cdevices_len_allocs *cgoAllocMap
-	ref58f92915.devices_len, cdevices_len_allocs = (C.size_t)(x.DevicesLen), cgoAllocsUnknown
-	allocs58f92915.Borrow(cdevices_len_allocs)
-
-	var cdevices_ptr_allocs *cgoAllocMap
-	ref58f92915.devices_ptr, cdevices_ptr_allocs = unpackSString(x.DevicesPtr)
-	allocs58f92915.Borrow(cdevices_ptr_allocs)
+	var csignature_allocs *cgoAllocMap
+	ref1f41bfe9.signature, csignature_allocs = x.Signature.PassValue()
+	allocs1f41bfe9.Borrow(csignature_allocs)
 
-	x.ref58f92915 = ref58f92915
-	x.allocs58f92915 = allocs58f92915
-	return ref58f92915, allocs58f92915
+	x.ref1f41bfe9 = ref1f41bfe9
+	x.allocs1f41bfe9 = allocs1f41bfe9
+	return ref1f41bfe9, allocs1f41bfe9
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilGpuDeviceResponse) PassValue() (C.fil_GpuDeviceResponse, *cgoAllocMap) {
-	if x.ref58f92915 != nil {
-		return *x.ref58f92915, nil
+func (x FilPopProveResponse) PassValue() (C.fil_PopProveResponse, *cgoAllocMap) {
+	if x.ref1f41bfe9 != nil {
+		return *x.ref1f41bfe9, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -3167,83 +4505,80 @@ func (x FilGpuDeviceResponse) PassValue() (C.fil_GpuDeviceResponse, *cgoAllocMap
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilGpuDeviceResponse) Deref() {
-	if x.ref58f92915 == nil {
+func (x *FilPopProveResponse) Deref() {
+	if x.ref1f41bfe9 == nil {
 		return
 	}
-	x.StatusCode = (FCPResponseStatus)(x.ref58f92915.status_code)
-	x.ErrorMsg = packPCharString(x.ref58f92915.error_msg)
-	x.DevicesLen = (uint)(x.ref58f92915.devices_len)
-	packSString(x.DevicesPtr, x.ref58f92915.devices_ptr)
+	x.Signature = *NewFilBLSSignatureRef(unsafe.Pointer(&x.ref1f41bfe9.signature))
 }
 
-// allocFilBLSDigestMemory allocates memory for type C.fil_BLSDigest in C.
+// allocFilPrivateKeyGenerateResponseMemory allocates memory for type C.fil_PrivateKeyGenerateResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilBLSDigestMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilBLSDigestValue))
+func allocFilPrivateKeyGenerateResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPrivateKeyGenerateResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilBLSDigestValue = unsafe.Sizeof([1]C.fil_BLSDigest{})
+const sizeOfFilPrivateKeyGenerateResponseValue = unsafe.Sizeof([1]C.fil_PrivateKeyGenerateResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilBLSDigest) Ref() *C.fil_BLSDigest {
+func (x *FilPrivateKeyGenerateResponse) Ref() *C.fil_PrivateKeyGenerateResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref215fc78c
+	return x.ref2dba09f
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilBLSDigest) Free() {
-	if x != nil && x.allocs215fc78c != nil {
-		x.allocs215fc78c.(*cgoAllocMap).Free()
-		x.ref215fc78c = nil
+func (x *FilPrivateKeyGenerateResponse) Free() {
+	if x != nil && x.allocs2dba09f != nil {
+		x.allocs2dba09f.(*cgoAllocMap).Free()
+		x.ref2dba09f = nil
 	}
 }
 
-// NewFilBLSDigestRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilPrivateKeyGenerateResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilBLSDigestRef(ref unsafe.Pointer) *FilBLSDigest {
+func NewFilPrivateKeyGenerateResponseRef(ref unsafe.Pointer) *FilPrivateKeyGenerateResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilBLSDigest)
-	obj.ref215fc78c = (*C.fil_BLSDigest)(unsafe.Pointer(ref))
+	obj := new(FilPrivateKeyGenerateResponse)
+	obj.ref2dba09f = (*C.fil_PrivateKeyGenerateResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilBLSDigest) PassRef() (*C.fil_BLSDigest, *cgoAllocMap) {
+func (x *FilPrivateKeyGenerateResponse) PassRef() (*C.fil_PrivateKeyGenerateResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref215fc78c != nil {
-		return x.ref215fc78c, nil
+	} else if x.ref2dba09f != nil {
+		return x.ref2dba09f, nil
 	}
-	mem215fc78c := allocFilBLSDigestMemory(1)
-	ref215fc78c := (*C.fil_BLSDigest)(mem215fc78c)
-	allocs215fc78c := new(cgoAllocMap)
-	allocs215fc78c.Add(mem215fc78c)
+	mem2dba09f := allocFilPrivateKeyGenerateResponseMemory(1)
+	ref2dba09f := (*C.fil_PrivateKeyGenerateResponse)(mem2dba09f)
+	allocs2dba09f := new(cgoAllocMap)
+	allocs2dba09f.Add(mem2dba09f)
 
-	var cinner_allocs *cgoAllocMap
-	ref215fc78c.inner, cinner_allocs = *(*[96]C.uint8_t)(unsafe.Pointer(&x.Inner)), cgoAllocsUnknown
-	allocs215fc78c.Borrow(cinner_allocs)
+	var cprivate_key_allocs *cgoAllocMap
+	ref2dba09f.private_key, cprivate_key_allocs = x.PrivateKey.PassValue()
+	allocs2dba09f.Borrow(cprivate_key_allocs)
 
-	x.ref215fc78c = ref215fc78c
-	x.allocs215fc78c = allocs215fc78c
-	return ref215fc78c, allocs215fc78c
+	x.ref2dba09f = ref2dba09f
+	x.allocs2dba09f = allocs2dba09f
+	return ref2dba09f, allocs2dba09f
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilBLSDigest) PassValue() (C.fil_BLSDigest, *cgoAllocMap) {
-	if x.ref215fc78c != nil {
-		return *x.ref215fc78c, nil
+func (x FilPrivateKeyGenerateResponse) PassValue() (C.fil_PrivateKeyGenerateResponse, *cgoAllocMap) {
+	if x.ref2dba09f != nil {
+		return *x.ref2dba09f, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -3251,80 +4586,80 @@ func (x FilBLSDigest) PassValue() (C.fil_BLSDigest, *cgoAllocMap) {
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilBLSDigest) Deref() {
-	if x.ref215fc78c == nil {
+func (x *FilPrivateKeyGenerateResponse) Deref() {
+	if x.ref2dba09f == nil {
 		return
 	}
-	x.Inner = *(*[96]byte)(unsafe.Pointer(&x.ref215fc78c.inner))
+	x.PrivateKey = *NewFilBLSPrivateKeyRef(unsafe.Pointer(&x.ref2dba09f.private_key))
 }
 
-// allocFilHashResponseMemory allocates memory for type C.fil_HashResponse in C.
+// allocFilBLSPublicKeyG2Memory allocates memory for type C.fil_BLSPublicKeyG2 in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilHashResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilHashResponseValue))
+func allocFilBLSPublicKeyG2Memory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilBLSPublicKeyG2Value))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilHashResponseValue = unsafe.Sizeof([1]C.fil_HashResponse{})
+const sizeOfFilBLSPublicKeyG2Value = unsafe.Sizeof([1]C.fil_BLSPublicKeyG2{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilHashResponse) Ref() *C.fil_HashResponse {
+func (x *FilBLSPublicKeyG2) Ref() *C.fil_BLSPublicKeyG2 {
 	if x == nil {
 		return nil
 	}
-	return x.refc52a22ef
+	return x.ref16ddcc43
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilHashResponse) Free() {
-	if x != nil && x.allocsc52a22ef != nil {
-		x.allocsc52a22ef.(*cgoAllocMap).Free()
-		x.refc52a22ef = nil
+func (x *FilBLSPublicKeyG2) Free() {
+	if x != nil && x.allocs16ddcc43 != nil {
+		x.allocs16ddcc43.(*cgoAllocMap).Free()
+		x.ref16ddcc43 = nil
 	}
 }
 
-// NewFilHashResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilBLSPublicKeyG2Ref creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilHashResponseRef(ref unsafe.Pointer) *FilHashResponse {
+func NewFilBLSPublicKeyG2Ref(ref unsafe.Pointer) *FilBLSPublicKeyG2 {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilHashResponse)
-	obj.refc52a22ef = (*C.fil_HashResponse)(unsafe.Pointer(ref))
+	obj := new(FilBLSPublicKeyG2)
+	obj.ref16ddcc43 = (*C.fil_BLSPublicKeyG2)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilHashResponse) PassRef() (*C.fil_HashResponse, *cgoAllocMap) {
+func (x *FilBLSPublicKeyG2) PassRef() (*C.fil_BLSPublicKeyG2, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.refc52a22ef != nil {
-		return x.refc52a22ef, nil
+	} else if x.ref16ddcc43 != nil {
+		return x.ref16ddcc43, nil
 	}
-	memc52a22ef := allocFilHashResponseMemory(1)
-	refc52a22ef := (*C.fil_HashResponse)(memc52a22ef)
-	allocsc52a22ef := new(cgoAllocMap)
-	allocsc52a22ef.Add(memc52a22ef)
+	mem16ddcc43 := allocFilBLSPublicKeyG2Memory(1)
+	ref16ddcc43 := (*C.fil_BLSPublicKeyG2)(mem16ddcc43)
+	allocs16ddcc43 := new(cgoAllocMap)
+	allocs16ddcc43.Add(mem16ddcc43)
 
-	var cdigest_allocs *cgoAllocMap
-	refc52a22ef.digest, cdigest_allocs = x.Digest.PassValue()
-	allocsc52a22ef.Borrow(cdigest_allocs)
+	var cinner_allocs *cgoAllocMap
+	ref16ddcc43.inner, cinner_allocs = *(*[96]C.uint8_t)(unsafe.Pointer(&x.Inner)), cgoAllocsUnknown
+	allocs16ddcc43.Borrow(cinner_allocs)
 
-	x.refc52a22ef = refc52a22ef
-	x.allocsc52a22ef = allocsc52a22ef
-	return refc52a22ef, allocsc52a22ef
+	x.ref16ddcc43 = ref16ddcc43
+	x.allocs16ddcc43 = allocs16ddcc43
+	return ref16ddcc43, allocs16ddcc43
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilHashResponse) PassValue() (C.fil_HashResponse, *cgoAllocMap) {
-	if x.refc52a22ef != nil {
-		return *x.refc52a22ef, nil
+func (x FilBLSPublicKeyG2) PassValue() (C.fil_BLSPublicKeyG2, *cgoAllocMap) {
+	if x.ref16ddcc43 != nil {
+		return *x.ref16ddcc43, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -3332,84 +4667,80 @@ func (x FilHashResponse) PassValue() (C.fil_HashResponse, *cgoAllocMap) {
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilHashResponse) Deref() {
-	if x.refc52a22ef == nil {
+func (x *FilBLSPublicKeyG2) Deref() {
+	if x.ref16ddcc43 == nil {
 		return
 	}
-	x.Digest = *NewFilBLSDigestRef(unsafe.Pointer(&x.refc52a22ef.digest))
+	x.Inner = *(*[96]byte)(unsafe.Pointer(&x.ref16ddcc43.inner))
 }
 
-// allocFilInitLogFdResponseMemory allocates memory for type C.fil_InitLogFdResponse in C.
+// allocFilPrivateKeyPublicKeyG2ResponseMemory allocates memory for type C.fil_PrivateKeyPublicKeyG2Response in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilInitLogFdResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilInitLogFdResponseValue))
+func allocFilPrivateKeyPublicKeyG2ResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPrivateKeyPublicKeyG2ResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilInitLogFdResponseValue = unsafe.Sizeof([1]C.fil_InitLogFdResponse{})
+const sizeOfFilPrivateKeyPublicKeyG2ResponseValue = unsafe.Sizeof([1]C.fil_PrivateKeyPublicKeyG2Response{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilInitLogFdResponse) Ref() *C.fil_InitLogFdResponse {
+func (x *FilPrivateKeyPublicKeyG2Response) Ref() *C.fil_PrivateKeyPublicKeyG2Response {
 	if x == nil {
 		return nil
 	}
-	return x.ref3c1a0a08
+	return x.ref64cfade
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilInitLogFdResponse) Free() {
-	if x != nil && x.allocs3c1a0a08 != nil {
-		x.allocs3c1a0a08.(*cgoAllocMap).Free()
-		x.ref3c1a0a08 = nil
+func (x *FilPrivateKeyPublicKeyG2Response) Free() {
+	if x != nil && x.allocs64cfade != nil {
+		x.allocs64cfade.(*cgoAllocMap).Free()
+		x.ref64cfade = nil
 	}
 }
 
-// NewFilInitLogFdResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilPrivateKeyPublicKeyG2ResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilInitLogFdResponseRef(ref unsafe.Pointer) *FilInitLogFdResponse {
+func NewFilPrivateKeyPublicKeyG2ResponseRef(ref unsafe.Pointer) *FilPrivateKeyPublicKeyG2Response {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilInitLogFdResponse)
-	obj.ref3c1a0a08 = (*C.fil_InitLogFdResponse)(unsafe.Pointer(ref))
+	obj := new(FilPrivateKeyPublicKeyG2Response)
+	obj.ref64cfade = (*C.fil_PrivateKeyPublicKeyG2Response)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilInitLogFdResponse) PassRef() (*C.fil_InitLogFdResponse, *cgoAllocMap) {
+func (x *FilPrivateKeyPublicKeyG2Response) PassRef() (*C.fil_PrivateKeyPublicKeyG2Response, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref3c1a0a08 != nil {
-		return x.ref3c1a0a08, nil
+	} else if x.ref64cfade != nil {
+		return x.ref64cfade, nil
 	}
-	mem3c1a0a08 := allocFilInitLogFdResponseMemory(1)
-	ref3c1a0a08 := (*C.fil_InitLogFdResponse)(mem3c1a0a08)
-	allocs3c1a0a08 := new(cgoAllocMap)
-	allocs3c1a0a08.Add(mem3c1a0a08)
-
-	var cstatus_code_allocs *cgoAllocMap
-	ref3c1a0a08.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs3c1a0a08.Borrow(cstatus_code_allocs)
+	mem64cfade := allocFilPrivateKeyPublicKeyG2ResponseMemory(1)
+	ref64cfade := (*C.fil_PrivateKeyPublicKeyG2Response)(mem64cfade)
+	allocs64cfade := new(cgoAllocMap)
+	allocs64cfade.Add(mem64cfade)
 
-	var cerror_msg_allocs *cgoAllocMap
-	ref3c1a0a08.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs3c1a0a08.Borrow(cerror_msg_allocs)
+	var cpublic_key_allocs *cgoAllocMap
+	ref64cfade.public_key, cpublic_key_allocs = x.PublicKey.PassValue()
+	allocs64cfade.Borrow(cpublic_key_allocs)
 
-	x.ref3c1a0a08 = ref3c1a0a08
-	x.allocs3c1a0a08 = allocs3c1a0a08
-	return ref3c1a0a08, allocs3c1a0a08
+	x.ref64cfade = ref64cfade
+	x.allocs64cfade = allocs64cfade
+	return ref64cfade, allocs64cfade
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilInitLogFdResponse) PassValue() (C.fil_InitLogFdResponse, *cgoAllocMap) {
-	if x.ref3c1a0a08 != nil {
-		return *x.ref3c1a0a08, nil
+func (x FilPrivateKeyPublicKeyG2Response) PassValue() (C.fil_PrivateKeyPublicKeyG2Response, *cgoAllocMap) {
+	if x.ref64cfade != nil {
+		return *x.ref64cfade, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -3417,89 +4748,92 @@ func (x FilInitLogFdResponse) PassValue() (C.fil_InitLogFdResponse, *cgoAllocMap
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilInitLogFdResponse) Deref() {
-	if x.ref3c1a0a08 == nil {
+func (x *FilPrivateKeyPublicKeyG2Response) Deref() {
+	if x.ref64cfade == nil {
 		return
 	}
-	x.StatusCode = (FCPResponseStatus)(x.ref3c1a0a08.status_code)
-	x.ErrorMsg = packPCharString(x.ref3c1a0a08.error_msg)
+	x.PublicKey = *NewFilBLSPublicKeyG2Ref(unsafe.Pointer(&x.ref64cfade.public_key))
 }
 
-// allocFilMergeWindowPoStPartitionProofsResponseMemory allocates memory for type C.fil_MergeWindowPoStPartitionProofsResponse in C.
+// allocFilPrivateKeysPublicKeysResponseMemory allocates memory for type C.fil_PrivateKeysPublicKeysResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilMergeWindowPoStPartitionProofsResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilMergeWindowPoStPartitionProofsResponseValue))
+func allocFilPrivateKeysPublicKeysResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPrivateKeysPublicKeysResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilMergeWindowPoStPartitionProofsResponseValue = unsafe.Sizeof([1]C.fil_MergeWindowPoStPartitionProofsResponse{})
+const sizeOfFilPrivateKeysPublicKeysResponseValue = unsafe.Sizeof([1]C.fil_PrivateKeysPublicKeysResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilMergeWindowPoStPartitionProofsResponse) Ref() *C.fil_MergeWindowPoStPartitionProofsResponse {
+func (x *FilPrivateKeysPublicKeysResponse) Ref() *C.fil_PrivateKeysPublicKeysResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref3369154e
+	return x.refcf91c784
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilMergeWindowPoStPartitionProofsResponse) Free() {
-	if x != nil && x.allocs3369154e != nil {
-		x.allocs3369154e.(*cgoAllocMap).Free()
-		x.ref3369154e = nil
+func (x *FilPrivateKeysPublicKeysResponse) Free() {
+	if x != nil && x.allocscf91c784 != nil {
+		x.allocscf91c784.(*cgoAllocMap).Free()
+		x.refcf91c784 = nil
 	}
 }
 
-// NewFilMergeWindowPoStPartitionProofsResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilPrivateKeysPublicKeysResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilMergeWindowPoStPartitionProofsResponseRef(ref unsafe.Pointer) *FilMergeWindowPoStPartitionProofsResponse {
+func NewFilPrivateKeysPublicKeysResponseRef(ref unsafe.Pointer) *FilPrivateKeysPublicKeysResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilMergeWindowPoStPartitionProofsResponse)
-	obj.ref3369154e = (*C.fil_MergeWindowPoStPartitionProofsResponse)(unsafe.Pointer(ref))
+	obj := new(FilPrivateKeysPublicKeysResponse)
+	obj.refcf91c784 = (*C.fil_PrivateKeysPublicKeysResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilMergeWindowPoStPartitionProofsResponse) PassRef() (*C.fil_MergeWindowPoStPartitionProofsResponse, *cgoAllocMap) {
+func (x *FilPrivateKeysPublicKeysResponse) PassRef() (*C.fil_PrivateKeysPublicKeysResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref3369154e != nil {
-		return x.ref3369154e, nil
+	} else if x.refcf91c784 != nil {
+		return x.refcf91c784, nil
 	}
-	mem3369154e := allocFilMergeWindowPoStPartitionProofsResponseMemory(1)
-	ref3369154e := (*C.fil_MergeWindowPoStPartitionProofsResponse)(mem3369154e)
-	allocs3369154e := new(cgoAllocMap)
-	allocs3369154e.Add(mem3369154e)
+	memcf91c784 := allocFilPrivateKeysPublicKeysResponseMemory(1)
+	refcf91c784 := (*C.fil_PrivateKeysPublicKeysResponse)(memcf91c784)
+	allocscf91c784 := new(cgoAllocMap)
+	allocscf91c784.Add(memcf91c784)
 
-	var cerror_msg_allocs *cgoAllocMap
-	ref3369154e.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs3369154e.Borrow(cerror_msg_allocs)
+	var cpublic_keys_len_allocs *cgoAllocMap
+	refcf91c784.public_keys_len, cpublic_keys_len_allocs = (C.size_t)(x.PublicKeysLen), cgoAllocsUnknown
+	allocscf91c784.Borrow(cpublic_keys_len_allocs)
 
-	var cproof_allocs *cgoAllocMap
-	ref3369154e.proof, cproof_allocs = x.Proof.PassValue()
-	allocs3369154e.Borrow(cproof_allocs)
+	var cpublic_keys_ptr_allocs *cgoAllocMap
+	refcf91c784.public_keys_ptr, cpublic_keys_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.PublicKeysPtr)))
+	allocscf91c784.Borrow(cpublic_keys_ptr_allocs)
 
-	var cstatus_code_allocs *cgoAllocMap
-	ref3369154e.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs3369154e.Borrow(cstatus_code_allocs)
+	var chas_invalid_key_allocs *cgoAllocMap
+	refcf91c784.has_invalid_key, chas_invalid_key_allocs = (C._Bool)(x.HasInvalidKey), cgoAllocsUnknown
+	allocscf91c784.Borrow(chas_invalid_key_allocs)
 
-	x.ref3369154e = ref3369154e
-	x.allocs3369154e = allocs3369154e
-	return ref3369154e, allocs3369154e
+	var cinvalid_index_allocs *cgoAllocMap
+	refcf91c784.invalid_index, cinvalid_index_allocs = (C.size_t)(x.InvalidIndex), cgoAllocsUnknown
+	allocscf91c784.Borrow(cinvalid_index_allocs)
+
+	x.refcf91c784 = refcf91c784
+	x.allocscf91c784 = allocscf91c784
+	return refcf91c784, allocscf91c784
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilMergeWindowPoStPartitionProofsResponse) PassValue() (C.fil_MergeWindowPoStPartitionProofsResponse, *cgoAllocMap) {
-	if x.ref3369154e != nil {
-		return *x.ref3369154e, nil
+func (x FilPrivateKeysPublicKeysResponse) PassValue() (C.fil_PrivateKeysPublicKeysResponse, *cgoAllocMap) {
+	if x.refcf91c784 != nil {
+		return *x.refcf91c784, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -3507,82 +4841,87 @@ func (x FilMergeWindowPoStPartitionProofsResponse) PassValue() (C.fil_MergeWindo
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilMergeWindowPoStPartitionProofsResponse) Deref() {
-	if x.ref3369154e == nil {
+func (x *FilPrivateKeysPublicKeysResponse) Deref() {
+	if x.refcf91c784 == nil {
 		return
 	}
-	x.ErrorMsg = packPCharString(x.ref3369154e.error_msg)
-	x.Proof = *NewFilPoStProofRef(unsafe.Pointer(&x.ref3369154e.proof))
-	x.StatusCode = (FCPResponseStatus)(x.ref3369154e.status_code)
+	x.PublicKeysLen = (uint)(x.refcf91c784.public_keys_len)
+	hxf4171bf := (*sliceHeader)(unsafe.Pointer(&x.PublicKeysPtr))
+	hxf4171bf.Data = unsafe.Pointer(x.refcf91c784.public_keys_ptr)
+	hxf4171bf.Cap = 0x7fffffff
+	// hxf4171bf.Len = ?
+
+	x.HasInvalidKey = (bool)(x.refcf91c784.has_invalid_key)
+	x.InvalidIndex = (uint)(x.refcf91c784.invalid_index)
 }
 
-// allocFilBLSPrivateKeyMemory allocates memory for type C.fil_BLSPrivateKey in C.
+// allocFilPublicKeyFromUncompressedResponseMemory allocates memory for type C.fil_PublicKeyFromUncompressedResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilBLSPrivateKeyMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilBLSPrivateKeyValue))
+func allocFilPublicKeyFromUncompressedResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPublicKeyFromUncompressedResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilBLSPrivateKeyValue = unsafe.Sizeof([1]C.fil_BLSPrivateKey{})
+const sizeOfFilPublicKeyFromUncompressedResponseValue = unsafe.Sizeof([1]C.fil_PublicKeyFromUncompressedResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilBLSPrivateKey) Ref() *C.fil_BLSPrivateKey {
+func (x *FilPublicKeyFromUncompressedResponse) Ref() *C.fil_PublicKeyFromUncompressedResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref2f77fe3a
+	return x.ref1947e439
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilBLSPrivateKey) Free() {
-	if x != nil && x.allocs2f77fe3a != nil {
-		x.allocs2f77fe3a.(*cgoAllocMap).Free()
-		x.ref2f77fe3a = nil
+func (x *FilPublicKeyFromUncompressedResponse) Free() {
+	if x != nil && x.allocs1947e439 != nil {
+		x.allocs1947e439.(*cgoAllocMap).Free()
+		x.ref1947e439 = nil
 	}
 }
 
-// NewFilBLSPrivateKeyRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilPublicKeyFromUncompressedResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilBLSPrivateKeyRef(ref unsafe.Pointer) *FilBLSPrivateKey {
+func NewFilPublicKeyFromUncompressedResponseRef(ref unsafe.Pointer) *FilPublicKeyFromUncompressedResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilBLSPrivateKey)
-	obj.ref2f77fe3a = (*C.fil_BLSPrivateKey)(unsafe.Pointer(ref))
+	obj := new(FilPublicKeyFromUncompressedResponse)
+	obj.ref1947e439 = (*C.fil_PublicKeyFromUncompressedResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilBLSPrivateKey) PassRef() (*C.fil_BLSPrivateKey, *cgoAllocMap) {
+func (x *FilPublicKeyFromUncompressedResponse) PassRef() (*C.fil_PublicKeyFromUncompressedResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref2f77fe3a != nil {
-		return x.ref2f77fe3a, nil
+	} else if x.ref1947e439 != nil {
+		return x.ref1947e439, nil
 	}
-	mem2f77fe3a := allocFilBLSPrivateKeyMemory(1)
-	ref2f77fe3a := (*C.fil_BLSPrivateKey)(mem2f77fe3a)
-	allocs2f77fe3a := new(cgoAllocMap)
-	allocs2f77fe3a.Add(mem2f77fe3a)
+	mem1947e439 := allocFilPublicKeyFromUncompressedResponseMemory(1)
+	ref1947e439 := (*C.fil_PublicKeyFromUncompressedResponse)(mem1947e439)
+	allocs1947e439 := new(cgoAllocMap)
+	allocs1947e439.Add(mem1947e439)
 
-	var cinner_allocs *cgoAllocMap
-	ref2f77fe3a.inner, cinner_allocs = *(*[32]C.uint8_t)(unsafe.Pointer(&x.Inner)), cgoAllocsUnknown
-	allocs2f77fe3a.Borrow(cinner_allocs)
+	var cpublic_key_allocs *cgoAllocMap
+	ref1947e439.public_key, cpublic_key_allocs = x.PublicKey.PassValue()
+	allocs1947e439.Borrow(cpublic_key_allocs)
 
-	x.ref2f77fe3a = ref2f77fe3a
-	x.allocs2f77fe3a = allocs2f77fe3a
-	return ref2f77fe3a, allocs2f77fe3a
+	x.ref1947e439 = ref1947e439
+	x.allocs1947e439 = allocs1947e439
+	return ref1947e439, allocs1947e439
 
 }
 
-// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilBLSPrivateKey) PassValue() (C.fil_BLSPrivateKey, *cgoAllocMap) {
-	if x.ref2f77fe3a != nil {
-		return *x.ref2f77fe3a, nil
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilPublicKeyFromUncompressedResponse) PassValue() (C.fil_PublicKeyFromUncompressedResponse, *cgoAllocMap) {
+	if x.ref1947e439 != nil {
+		return *x.ref1947e439, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -3590,80 +4929,80 @@ func (x FilBLSPrivateKey) PassValue() (C.fil_BLSPrivateKey, *cgoAllocMap) {
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilBLSPrivateKey) Deref() {
-	if x.ref2f77fe3a == nil {
+func (x *FilPublicKeyFromUncompressedResponse) Deref() {
+	if x.ref1947e439 == nil {
 		return
 	}
-	x.Inner = *(*[32]byte)(unsafe.Pointer(&x.ref2f77fe3a.inner))
+	x.PublicKey = *NewFilBLSPublicKeyRef(unsafe.Pointer(&x.ref1947e439.public_key))
 }
 
-// allocFilPrivateKeyGenerateResponseMemory allocates memory for type C.fil_PrivateKeyGenerateResponse in C.
+// allocFilBLSUncompressedPublicKeyMemory allocates memory for type C.fil_BLSUncompressedPublicKey in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilPrivateKeyGenerateResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPrivateKeyGenerateResponseValue))
+func allocFilBLSUncompressedPublicKeyMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilBLSUncompressedPublicKeyValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilPrivateKeyGenerateResponseValue = unsafe.Sizeof([1]C.fil_PrivateKeyGenerateResponse{})
+const sizeOfFilBLSUncompressedPublicKeyValue = unsafe.Sizeof([1]C.fil_BLSUncompressedPublicKey{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilPrivateKeyGenerateResponse) Ref() *C.fil_PrivateKeyGenerateResponse {
+func (x *FilBLSUncompressedPublicKey) Ref() *C.fil_BLSUncompressedPublicKey {
 	if x == nil {
 		return nil
 	}
-	return x.ref2dba09f
+	return x.ref940a2dfa
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilPrivateKeyGenerateResponse) Free() {
-	if x != nil && x.allocs2dba09f != nil {
-		x.allocs2dba09f.(*cgoAllocMap).Free()
-		x.ref2dba09f = nil
+func (x *FilBLSUncompressedPublicKey) Free() {
+	if x != nil && x.allocs940a2dfa != nil {
+		x.allocs940a2dfa.(*cgoAllocMap).Free()
+		x.ref940a2dfa = nil
 	}
 }
 
-// NewFilPrivateKeyGenerateResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilBLSUncompressedPublicKeyRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilPrivateKeyGenerateResponseRef(ref unsafe.Pointer) *FilPrivateKeyGenerateResponse {
+func NewFilBLSUncompressedPublicKeyRef(ref unsafe.Pointer) *FilBLSUncompressedPublicKey {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilPrivateKeyGenerateResponse)
-	obj.ref2dba09f = (*C.fil_PrivateKeyGenerateResponse)(unsafe.Pointer(ref))
+	obj := new(FilBLSUncompressedPublicKey)
+	obj.ref940a2dfa = (*C.fil_BLSUncompressedPublicKey)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilPrivateKeyGenerateResponse) PassRef() (*C.fil_PrivateKeyGenerateResponse, *cgoAllocMap) {
+func (x *FilBLSUncompressedPublicKey) PassRef() (*C.fil_BLSUncompressedPublicKey, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref2dba09f != nil {
-		return x.ref2dba09f, nil
+	} else if x.ref940a2dfa != nil {
+		return x.ref940a2dfa, nil
 	}
-	mem2dba09f := allocFilPrivateKeyGenerateResponseMemory(1)
-	ref2dba09f := (*C.fil_PrivateKeyGenerateResponse)(mem2dba09f)
-	allocs2dba09f := new(cgoAllocMap)
-	allocs2dba09f.Add(mem2dba09f)
+	mem940a2dfa := allocFilBLSUncompressedPublicKeyMemory(1)
+	ref940a2dfa := (*C.fil_BLSUncompressedPublicKey)(mem940a2dfa)
+	allocs940a2dfa := new(cgoAllocMap)
+	allocs940a2dfa.Add(mem940a2dfa)
 
-	var cprivate_key_allocs *cgoAllocMap
-	ref2dba09f.private_key, cprivate_key_allocs = x.PrivateKey.PassValue()
-	allocs2dba09f.Borrow(cprivate_key_allocs)
+	var cinner_allocs *cgoAllocMap
+	ref940a2dfa.inner, cinner_allocs = *(*[96]C.uint8_t)(unsafe.Pointer(&x.Inner)), cgoAllocsUnknown
+	allocs940a2dfa.Borrow(cinner_allocs)
 
-	x.ref2dba09f = ref2dba09f
-	x.allocs2dba09f = allocs2dba09f
-	return ref2dba09f, allocs2dba09f
+	x.ref940a2dfa = ref940a2dfa
+	x.allocs940a2dfa = allocs940a2dfa
+	return ref940a2dfa, allocs940a2dfa
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilPrivateKeyGenerateResponse) PassValue() (C.fil_PrivateKeyGenerateResponse, *cgoAllocMap) {
-	if x.ref2dba09f != nil {
-		return *x.ref2dba09f, nil
+func (x FilBLSUncompressedPublicKey) PassValue() (C.fil_BLSUncompressedPublicKey, *cgoAllocMap) {
+	if x.ref940a2dfa != nil {
+		return *x.ref940a2dfa, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -3671,80 +5010,80 @@ func (x FilPrivateKeyGenerateResponse) PassValue() (C.fil_PrivateKeyGenerateResp
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilPrivateKeyGenerateResponse) Deref() {
-	if x.ref2dba09f == nil {
+func (x *FilBLSUncompressedPublicKey) Deref() {
+	if x.ref940a2dfa == nil {
 		return
 	}
-	x.PrivateKey = *NewFilBLSPrivateKeyRef(unsafe.Pointer(&x.ref2dba09f.private_key))
+	x.Inner = *(*[96]byte)(unsafe.Pointer(&x.ref940a2dfa.inner))
 }
 
-// allocFilBLSPublicKeyMemory allocates memory for type C.fil_BLSPublicKey in C.
+// allocFilPublicKeyToUncompressedResponseMemory allocates memory for type C.fil_PublicKeyToUncompressedResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilBLSPublicKeyMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilBLSPublicKeyValue))
+func allocFilPublicKeyToUncompressedResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPublicKeyToUncompressedResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilBLSPublicKeyValue = unsafe.Sizeof([1]C.fil_BLSPublicKey{})
+const sizeOfFilPublicKeyToUncompressedResponseValue = unsafe.Sizeof([1]C.fil_PublicKeyToUncompressedResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilBLSPublicKey) Ref() *C.fil_BLSPublicKey {
+func (x *FilPublicKeyToUncompressedResponse) Ref() *C.fil_PublicKeyToUncompressedResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref6d0cab13
+	return x.ref35e9846c
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilBLSPublicKey) Free() {
-	if x != nil && x.allocs6d0cab13 != nil {
-		x.allocs6d0cab13.(*cgoAllocMap).Free()
-		x.ref6d0cab13 = nil
+func (x *FilPublicKeyToUncompressedResponse) Free() {
+	if x != nil && x.allocs35e9846c != nil {
+		x.allocs35e9846c.(*cgoAllocMap).Free()
+		x.ref35e9846c = nil
 	}
 }
 
-// NewFilBLSPublicKeyRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilPublicKeyToUncompressedResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilBLSPublicKeyRef(ref unsafe.Pointer) *FilBLSPublicKey {
+func NewFilPublicKeyToUncompressedResponseRef(ref unsafe.Pointer) *FilPublicKeyToUncompressedResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilBLSPublicKey)
-	obj.ref6d0cab13 = (*C.fil_BLSPublicKey)(unsafe.Pointer(ref))
+	obj := new(FilPublicKeyToUncompressedResponse)
+	obj.ref35e9846c = (*C.fil_PublicKeyToUncompressedResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilBLSPublicKey) PassRef() (*C.fil_BLSPublicKey, *cgoAllocMap) {
+func (x *FilPublicKeyToUncompressedResponse) PassRef() (*C.fil_PublicKeyToUncompressedResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref6d0cab13 != nil {
-		return x.ref6d0cab13, nil
+	} else if x.ref35e9846c != nil {
+		return x.ref35e9846c, nil
 	}
-	mem6d0cab13 := allocFilBLSPublicKeyMemory(1)
-	ref6d0cab13 := (*C.fil_BLSPublicKey)(mem6d0cab13)
-	allocs6d0cab13 := new(cgoAllocMap)
-	allocs6d0cab13.Add(mem6d0cab13)
+	mem35e9846c := allocFilPublicKeyToUncompressedResponseMemory(1)
+	ref35e9846c := (*C.fil_PublicKeyToUncompressedResponse)(mem35e9846c)
+	allocs35e9846c := new(cgoAllocMap)
+	allocs35e9846c.Add(mem35e9846c)
 
-	var cinner_allocs *cgoAllocMap
-	ref6d0cab13.inner, cinner_allocs = *(*[48]C.uint8_t)(unsafe.Pointer(&x.Inner)), cgoAllocsUnknown
-	allocs6d0cab13.Borrow(cinner_allocs)
+	var cpublic_key_allocs *cgoAllocMap
+	ref35e9846c.public_key, cpublic_key_allocs = x.PublicKey.PassValue()
+	allocs35e9846c.Borrow(cpublic_key_allocs)
 
-	x.ref6d0cab13 = ref6d0cab13
-	x.allocs6d0cab13 = allocs6d0cab13
-	return ref6d0cab13, allocs6d0cab13
+	x.ref35e9846c = ref35e9846c
+	x.allocs35e9846c = allocs35e9846c
+	return ref35e9846c, allocs35e9846c
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilBLSPublicKey) PassValue() (C.fil_BLSPublicKey, *cgoAllocMap) {
-	if x.ref6d0cab13 != nil {
-		return *x.ref6d0cab13, nil
+func (x FilPublicKeyToUncompressedResponse) PassValue() (C.fil_PublicKeyToUncompressedResponse, *cgoAllocMap) {
+	if x.ref35e9846c != nil {
+		return *x.ref35e9846c, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -3752,80 +5091,92 @@ func (x FilBLSPublicKey) PassValue() (C.fil_BLSPublicKey, *cgoAllocMap) {
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilBLSPublicKey) Deref() {
-	if x.ref6d0cab13 == nil {
+func (x *FilPublicKeyToUncompressedResponse) Deref() {
+	if x.ref35e9846c == nil {
 		return
 	}
-	x.Inner = *(*[48]byte)(unsafe.Pointer(&x.ref6d0cab13.inner))
+	x.PublicKey = *NewFilBLSUncompressedPublicKeyRef(unsafe.Pointer(&x.ref35e9846c.public_key))
 }
 
-// allocFilPrivateKeyPublicKeyResponseMemory allocates memory for type C.fil_PrivateKeyPublicKeyResponse in C.
+// allocFilSealCommitPhase1ResponseMemory allocates memory for type C.fil_SealCommitPhase1Response in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilPrivateKeyPublicKeyResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPrivateKeyPublicKeyResponseValue))
+func allocFilSealCommitPhase1ResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilSealCommitPhase1ResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilPrivateKeyPublicKeyResponseValue = unsafe.Sizeof([1]C.fil_PrivateKeyPublicKeyResponse{})
+const sizeOfFilSealCommitPhase1ResponseValue = unsafe.Sizeof([1]C.fil_SealCommitPhase1Response{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilPrivateKeyPublicKeyResponse) Ref() *C.fil_PrivateKeyPublicKeyResponse {
+func (x *FilSealCommitPhase1Response) Ref() *C.fil_SealCommitPhase1Response {
 	if x == nil {
 		return nil
 	}
-	return x.refee14e59d
+	return x.ref61ed8561
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilPrivateKeyPublicKeyResponse) Free() {
-	if x != nil && x.allocsee14e59d != nil {
-		x.allocsee14e59d.(*cgoAllocMap).Free()
-		x.refee14e59d = nil
+func (x *FilSealCommitPhase1Response) Free() {
+	if x != nil && x.allocs61ed8561 != nil {
+		x.allocs61ed8561.(*cgoAllocMap).Free()
+		x.ref61ed8561 = nil
 	}
 }
 
-// NewFilPrivateKeyPublicKeyResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilSealCommitPhase1ResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilPrivateKeyPublicKeyResponseRef(ref unsafe.Pointer) *FilPrivateKeyPublicKeyResponse {
+func NewFilSealCommitPhase1ResponseRef(ref unsafe.Pointer) *FilSealCommitPhase1Response {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilPrivateKeyPublicKeyResponse)
-	obj.refee14e59d = (*C.fil_PrivateKeyPublicKeyResponse)(unsafe.Pointer(ref))
+	obj := new(FilSealCommitPhase1Response)
+	obj.ref61ed8561 = (*C.fil_SealCommitPhase1Response)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilPrivateKeyPublicKeyResponse) PassRef() (*C.fil_PrivateKeyPublicKeyResponse, *cgoAllocMap) {
+func (x *FilSealCommitPhase1Response) PassRef() (*C.fil_SealCommitPhase1Response, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.refee14e59d != nil {
-		return x.refee14e59d, nil
+	} else if x.ref61ed8561 != nil {
+		return x.ref61ed8561, nil
 	}
-	memee14e59d := allocFilPrivateKeyPublicKeyResponseMemory(1)
-	refee14e59d := (*C.fil_PrivateKeyPublicKeyResponse)(memee14e59d)
-	allocsee14e59d := new(cgoAllocMap)
-	allocsee14e59d.Add(memee14e59d)
+	mem61ed8561 := allocFilSealCommitPhase1ResponseMemory(1)
+	ref61ed8561 := (*C.fil_SealCommitPhase1Response)(mem61ed8561)
+	allocs61ed8561 := new(cgoAllocMap)
+	allocs61ed8561.Add(mem61ed8561)
 
-	var cpublic_key_allocs *cgoAllocMap
-	refee14e59d.public_key, cpublic_key_allocs = x.PublicKey.PassValue()
-	allocsee14e59d.Borrow(cpublic_key_allocs)
+	var cstatus_code_allocs *cgoAllocMap
+	ref61ed8561.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocs61ed8561.Borrow(cstatus_code_allocs)
 
-	x.refee14e59d = refee14e59d
-	x.allocsee14e59d = allocsee14e59d
-	return refee14e59d, allocsee14e59d
+	var cerror_msg_allocs *cgoAllocMap
+	ref61ed8561.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocs61ed8561.Borrow(cerror_msg_allocs)
+
+	var cseal_commit_phase1_output_ptr_allocs *cgoAllocMap
+	ref61ed8561.seal_commit_phase1_output_ptr, cseal_commit_phase1_output_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.SealCommitPhase1OutputPtr)))
+	allocs61ed8561.Borrow(cseal_commit_phase1_output_ptr_allocs)
+
+	var cseal_commit_phase1_output_len_allocs *cgoAllocMap
+	ref61ed8561.seal_commit_phase1_output_len, cseal_commit_phase1_output_len_allocs = (C.size_t)(x.SealCommitPhase1OutputLen), cgoAllocsUnknown
+	allocs61ed8561.Borrow(cseal_commit_phase1_output_len_allocs)
+
+	x.ref61ed8561 = ref61ed8561
+	x.allocs61ed8561 = allocs61ed8561
+	return ref61ed8561, allocs61ed8561
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilPrivateKeyPublicKeyResponse) PassValue() (C.fil_PrivateKeyPublicKeyResponse, *cgoAllocMap) {
-	if x.refee14e59d != nil {
-		return *x.refee14e59d, nil
+func (x FilSealCommitPhase1Response) PassValue() (C.fil_SealCommitPhase1Response, *cgoAllocMap) {
+	if x.ref61ed8561 != nil {
+		return *x.ref61ed8561, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -3833,80 +5184,91 @@ func (x FilPrivateKeyPublicKeyResponse) PassValue() (C.fil_PrivateKeyPublicKeyRe
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilPrivateKeyPublicKeyResponse) Deref() {
-	if x.refee14e59d == nil {
+func (x *FilSealCommitPhase1Response) Deref() {
+	if x.ref61ed8561 == nil {
 		return
 	}
-	x.PublicKey = *NewFilBLSPublicKeyRef(unsafe.Pointer(&x.refee14e59d.public_key))
+	x.StatusCode = (FCPResponseStatus)(x.ref61ed8561.status_code)
+	x.ErrorMsg = packPCharString(x.ref61ed8561.error_msg)
+	hxf058b18 := (*sliceHeader)(unsafe.Pointer(&x.SealCommitPhase1OutputPtr))
+	hxf058b18.Data = unsafe.Pointer(x.ref61ed8561.seal_commit_phase1_output_ptr)
+	hxf058b18.Cap = 0x7fffffff
+	// hxf058b18.Len = ?
+
+	x.SealCommitPhase1OutputLen = (uint)(x.ref61ed8561.seal_commit_phase1_output_len)
 }
 
-// allocFilPrivateKeySignResponseMemory allocates memory for type C.fil_PrivateKeySignResponse in C.
+// allocFilSealCommitPhase1ToFileResponseMemory allocates memory for type C.fil_SealCommitPhase1ToFileResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilPrivateKeySignResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilPrivateKeySignResponseValue))
+func allocFilSealCommitPhase1ToFileResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilSealCommitPhase1ToFileResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilPrivateKeySignResponseValue = unsafe.Sizeof([1]C.fil_PrivateKeySignResponse{})
+const sizeOfFilSealCommitPhase1ToFileResponseValue = unsafe.Sizeof([1]C.fil_SealCommitPhase1ToFileResponse{})
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilPrivateKeySignResponse) Ref() *C.fil_PrivateKeySignResponse {
+func (x *FilSealCommitPhase1ToFileResponse) Ref() *C.fil_SealCommitPhase1ToFileResponse {
 	if x == nil {
 		return nil
 	}
-	return x.refcdf97b28
+	return x.refcddc8aad
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilPrivateKeySignResponse) Free() {
-	if x != nil && x.allocscdf97b28 != nil {
-		x.allocscdf97b28.(*cgoAllocMap).Free()
-		x.refcdf97b28 = nil
+func (x *FilSealCommitPhase1ToFileResponse) Free() {
+	if x != nil && x.allocscddc8aad != nil {
+		x.allocscddc8aad.(*cgoAllocMap).Free()
+		x.refcddc8aad = nil
 	}
 }
 
-// NewFilPrivateKeySignResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilSealCommitPhase1ToFileResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilPrivateKeySignResponseRef(ref unsafe.Pointer) *FilPrivateKeySignResponse {
+func NewFilSealCommitPhase1ToFileResponseRef(ref unsafe.Pointer) *FilSealCommitPhase1ToFileResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilPrivateKeySignResponse)
-	obj.refcdf97b28 = (*C.fil_PrivateKeySignResponse)(unsafe.Pointer(ref))
+	obj := new(FilSealCommitPhase1ToFileResponse)
+	obj.refcddc8aad = (*C.fil_SealCommitPhase1ToFileResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilPrivateKeySignResponse) PassRef() (*C.fil_PrivateKeySignResponse, *cgoAllocMap) {
+func (x *FilSealCommitPhase1ToFileResponse) PassRef() (*C.fil_SealCommitPhase1ToFileResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.refcdf97b28 != nil {
-		return x.refcdf97b28, nil
+	} else if x.refcddc8aad != nil {
+		return x.refcddc8aad, nil
 	}
-	memcdf97b28 := allocFilPrivateKeySignResponseMemory(1)
-	refcdf97b28 := (*C.fil_PrivateKeySignResponse)(memcdf97b28)
-	allocscdf97b28 := new(cgoAllocMap)
-	allocscdf97b28.Add(memcdf97b28)
+	memcddc8aad := allocFilSealCommitPhase1ToFileResponseMemory(1)
+	refcddc8aad := (*C.fil_SealCommitPhase1ToFileResponse)(memcddc8aad)
+	allocscddc8aad := new(cgoAllocMap)
+	allocscddc8aad.Add(memcddc8aad)
 
-	var csignature_allocs *cgoAllocMap
-	refcdf97b28.signature, csignature_allocs = x.Signature.PassValue()
-	allocscdf97b28.Borrow(csignature_allocs)
+	var cstatus_code_allocs *cgoAllocMap
+	refcddc8aad.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocscddc8aad.Borrow(cstatus_code_allocs)
 
-	x.refcdf97b28 = refcdf97b28
-	x.allocscdf97b28 = allocscdf97b28
-	return refcdf97b28, allocscdf97b28
+	var cerror_msg_allocs *cgoAllocMap
+	refcddc8aad.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocscddc8aad.Borrow(cerror_msg_allocs)
+
+	x.refcddc8aad = refcddc8aad
+	x.allocscddc8aad = allocscddc8aad
+	return refcddc8aad, allocscddc8aad
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilPrivateKeySignResponse) PassValue() (C.fil_PrivateKeySignResponse, *cgoAllocMap) {
-	if x.refcdf97b28 != nil {
-		return *x.refcdf97b28, nil
+func (x FilSealCommitPhase1ToFileResponse) PassValue() (C.fil_SealCommitPhase1ToFileResponse, *cgoAllocMap) {
+	if x.refcddc8aad != nil {
+		return *x.refcddc8aad, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -3914,92 +5276,131 @@ func (x FilPrivateKeySignResponse) PassValue() (C.fil_PrivateKeySignResponse, *c
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilPrivateKeySignResponse) Deref() {
-	if x.refcdf97b28 == nil {
+func (x *FilSealCommitPhase1ToFileResponse) Deref() {
+	if x.refcddc8aad == nil {
 		return
 	}
-	x.Signature = *NewFilBLSSignatureRef(unsafe.Pointer(&x.refcdf97b28.signature))
+	x.StatusCode = (FCPResponseStatus)(x.refcddc8aad.status_code)
+	x.ErrorMsg = packPCharString(x.refcddc8aad.error_msg)
 }
 
-// allocFilSealCommitPhase1ResponseMemory allocates memory for type C.fil_SealCommitPhase1Response in C.
+// allocFilSealCommitPhase2BatchResponseMemory allocates memory for type C.fil_SealCommitPhase2BatchResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
-func allocFilSealCommitPhase1ResponseMemory(n int) unsafe.Pointer {
-	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilSealCommitPhase1ResponseValue))
+func allocFilSealCommitPhase2BatchResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilSealCommitPhase2BatchResponseValue))
 	if mem == nil {
 		panic(fmt.Sprintln("memory alloc error: ", err))
 	}
 	return mem
 }
 
-const sizeOfFilSealCommitPhase1ResponseValue = unsafe.Sizeof([1]C.fil_SealCommitPhase1Response{})
+const sizeOfFilSealCommitPhase2BatchResponseValue = unsafe.Sizeof([1]C.fil_SealCommitPhase2BatchResponse{})
+
+// unpackSFilSealCommitPhase2Response transforms a sliced Go data structure into plain C format.
+func unpackSFilSealCommitPhase2Response(x []FilSealCommitPhase2Response) (unpacked *C.fil_SealCommitPhase2Response, allocs *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	}
+	allocs = new(cgoAllocMap)
+	defer runtime.SetFinalizer(allocs, func(a *cgoAllocMap) {
+		go a.Free()
+	})
+
+	len0 := len(x)
+	mem0 := allocFilSealCommitPhase2ResponseMemory(len0)
+	allocs.Add(mem0)
+	h0 := &sliceHeader{
+		Data: mem0,
+		Cap:  len0,
+		Len:  len0,
+	}
+	v0 := *(*[]C.fil_SealCommitPhase2Response)(unsafe.Pointer(h0))
+	for i0 := range x {
+		allocs0 := new(cgoAllocMap)
+		v0[i0], allocs0 = x[i0].PassValue()
+		allocs.Borrow(allocs0)
+	}
+	h := (*sliceHeader)(unsafe.Pointer(&v0))
+	unpacked = (*C.fil_SealCommitPhase2Response)(h.Data)
+	return
+}
+
+// packSFilSealCommitPhase2Response reads sliced Go data structure out from plain C format.
+func packSFilSealCommitPhase2Response(v []FilSealCommitPhase2Response, ptr0 *C.fil_SealCommitPhase2Response) {
+	const m = 0x7fffffff
+	for i0 := range v {
+		ptr1 := (*(*[m / sizeOfFilSealCommitPhase2ResponseValue]C.fil_SealCommitPhase2Response)(unsafe.Pointer(ptr0)))[i0]
+		v[i0] = *NewFilSealCommitPhase2ResponseRef(unsafe.Pointer(&ptr1))
+	}
+}
 
 // Ref returns the underlying reference to C object or nil if struct is nil.
-func (x *FilSealCommitPhase1Response) Ref() *C.fil_SealCommitPhase1Response {
+func (x *FilSealCommitPhase2BatchResponse) Ref() *C.fil_SealCommitPhase2BatchResponse {
 	if x == nil {
 		return nil
 	}
-	return x.ref61ed8561
+	return x.refcd7f5c31
 }
 
 // Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
 // Does nothing if struct is nil or has no allocation map.
-func (x *FilSealCommitPhase1Response) Free() {
-	if x != nil && x.allocs61ed8561 != nil {
-		x.allocs61ed8561.(*cgoAllocMap).Free()
-		x.ref61ed8561 = nil
+func (x *FilSealCommitPhase2BatchResponse) Free() {
+	if x != nil && x.allocscd7f5c31 != nil {
+		x.allocscd7f5c31.(*cgoAllocMap).Free()
+		x.refcd7f5c31 = nil
 	}
 }
 
-// NewFilSealCommitPhase1ResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// NewFilSealCommitPhase2BatchResponseRef creates a new wrapper struct with underlying reference set to the original C object.
 // Returns nil if the provided pointer to C object is nil too.
-func NewFilSealCommitPhase1ResponseRef(ref unsafe.Pointer) *FilSealCommitPhase1Response {
+func NewFilSealCommitPhase2BatchResponseRef(ref unsafe.Pointer) *FilSealCommitPhase2BatchResponse {
 	if ref == nil {
 		return nil
 	}
-	obj := new(FilSealCommitPhase1Response)
-	obj.ref61ed8561 = (*C.fil_SealCommitPhase1Response)(unsafe.Pointer(ref))
+	obj := new(FilSealCommitPhase2BatchResponse)
+	obj.refcd7f5c31 = (*C.fil_SealCommitPhase2BatchResponse)(unsafe.Pointer(ref))
 	return obj
 }
 
 // PassRef returns the underlying C object, otherwise it will allocate one and set its values
 // from this wrapping struct, counting allocations into an allocation map.
-func (x *FilSealCommitPhase1Response) PassRef() (*C.fil_SealCommitPhase1Response, *cgoAllocMap) {
+func (x *FilSealCommitPhase2BatchResponse) PassRef() (*C.fil_SealCommitPhase2BatchResponse, *cgoAllocMap) {
 	if x == nil {
 		return nil, nil
-	} else if x.ref61ed8561 != nil {
-		return x.ref61ed8561, nil
+	} else if x.refcd7f5c31 != nil {
+		return x.refcd7f5c31, nil
 	}
-	mem61ed8561 := allocFilSealCommitPhase1ResponseMemory(1)
-	ref61ed8561 := (*C.fil_SealCommitPhase1Response)(mem61ed8561)
-	allocs61ed8561 := new(cgoAllocMap)
-	allocs61ed8561.Add(mem61ed8561)
+	memcd7f5c31 := allocFilSealCommitPhase2BatchResponseMemory(1)
+	refcd7f5c31 := (*C.fil_SealCommitPhase2BatchResponse)(memcd7f5c31)
+	allocscd7f5c31 := new(cgoAllocMap)
+	allocscd7f5c31.Add(memcd7f5c31)
 
 	var cstatus_code_allocs *cgoAllocMap
-	ref61ed8561.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
-	allocs61ed8561.Borrow(cstatus_code_allocs)
+	refcd7f5c31.status_code, cstatus_code_allocs = (C.FCPResponseStatus)(x.StatusCode), cgoAllocsUnknown
+	allocscd7f5c31.Borrow(cstatus_code_allocs)
 
 	var cerror_msg_allocs *cgoAllocMap
-	ref61ed8561.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
-	allocs61ed8561.Borrow(cerror_msg_allocs)
+	refcd7f5c31.error_msg, cerror_msg_allocs = unpackPCharString(x.ErrorMsg)
+	allocscd7f5c31.Borrow(cerror_msg_allocs)
 
-	var cseal_commit_phase1_output_ptr_allocs *cgoAllocMap
-	ref61ed8561.seal_commit_phase1_output_ptr, cseal_commit_phase1_output_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.SealCommitPhase1OutputPtr)))
-	allocs61ed8561.Borrow(cseal_commit_phase1_output_ptr_allocs)
+	var cresults_ptr_allocs *cgoAllocMap
+	refcd7f5c31.results_ptr, cresults_ptr_allocs = unpackSFilSealCommitPhase2Response(x.ResultsPtr)
+	allocscd7f5c31.Borrow(cresults_ptr_allocs)
 
-	var cseal_commit_phase1_output_len_allocs *cgoAllocMap
-	ref61ed8561.seal_commit_phase1_output_len, cseal_commit_phase1_output_len_allocs = (C.size_t)(x.SealCommitPhase1OutputLen), cgoAllocsUnknown
-	allocs61ed8561.Borrow(cseal_commit_phase1_output_len_allocs)
+	var cresults_len_allocs *cgoAllocMap
+	refcd7f5c31.results_len, cresults_len_allocs = (C.size_t)(x.ResultsLen), cgoAllocsUnknown
+	allocscd7f5c31.Borrow(cresults_len_allocs)
 
-	x.ref61ed8561 = ref61ed8561
-	x.allocs61ed8561 = allocs61ed8561
-	return ref61ed8561, allocs61ed8561
+	x.refcd7f5c31 = refcd7f5c31
+	x.allocscd7f5c31 = allocscd7f5c31
+	return refcd7f5c31, allocscd7f5c31
 
 }
 
 // PassValue does the same as PassRef except that it will try to dereference the returned pointer.
-func (x FilSealCommitPhase1Response) PassValue() (C.fil_SealCommitPhase1Response, *cgoAllocMap) {
-	if x.ref61ed8561 != nil {
-		return *x.ref61ed8561, nil
+func (x FilSealCommitPhase2BatchResponse) PassValue() (C.fil_SealCommitPhase2BatchResponse, *cgoAllocMap) {
+	if x.refcd7f5c31 != nil {
+		return *x.refcd7f5c31, nil
 	}
 	ref, allocs := x.PassRef()
 	return *ref, allocs
@@ -4007,18 +5408,14 @@ func (x FilSealCommitPhase1Response) PassValue() (C.fil_SealCommitPhase1Response
 
 // Deref uses the underlying reference to C object and fills the wrapping struct with values.
 // Do not forget to call this method whether you get a struct for C object and want to read its values.
-func (x *FilSealCommitPhase1Response) Deref() {
-	if x.ref61ed8561 == nil {
+func (x *FilSealCommitPhase2BatchResponse) Deref() {
+	if x.refcd7f5c31 == nil {
 		return
 	}
-	x.StatusCode = (FCPResponseStatus)(x.ref61ed8561.status_code)
-	x.ErrorMsg = packPCharString(x.ref61ed8561.error_msg)
-	hxfe48d67 := (*sliceHeader)(unsafe.Pointer(&x.SealCommitPhase1OutputPtr))
-	hxfe48d67.Data = unsafe.Pointer(x.ref61ed8561.seal_commit_phase1_output_ptr)
-	hxfe48d67.Cap = 0x7fffffff
-	// hxfe48d67.Len = ?
-
-	x.SealCommitPhase1OutputLen = (uint)(x.ref61ed8561.seal_commit_phase1_output_len)
+	x.StatusCode = (FCPResponseStatus)(x.refcd7f5c31.status_code)
+	x.ErrorMsg = packPCharString(x.refcd7f5c31.error_msg)
+	packSFilSealCommitPhase2Response(x.ResultsPtr, x.refcd7f5c31.results_ptr)
+	x.ResultsLen = (uint)(x.refcd7f5c31.results_len)
 }
 
 // allocFilSealPreCommitPhase1ResponseMemory allocates memory for type C.fil_SealPreCommitPhase1Response in C.
@@ -4113,10 +5510,10 @@ func (x *FilSealPreCommitPhase1Response) Deref() {
 	}
 	x.ErrorMsg = packPCharString(x.ref132bbfd8.error_msg)
 	x.StatusCode = (FCPResponseStatus)(x.ref132bbfd8.status_code)
-	hxf4171bf := (*sliceHeader)(unsafe.Pointer(&x.SealPreCommitPhase1OutputPtr))
-	hxf4171bf.Data = unsafe.Pointer(x.ref132bbfd8.seal_pre_commit_phase1_output_ptr)
-	hxf4171bf.Cap = 0x7fffffff
-	// hxf4171bf.Len = ?
+	hxff6bc57 := (*sliceHeader)(unsafe.Pointer(&x.SealPreCommitPhase1OutputPtr))
+	hxff6bc57.Data = unsafe.Pointer(x.ref132bbfd8.seal_pre_commit_phase1_output_ptr)
+	hxff6bc57.Cap = 0x7fffffff
+	// hxff6bc57.Len = ?
 
 	x.SealPreCommitPhase1OutputLen = (uint)(x.ref132bbfd8.seal_pre_commit_phase1_output_len)
 }
@@ -4222,6 +5619,249 @@ func (x *FilSealPreCommitPhase2Response) Deref() {
 	x.CommR = *(*[32]byte)(unsafe.Pointer(&x.ref2aa6831d.comm_r))
 }
 
+// allocFilSignatureFromUncompressedResponseMemory allocates memory for type C.fil_SignatureFromUncompressedResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilSignatureFromUncompressedResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilSignatureFromUncompressedResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilSignatureFromUncompressedResponseValue = unsafe.Sizeof([1]C.fil_SignatureFromUncompressedResponse{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilSignatureFromUncompressedResponse) Ref() *C.fil_SignatureFromUncompressedResponse {
+	if x == nil {
+		return nil
+	}
+	return x.ref50d2d7d1
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilSignatureFromUncompressedResponse) Free() {
+	if x != nil && x.allocs50d2d7d1 != nil {
+		x.allocs50d2d7d1.(*cgoAllocMap).Free()
+		x.ref50d2d7d1 = nil
+	}
+}
+
+// NewFilSignatureFromUncompressedResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilSignatureFromUncompressedResponseRef(ref unsafe.Pointer) *FilSignatureFromUncompressedResponse {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilSignatureFromUncompressedResponse)
+	obj.ref50d2d7d1 = (*C.fil_SignatureFromUncompressedResponse)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilSignatureFromUncompressedResponse) PassRef() (*C.fil_SignatureFromUncompressedResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref50d2d7d1 != nil {
+		return x.ref50d2d7d1, nil
+	}
+	mem50d2d7d1 := allocFilSignatureFromUncompressedResponseMemory(1)
+	ref50d2d7d1 := (*C.fil_SignatureFromUncompressedResponse)(mem50d2d7d1)
+	allocs50d2d7d1 := new(cgoAllocMap)
+	allocs50d2d7d1.Add(mem50d2d7d1)
+
+	var csignature_allocs *cgoAllocMap
+	ref50d2d7d1.signature, csignature_allocs = x.Signature.PassValue()
+	allocs50d2d7d1.Borrow(csignature_allocs)
+
+	x.ref50d2d7d1 = ref50d2d7d1
+	x.allocs50d2d7d1 = allocs50d2d7d1
+	return ref50d2d7d1, allocs50d2d7d1
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilSignatureFromUncompressedResponse) PassValue() (C.fil_SignatureFromUncompressedResponse, *cgoAllocMap) {
+	if x.ref50d2d7d1 != nil {
+		return *x.ref50d2d7d1, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilSignatureFromUncompressedResponse) Deref() {
+	if x.ref50d2d7d1 == nil {
+		return
+	}
+	x.Signature = *NewFilBLSSignatureRef(unsafe.Pointer(&x.ref50d2d7d1.signature))
+}
+
+// allocFilBLSUncompressedSignatureMemory allocates memory for type C.fil_BLSUncompressedSignature in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilBLSUncompressedSignatureMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilBLSUncompressedSignatureValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilBLSUncompressedSignatureValue = unsafe.Sizeof([1]C.fil_BLSUncompressedSignature{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilBLSUncompressedSignature) Ref() *C.fil_BLSUncompressedSignature {
+	if x == nil {
+		return nil
+	}
+	return x.ref5baa8f53
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilBLSUncompressedSignature) Free() {
+	if x != nil && x.allocs5baa8f53 != nil {
+		x.allocs5baa8f53.(*cgoAllocMap).Free()
+		x.ref5baa8f53 = nil
+	}
+}
+
+// NewFilBLSUncompressedSignatureRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilBLSUncompressedSignatureRef(ref unsafe.Pointer) *FilBLSUncompressedSignature {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilBLSUncompressedSignature)
+	obj.ref5baa8f53 = (*C.fil_BLSUncompressedSignature)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilBLSUncompressedSignature) PassRef() (*C.fil_BLSUncompressedSignature, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.ref5baa8f53 != nil {
+		return x.ref5baa8f53, nil
+	}
+	mem5baa8f53 := allocFilBLSUncompressedSignatureMemory(1)
+	ref5baa8f53 := (*C.fil_BLSUncompressedSignature)(mem5baa8f53)
+	allocs5baa8f53 := new(cgoAllocMap)
+	allocs5baa8f53.Add(mem5baa8f53)
+
+	var cinner_allocs *cgoAllocMap
+	ref5baa8f53.inner, cinner_allocs = *(*[192]C.uint8_t)(unsafe.Pointer(&x.Inner)), cgoAllocsUnknown
+	allocs5baa8f53.Borrow(cinner_allocs)
+
+	x.ref5baa8f53 = ref5baa8f53
+	x.allocs5baa8f53 = allocs5baa8f53
+	return ref5baa8f53, allocs5baa8f53
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilBLSUncompressedSignature) PassValue() (C.fil_BLSUncompressedSignature, *cgoAllocMap) {
+	if x.ref5baa8f53 != nil {
+		return *x.ref5baa8f53, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilBLSUncompressedSignature) Deref() {
+	if x.ref5baa8f53 == nil {
+		return
+	}
+	x.Inner = *(*[192]byte)(unsafe.Pointer(&x.ref5baa8f53.inner))
+}
+
+// allocFilSignatureToUncompressedResponseMemory allocates memory for type C.fil_SignatureToUncompressedResponse in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilSignatureToUncompressedResponseMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilSignatureToUncompressedResponseValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilSignatureToUncompressedResponseValue = unsafe.Sizeof([1]C.fil_SignatureToUncompressedResponse{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilSignatureToUncompressedResponse) Ref() *C.fil_SignatureToUncompressedResponse {
+	if x == nil {
+		return nil
+	}
+	return x.reff8c41e37
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilSignatureToUncompressedResponse) Free() {
+	if x != nil && x.allocsf8c41e37 != nil {
+		x.allocsf8c41e37.(*cgoAllocMap).Free()
+		x.reff8c41e37 = nil
+	}
+}
+
+// NewFilSignatureToUncompressedResponseRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilSignatureToUncompressedResponseRef(ref unsafe.Pointer) *FilSignatureToUncompressedResponse {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilSignatureToUncompressedResponse)
+	obj.reff8c41e37 = (*C.fil_SignatureToUncompressedResponse)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilSignatureToUncompressedResponse) PassRef() (*C.fil_SignatureToUncompressedResponse, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.reff8c41e37 != nil {
+		return x.reff8c41e37, nil
+	}
+	memf8c41e37 := allocFilSignatureToUncompressedResponseMemory(1)
+	reff8c41e37 := (*C.fil_SignatureToUncompressedResponse)(memf8c41e37)
+	allocsf8c41e37 := new(cgoAllocMap)
+	allocsf8c41e37.Add(memf8c41e37)
+
+	var csignature_allocs *cgoAllocMap
+	reff8c41e37.signature, csignature_allocs = x.Signature.PassValue()
+	allocsf8c41e37.Borrow(csignature_allocs)
+
+	x.reff8c41e37 = reff8c41e37
+	x.allocsf8c41e37 = allocsf8c41e37
+	return reff8c41e37, allocsf8c41e37
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilSignatureToUncompressedResponse) PassValue() (C.fil_SignatureToUncompressedResponse, *cgoAllocMap) {
+	if x.reff8c41e37 != nil {
+		return *x.reff8c41e37, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilSignatureToUncompressedResponse) Deref() {
+	if x.reff8c41e37 == nil {
+		return
+	}
+	x.Signature = *NewFilBLSUncompressedSignatureRef(unsafe.Pointer(&x.reff8c41e37.signature))
+}
+
 // allocFilStringResponseMemory allocates memory for type C.fil_StringResponse in C.
 // The caller is responsible for freeing the this memory via C.free.
 func allocFilStringResponseMemory(n int) unsafe.Pointer {
@@ -5238,6 +6878,106 @@ func (x *FilPrivateReplicaInfo) Deref() {
 	x.SectorId = (uint64)(x.ref81a31e9b.sector_id)
 }
 
+// allocFilSealCommitPhase2BatchInputMemory allocates memory for type C.fil_SealCommitPhase2BatchInput in C.
+// The caller is responsible for freeing the this memory via C.free.
+func allocFilSealCommitPhase2BatchInputMemory(n int) unsafe.Pointer {
+	mem, err := C.calloc(C.size_t(n), (C.size_t)(sizeOfFilSealCommitPhase2BatchInputValue))
+	if mem == nil {
+		panic(fmt.Sprintln("memory alloc error: ", err))
+	}
+	return mem
+}
+
+const sizeOfFilSealCommitPhase2BatchInputValue = unsafe.Sizeof([1]C.fil_SealCommitPhase2BatchInput{})
+
+// Ref returns the underlying reference to C object or nil if struct is nil.
+func (x *FilSealCommitPhase2BatchInput) Ref() *C.fil_SealCommitPhase2BatchInput {
+	if x == nil {
+		return nil
+	}
+	return x.refcce47217
+}
+
+// Free invokes alloc map's free mechanism that cleanups any allocated memory using C free.
+// Does nothing if struct is nil or has no allocation map.
+func (x *FilSealCommitPhase2BatchInput) Free() {
+	if x != nil && x.allocscce47217 != nil {
+		x.allocscce47217.(*cgoAllocMap).Free()
+		x.refcce47217 = nil
+	}
+}
+
+// NewFilSealCommitPhase2BatchInputRef creates a new wrapper struct with underlying reference set to the original C object.
+// Returns nil if the provided pointer to C object is nil too.
+func NewFilSealCommitPhase2BatchInputRef(ref unsafe.Pointer) *FilSealCommitPhase2BatchInput {
+	if ref == nil {
+		return nil
+	}
+	obj := new(FilSealCommitPhase2BatchInput)
+	obj.refcce47217 = (*C.fil_SealCommitPhase2BatchInput)(unsafe.Pointer(ref))
+	return obj
+}
+
+// PassRef returns the underlying C object, otherwise it will allocate one and set its values
+// from this wrapping struct, counting allocations into an allocation map.
+func (x *FilSealCommitPhase2BatchInput) PassRef() (*C.fil_SealCommitPhase2BatchInput, *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	} else if x.refcce47217 != nil {
+		return x.refcce47217, nil
+	}
+	memcce47217 := allocFilSealCommitPhase2BatchInputMemory(1)
+	refcce47217 := (*C.fil_SealCommitPhase2BatchInput)(memcce47217)
+	allocscce47217 := new(cgoAllocMap)
+	allocscce47217.Add(memcce47217)
+
+	var cseal_commit_phase1_output_ptr_allocs *cgoAllocMap
+	refcce47217.seal_commit_phase1_output_ptr, cseal_commit_phase1_output_ptr_allocs = copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&x.SealCommitPhase1OutputPtr)))
+	allocscce47217.Borrow(cseal_commit_phase1_output_ptr_allocs)
+
+	var cseal_commit_phase1_output_len_allocs *cgoAllocMap
+	refcce47217.seal_commit_phase1_output_len, cseal_commit_phase1_output_len_allocs = (C.size_t)(x.SealCommitPhase1OutputLen), cgoAllocsUnknown
+	allocscce47217.Borrow(cseal_commit_phase1_output_len_allocs)
+
+	var csector_id_allocs *cgoAllocMap
+	refcce47217.sector_id, csector_id_allocs = (C.uint64_t)(x.SectorId), cgoAllocsUnknown
+	allocscce47217.Borrow(csector_id_allocs)
+
+	var cprover_id_allocs *cgoAllocMap
+	refcce47217.prover_id, cprover_id_allocs = x.ProverId.PassValue()
+	allocscce47217.Borrow(cprover_id_allocs)
+
+	x.refcce47217 = refcce47217
+	x.allocscce47217 = allocscce47217
+	return refcce47217, allocscce47217
+
+}
+
+// PassValue does the same as PassRef except that it will try to dereference the returned pointer.
+func (x FilSealCommitPhase2BatchInput) PassValue() (C.fil_SealCommitPhase2BatchInput, *cgoAllocMap) {
+	if x.refcce47217 != nil {
+		return *x.refcce47217, nil
+	}
+	ref, allocs := x.PassRef()
+	return *ref, allocs
+}
+
+// Deref uses the underlying reference to C object and fills the wrapping struct with values.
+// Do not forget to call this method whether you get a struct for C object and want to read its values.
+func (x *FilSealCommitPhase2BatchInput) Deref() {
+	if x.refcce47217 == nil {
+		return
+	}
+	hxf5fa529 := (*sliceHeader)(unsafe.Pointer(&x.SealCommitPhase1OutputPtr))
+	hxf5fa529.Data = unsafe.Pointer(x.refcce47217.seal_commit_phase1_output_ptr)
+	hxf5fa529.Cap = 0x7fffffff
+	// hxf5fa529.Len = ?
+
+	x.SealCommitPhase1OutputLen = (uint)(x.refcce47217.seal_commit_phase1_output_len)
+	x.SectorId = (uint64)(x.refcce47217.sector_id)
+	x.ProverId = *NewFil32ByteArrayRef(unsafe.Pointer(&x.refcce47217.prover_id))
+}
+
 // allocFilPublicReplicaInfoMemory allocates memory for type C.fil_PublicReplicaInfo in C.
 // The caller is responsible for freeing the this memory via C.free.
 func allocFilPublicReplicaInfoMemory(n int) unsafe.Pointer {
@@ -5396,15 +7136,6 @@ func unpackArgSFilSealCommitPhase2Response(x []FilSealCommitPhase2Response) (unp
 	return
 }
 
-// packSFilSealCommitPhase2Response reads sliced Go data structure out from plain C format.
-func packSFilSealCommitPhase2Response(v []FilSealCommitPhase2Response, ptr0 *C.fil_SealCommitPhase2Response) {
-	const m = 0x7fffffff
-	for i0 := range v {
-		ptr1 := (*(*[m / sizeOfFilSealCommitPhase2ResponseValue]C.fil_SealCommitPhase2Response)(unsafe.Pointer(ptr0)))[i0]
-		v[i0] = *NewFilSealCommitPhase2ResponseRef(unsafe.Pointer(&ptr1))
-	}
-}
-
 // unpackArgSFilPublicPieceInfo transforms a sliced Go data structure into plain C format.
 func unpackArgSFilPublicPieceInfo(x []FilPublicPieceInfo) (unpacked *C.fil_PublicPieceInfo, allocs *cgoAllocMap) {
 	if x == nil {
@@ -5615,6 +7346,44 @@ func packSFilPartitionSnarkProof(v []FilPartitionSnarkProof, ptr0 *C.fil_Partiti
 	}
 }
 
+// unpackArgSFilSealCommitPhase2BatchInput transforms a sliced Go data structure into plain C format.
+func unpackArgSFilSealCommitPhase2BatchInput(x []FilSealCommitPhase2BatchInput) (unpacked *C.fil_SealCommitPhase2BatchInput, allocs *cgoAllocMap) {
+	if x == nil {
+		return nil, nil
+	}
+	allocs = new(cgoAllocMap)
+	defer runtime.SetFinalizer(allocs, func(a *cgoAllocMap) {
+		go a.Free()
+	})
+
+	len0 := len(x)
+	mem0 := allocFilSealCommitPhase2BatchInputMemory(len0)
+	allocs.Add(mem0)
+	h0 := &sliceHeader{
+		Data: mem0,
+		Cap:  len0,
+		Len:  len0,
+	}
+	v0 := *(*[]C.fil_SealCommitPhase2BatchInput)(unsafe.Pointer(h0))
+	for i0 := range x {
+		allocs0 := new(cgoAllocMap)
+		v0[i0], allocs0 = x[i0].PassValue()
+		allocs.Borrow(allocs0)
+	}
+	h := (*sliceHeader)(unsafe.Pointer(&v0))
+	unpacked = (*C.fil_SealCommitPhase2BatchInput)(h.Data)
+	return
+}
+
+// packSFilSealCommitPhase2BatchInput reads sliced Go data structure out from plain C format.
+func packSFilSealCommitPhase2BatchInput(v []FilSealCommitPhase2BatchInput, ptr0 *C.fil_SealCommitPhase2BatchInput) {
+	const m = 0x7fffffff
+	for i0 := range v {
+		ptr1 := (*(*[m / sizeOfFilSealCommitPhase2BatchInputValue]C.fil_SealCommitPhase2BatchInput)(unsafe.Pointer(ptr0)))[i0]
+		v[i0] = *NewFilSealCommitPhase2BatchInputRef(unsafe.Pointer(&ptr1))
+	}
+}
+
 // unpackArgSFilAggregationInputs transforms a sliced Go data structure into plain C format.
 func unpackArgSFilAggregationInputs(x []FilAggregationInputs) (unpacked *C.fil_AggregationInputs, allocs *cgoAllocMap) {
 	if x == nil {
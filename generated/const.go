@@ -12,10 +12,10 @@ package generated
 */
 import "C"
 
-// FCPResponseStatus as declared in filecoin-ffi/filcrypto.h:31
+// FCPResponseStatus as declared in filecoin-ffi/filcrypto.h:43
 type FCPResponseStatus int32
 
-// FCPResponseStatus enumeration from filecoin-ffi/filcrypto.h:31
+// FCPResponseStatus enumeration from filecoin-ffi/filcrypto.h:43
 const (
 	FCPResponseStatusFCPNoError           FCPResponseStatus = C.FCPResponseStatus_FCPNoError
 	FCPResponseStatusFCPUnclassifiedError FCPResponseStatus = C.FCPResponseStatus_FCPUnclassifiedError
@@ -23,18 +23,29 @@ const (
 	FCPResponseStatusFCPReceiverError     FCPResponseStatus = C.FCPResponseStatus_FCPReceiverError
 )
 
-// FilRegisteredAggregationProof as declared in filecoin-ffi/filcrypto.h:35
+// FilBLSPointValidation as declared in filecoin-ffi/filcrypto.h:53
+type FilBLSPointValidation int32
+
+// FilBLSPointValidation enumeration from filecoin-ffi/filcrypto.h:53
+const (
+	FilBLSPointValidationValid           FilBLSPointValidation = C.fil_BLSPointValidation_Valid
+	FilBLSPointValidationInvalidEncoding FilBLSPointValidation = C.fil_BLSPointValidation_InvalidEncoding
+	FilBLSPointValidationNotInSubgroup   FilBLSPointValidation = C.fil_BLSPointValidation_NotInSubgroup
+	FilBLSPointValidationIdentity        FilBLSPointValidation = C.fil_BLSPointValidation_Identity
+)
+
+// FilRegisteredAggregationProof as declared in filecoin-ffi/filcrypto.h:57
 type FilRegisteredAggregationProof int32
 
-// FilRegisteredAggregationProof enumeration from filecoin-ffi/filcrypto.h:35
+// FilRegisteredAggregationProof enumeration from filecoin-ffi/filcrypto.h:57
 const (
 	FilRegisteredAggregationProofSnarkPackV1 FilRegisteredAggregationProof = C.fil_RegisteredAggregationProof_SnarkPackV1
 )
 
-// FilRegisteredPoStProof as declared in filecoin-ffi/filcrypto.h:48
+// FilRegisteredPoStProof as declared in filecoin-ffi/filcrypto.h:70
 type FilRegisteredPoStProof int32
 
-// FilRegisteredPoStProof enumeration from filecoin-ffi/filcrypto.h:48
+// FilRegisteredPoStProof enumeration from filecoin-ffi/filcrypto.h:70
 const (
 	FilRegisteredPoStProofStackedDrgWinning2KiBV1   FilRegisteredPoStProof = C.fil_RegisteredPoStProof_StackedDrgWinning2KiBV1
 	FilRegisteredPoStProofStackedDrgWinning8MiBV1   FilRegisteredPoStProof = C.fil_RegisteredPoStProof_StackedDrgWinning8MiBV1
@@ -48,10 +59,10 @@ const (
 	FilRegisteredPoStProofStackedDrgWindow64GiBV1   FilRegisteredPoStProof = C.fil_RegisteredPoStProof_StackedDrgWindow64GiBV1
 )
 
-// FilRegisteredSealProof as declared in filecoin-ffi/filcrypto.h:61
+// FilRegisteredSealProof as declared in filecoin-ffi/filcrypto.h:83
 type FilRegisteredSealProof int32
 
-// FilRegisteredSealProof enumeration from filecoin-ffi/filcrypto.h:61
+// FilRegisteredSealProof enumeration from filecoin-ffi/filcrypto.h:83
 const (
 	FilRegisteredSealProofStackedDrg2KiBV1    FilRegisteredSealProof = C.fil_RegisteredSealProof_StackedDrg2KiBV1
 	FilRegisteredSealProofStackedDrg8MiBV1    FilRegisteredSealProof = C.fil_RegisteredSealProof_StackedDrg8MiBV1
@@ -65,10 +76,10 @@ const (
 	FilRegisteredSealProofStackedDrg64GiBV11  FilRegisteredSealProof = C.fil_RegisteredSealProof_StackedDrg64GiBV1_1
 )
 
-// FilRegisteredUpdateProof as declared in filecoin-ffi/filcrypto.h:69
+// FilRegisteredUpdateProof as declared in filecoin-ffi/filcrypto.h:91
 type FilRegisteredUpdateProof int32
 
-// FilRegisteredUpdateProof enumeration from filecoin-ffi/filcrypto.h:69
+// FilRegisteredUpdateProof enumeration from filecoin-ffi/filcrypto.h:91
 const (
 	FilRegisteredUpdateProofStackedDrg2KiBV1   FilRegisteredUpdateProof = C.fil_RegisteredUpdateProof_StackedDrg2KiBV1
 	FilRegisteredUpdateProofStackedDrg8MiBV1   FilRegisteredUpdateProof = C.fil_RegisteredUpdateProof_StackedDrg8MiBV1
@@ -16,7 +16,7 @@ import (
 	"unsafe"
 )
 
-// FilAggregate function as declared in filecoin-ffi/filcrypto.h:432
+// FilAggregate function as declared in filecoin-ffi/filcrypto.h:581
 func FilAggregate(flattenedSignaturesPtr []byte, flattenedSignaturesLen uint) *FilAggregateResponse {
 	cflattenedSignaturesPtr, cflattenedSignaturesPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedSignaturesPtr)))
 	cflattenedSignaturesLen, cflattenedSignaturesLenAllocMap := (C.size_t)(flattenedSignaturesLen), cgoAllocsUnknown
@@ -27,7 +27,29 @@ func FilAggregate(flattenedSignaturesPtr []byte, flattenedSignaturesLen uint) *F
 	return __v
 }
 
-// FilAggregateSealProofs function as declared in filecoin-ffi/filcrypto.h:435
+// FilAggregateG1 function as declared in filecoin-ffi/filcrypto.h:594
+func FilAggregateG1(flattenedSignaturesPtr []byte, flattenedSignaturesLen uint) *FilSignatureG1Response {
+	cflattenedSignaturesPtr, cflattenedSignaturesPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedSignaturesPtr)))
+	cflattenedSignaturesLen, cflattenedSignaturesLenAllocMap := (C.size_t)(flattenedSignaturesLen), cgoAllocsUnknown
+	__ret := C.fil_aggregate_g1(cflattenedSignaturesPtr, cflattenedSignaturesLen)
+	runtime.KeepAlive(cflattenedSignaturesLenAllocMap)
+	runtime.KeepAlive(cflattenedSignaturesPtrAllocMap)
+	__v := NewFilSignatureG1ResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilAggregatePublicKeys function as declared in filecoin-ffi/filcrypto.h:609
+func FilAggregatePublicKeys(flattenedPublicKeysPtr []byte, flattenedPublicKeysLen uint) *FilAggregatePublicKeysResponse {
+	cflattenedPublicKeysPtr, cflattenedPublicKeysPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedPublicKeysPtr)))
+	cflattenedPublicKeysLen, cflattenedPublicKeysLenAllocMap := (C.size_t)(flattenedPublicKeysLen), cgoAllocsUnknown
+	__ret := C.fil_aggregate_public_keys(cflattenedPublicKeysPtr, cflattenedPublicKeysLen)
+	runtime.KeepAlive(cflattenedPublicKeysLenAllocMap)
+	runtime.KeepAlive(cflattenedPublicKeysPtrAllocMap)
+	__v := NewFilAggregatePublicKeysResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilAggregateSealProofs function as declared in filecoin-ffi/filcrypto.h:612
 func FilAggregateSealProofs(registeredProof FilRegisteredSealProof, registeredAggregation FilRegisteredAggregationProof, commRsPtr []Fil32ByteArray, commRsLen uint, seedsPtr []Fil32ByteArray, seedsLen uint, sealCommitResponsesPtr []FilSealCommitPhase2Response, sealCommitResponsesLen uint) *FilAggregateProof {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	cregisteredAggregation, cregisteredAggregationAllocMap := (C.fil_RegisteredAggregationProof)(registeredAggregation), cgoAllocsUnknown
@@ -53,7 +75,38 @@ func FilAggregateSealProofs(registeredProof FilRegisteredSealProof, registeredAg
 	return __v
 }
 
-// FilClearCache function as declared in filecoin-ffi/filcrypto.h:444
+// FilBlsSignerNew function as declared in filecoin-ffi/filcrypto.h:632
+func FilBlsSignerNew(rawPrivateKeyPtr []byte) *FilBLSSigner {
+	crawPrivateKeyPtr, crawPrivateKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&rawPrivateKeyPtr)))
+	__ret := C.fil_bls_signer_new(crawPrivateKeyPtr)
+	runtime.KeepAlive(crawPrivateKeyPtrAllocMap)
+	__v := NewFilBLSSignerRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilBlsSignerPublicKey function as declared in filecoin-ffi/filcrypto.h:641
+func FilBlsSignerPublicKey(signerPtr *FilBLSSigner) *FilPrivateKeyPublicKeyResponse {
+	csignerPtr, csignerPtrAllocMap := signerPtr.PassRef()
+	__ret := C.fil_bls_signer_public_key(csignerPtr)
+	runtime.KeepAlive(csignerPtrAllocMap)
+	__v := NewFilPrivateKeyPublicKeyResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilBlsSignerSign function as declared in filecoin-ffi/filcrypto.h:652
+func FilBlsSignerSign(signerPtr *FilBLSSigner, messagePtr []byte, messageLen uint) *FilPrivateKeySignResponse {
+	csignerPtr, csignerPtrAllocMap := signerPtr.PassRef()
+	cmessagePtr, cmessagePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&messagePtr)))
+	cmessageLen, cmessageLenAllocMap := (C.size_t)(messageLen), cgoAllocsUnknown
+	__ret := C.fil_bls_signer_sign(csignerPtr, cmessagePtr, cmessageLen)
+	runtime.KeepAlive(cmessageLenAllocMap)
+	runtime.KeepAlive(cmessagePtrAllocMap)
+	runtime.KeepAlive(csignerPtrAllocMap)
+	__v := NewFilPrivateKeySignResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilClearCache function as declared in filecoin-ffi/filcrypto.h:656
 func FilClearCache(sectorSize uint64, cacheDirPath string) *FilClearCacheResponse {
 	csectorSize, csectorSizeAllocMap := (C.uint64_t)(sectorSize), cgoAllocsUnknown
 	cacheDirPath = safeString(cacheDirPath)
@@ -66,308 +119,424 @@ func FilClearCache(sectorSize uint64, cacheDirPath string) *FilClearCacheRespons
 	return __v
 }
 
-// FilCreateZeroSignature function as declared in filecoin-ffi/filcrypto.h:451
+// FilComputeCommR function as declared in filecoin-ffi/filcrypto.h:662
+func FilComputeCommR(commC Fil32ByteArray, commRLast Fil32ByteArray) *FilComputeCommRResponse {
+	ccommC, ccommCAllocMap := commC.PassValue()
+	ccommRLast, ccommRLastAllocMap := commRLast.PassValue()
+	__ret := C.fil_compute_comm_r(ccommC, ccommRLast)
+	runtime.KeepAlive(ccommRLastAllocMap)
+	runtime.KeepAlive(ccommCAllocMap)
+	__v := NewFilComputeCommRResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilCreateZeroSignature function as declared in filecoin-ffi/filcrypto.h:669
 func FilCreateZeroSignature() *FilZeroSignatureResponse {
 	__ret := C.fil_create_zero_signature()
 	__v := NewFilZeroSignatureResponseRef(unsafe.Pointer(__ret))
 	return __v
 }
 
-// FilDestroyAggregateProof function as declared in filecoin-ffi/filcrypto.h:457
+// FilDestroyAggregateProof function as declared in filecoin-ffi/filcrypto.h:675
 func FilDestroyAggregateProof(ptr *FilAggregateProof) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_aggregate_proof(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyAggregateResponse function as declared in filecoin-ffi/filcrypto.h:459
+// FilDestroyAggregatePublicKeysResponse function as declared in filecoin-ffi/filcrypto.h:677
+func FilDestroyAggregatePublicKeysResponse(ptr *FilAggregatePublicKeysResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_aggregate_public_keys_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroyAggregateResponse function as declared in filecoin-ffi/filcrypto.h:679
 func FilDestroyAggregateResponse(ptr *FilAggregateResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_aggregate_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyClearCacheResponse function as declared in filecoin-ffi/filcrypto.h:461
+// FilDestroyBlsSigner function as declared in filecoin-ffi/filcrypto.h:684
+func FilDestroyBlsSigner(ptr *FilBLSSigner) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_bls_signer(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroyClearCacheResponse function as declared in filecoin-ffi/filcrypto.h:686
 func FilDestroyClearCacheResponse(ptr *FilClearCacheResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_clear_cache_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyEmptySectorUpdateDecodeFromResponse function as declared in filecoin-ffi/filcrypto.h:467
+// FilDestroyComputeCommRResponse function as declared in filecoin-ffi/filcrypto.h:688
+func FilDestroyComputeCommRResponse(ptr *FilComputeCommRResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_compute_comm_r_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroyEmptySectorUpdateDecodeFromResponse function as declared in filecoin-ffi/filcrypto.h:694
 func FilDestroyEmptySectorUpdateDecodeFromResponse(ptr *FilEmptySectorUpdateDecodeFromResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_empty_sector_update_decode_from_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyEmptySectorUpdateEncodeIntoResponse function as declared in filecoin-ffi/filcrypto.h:473
+// FilDestroyEmptySectorUpdateEncodeIntoResponse function as declared in filecoin-ffi/filcrypto.h:700
 func FilDestroyEmptySectorUpdateEncodeIntoResponse(ptr *FilEmptySectorUpdateEncodeIntoResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_empty_sector_update_encode_into_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyEmptySectorUpdateGenerateProofResponse function as declared in filecoin-ffi/filcrypto.h:479
+// FilDestroyEmptySectorUpdateGenerateProofResponse function as declared in filecoin-ffi/filcrypto.h:706
 func FilDestroyEmptySectorUpdateGenerateProofResponse(ptr *FilEmptySectorUpdateProofResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_empty_sector_update_generate_proof_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyEmptySectorUpdateRemoveEncodedDataResponse function as declared in filecoin-ffi/filcrypto.h:485
+// FilDestroyEmptySectorUpdateRemoveEncodedDataResponse function as declared in filecoin-ffi/filcrypto.h:712
 func FilDestroyEmptySectorUpdateRemoveEncodedDataResponse(ptr *FilEmptySectorUpdateRemoveEncodedDataResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_empty_sector_update_remove_encoded_data_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyEmptySectorUpdateVerifyProofResponse function as declared in filecoin-ffi/filcrypto.h:491
+// FilDestroyEmptySectorUpdateVerifyProofResponse function as declared in filecoin-ffi/filcrypto.h:718
 func FilDestroyEmptySectorUpdateVerifyProofResponse(ptr *FilVerifyEmptySectorUpdateProofResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_empty_sector_update_verify_proof_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyFauxrepResponse function as declared in filecoin-ffi/filcrypto.h:493
+// FilDestroyFauxrepResponse function as declared in filecoin-ffi/filcrypto.h:720
 func FilDestroyFauxrepResponse(ptr *FilFauxRepResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_fauxrep_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyFinalizeTicketResponse function as declared in filecoin-ffi/filcrypto.h:495
+// FilDestroyFinalizeTicketResponse function as declared in filecoin-ffi/filcrypto.h:722
 func FilDestroyFinalizeTicketResponse(ptr *FilFinalizeTicketResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_finalize_ticket_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyGenerateDataCommitmentResponse function as declared in filecoin-ffi/filcrypto.h:497
+// FilDestroyGenerateDataCommitmentResponse function as declared in filecoin-ffi/filcrypto.h:724
 func FilDestroyGenerateDataCommitmentResponse(ptr *FilGenerateDataCommitmentResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_generate_data_commitment_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyGenerateEmptySectorUpdatePartitionProofResponse function as declared in filecoin-ffi/filcrypto.h:503
+// FilDestroyGenerateEmptySectorUpdatePartitionProofResponse function as declared in filecoin-ffi/filcrypto.h:730
 func FilDestroyGenerateEmptySectorUpdatePartitionProofResponse(ptr *FilPartitionProofResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_generate_empty_sector_update_partition_proof_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyGenerateFallbackSectorChallengesResponse function as declared in filecoin-ffi/filcrypto.h:505
+// FilDestroyGenerateFallbackSectorChallengesResponse function as declared in filecoin-ffi/filcrypto.h:732
 func FilDestroyGenerateFallbackSectorChallengesResponse(ptr *FilGenerateFallbackSectorChallengesResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_generate_fallback_sector_challenges_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyGeneratePieceCommitmentResponse function as declared in filecoin-ffi/filcrypto.h:507
+// FilDestroyGeneratePieceCommitmentResponse function as declared in filecoin-ffi/filcrypto.h:734
 func FilDestroyGeneratePieceCommitmentResponse(ptr *FilGeneratePieceCommitmentResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_generate_piece_commitment_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyGenerateSingleVanillaProofResponse function as declared in filecoin-ffi/filcrypto.h:509
+// FilDestroyGenerateSdrResponse function as declared in filecoin-ffi/filcrypto.h:736
+func FilDestroyGenerateSdrResponse(ptr *FilGenerateSDRResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_generate_sdr_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroyGenerateSingleVanillaProofResponse function as declared in filecoin-ffi/filcrypto.h:738
 func FilDestroyGenerateSingleVanillaProofResponse(ptr *FilGenerateSingleVanillaProofResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_generate_single_vanilla_proof_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyGenerateSingleWindowPostWithVanillaResponse function as declared in filecoin-ffi/filcrypto.h:511
+// FilDestroyGenerateSingleWindowPostWithVanillaResponse function as declared in filecoin-ffi/filcrypto.h:740
 func FilDestroyGenerateSingleWindowPostWithVanillaResponse(ptr *FilGenerateSingleWindowPoStWithVanillaResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_generate_single_window_post_with_vanilla_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyGenerateWindowPostResponse function as declared in filecoin-ffi/filcrypto.h:513
+// FilDestroyGenerateWindowPostResponse function as declared in filecoin-ffi/filcrypto.h:742
 func FilDestroyGenerateWindowPostResponse(ptr *FilGenerateWindowPoStResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_generate_window_post_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyGenerateWinningPostResponse function as declared in filecoin-ffi/filcrypto.h:515
+// FilDestroyGenerateWinningPostResponse function as declared in filecoin-ffi/filcrypto.h:744
 func FilDestroyGenerateWinningPostResponse(ptr *FilGenerateWinningPoStResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_generate_winning_post_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyGenerateWinningPostSectorChallenge function as declared in filecoin-ffi/filcrypto.h:517
+// FilDestroyGenerateWinningPostSectorChallenge function as declared in filecoin-ffi/filcrypto.h:746
 func FilDestroyGenerateWinningPostSectorChallenge(ptr *FilGenerateWinningPoStSectorChallenge) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_generate_winning_post_sector_challenge(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyGetNumPartitionForFallbackPostResponse function as declared in filecoin-ffi/filcrypto.h:519
+// FilDestroyGetNumPartitionForFallbackPostResponse function as declared in filecoin-ffi/filcrypto.h:748
 func FilDestroyGetNumPartitionForFallbackPostResponse(ptr *FilGetNumPartitionForFallbackPoStResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_get_num_partition_for_fallback_post_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyGpuDeviceResponse function as declared in filecoin-ffi/filcrypto.h:521
+// FilDestroyGpuDeviceResponse function as declared in filecoin-ffi/filcrypto.h:750
 func FilDestroyGpuDeviceResponse(ptr *FilGpuDeviceResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_gpu_device_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyHashResponse function as declared in filecoin-ffi/filcrypto.h:523
+// FilDestroyHashResponse function as declared in filecoin-ffi/filcrypto.h:752
 func FilDestroyHashResponse(ptr *FilHashResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_hash_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyInitLogFdResponse function as declared in filecoin-ffi/filcrypto.h:525
+// FilDestroyHashVerifyBatchResponse function as declared in filecoin-ffi/filcrypto.h:754
+func FilDestroyHashVerifyBatchResponse(ptr *FilHashVerifyBatchResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_hash_verify_batch_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroyInitLogFdResponse function as declared in filecoin-ffi/filcrypto.h:756
 func FilDestroyInitLogFdResponse(ptr *FilInitLogFdResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_init_log_fd_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyMergeWindowPostPartitionProofsResponse function as declared in filecoin-ffi/filcrypto.h:527
+// FilDestroyMergeWindowPostPartitionProofsResponse function as declared in filecoin-ffi/filcrypto.h:758
 func FilDestroyMergeWindowPostPartitionProofsResponse(ptr *FilMergeWindowPoStPartitionProofsResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_merge_window_post_partition_proofs_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyPrivateKeyGenerateResponse function as declared in filecoin-ffi/filcrypto.h:529
+// FilDestroyPopProveResponse function as declared in filecoin-ffi/filcrypto.h:760
+func FilDestroyPopProveResponse(ptr *FilPopProveResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_pop_prove_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroyPrivateKeyGenerateResponse function as declared in filecoin-ffi/filcrypto.h:762
 func FilDestroyPrivateKeyGenerateResponse(ptr *FilPrivateKeyGenerateResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_private_key_generate_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyPrivateKeyPublicKeyResponse function as declared in filecoin-ffi/filcrypto.h:531
+// FilDestroyPrivateKeyPublicKeyG2Response function as declared in filecoin-ffi/filcrypto.h:764
+func FilDestroyPrivateKeyPublicKeyG2Response(ptr *FilPrivateKeyPublicKeyG2Response) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_private_key_public_key_g2_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroyPrivateKeyPublicKeyResponse function as declared in filecoin-ffi/filcrypto.h:766
 func FilDestroyPrivateKeyPublicKeyResponse(ptr *FilPrivateKeyPublicKeyResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_private_key_public_key_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyPrivateKeySignResponse function as declared in filecoin-ffi/filcrypto.h:533
+// FilDestroyPrivateKeySignResponse function as declared in filecoin-ffi/filcrypto.h:768
 func FilDestroyPrivateKeySignResponse(ptr *FilPrivateKeySignResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_private_key_sign_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroySealCommitPhase1Response function as declared in filecoin-ffi/filcrypto.h:535
+// FilDestroyPrivateKeysPublicKeysResponse function as declared in filecoin-ffi/filcrypto.h:770
+func FilDestroyPrivateKeysPublicKeysResponse(ptr *FilPrivateKeysPublicKeysResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_private_keys_public_keys_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroyPublicKeyFromUncompressedResponse function as declared in filecoin-ffi/filcrypto.h:772
+func FilDestroyPublicKeyFromUncompressedResponse(ptr *FilPublicKeyFromUncompressedResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_public_key_from_uncompressed_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroyPublicKeyToUncompressedResponse function as declared in filecoin-ffi/filcrypto.h:774
+func FilDestroyPublicKeyToUncompressedResponse(ptr *FilPublicKeyToUncompressedResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_public_key_to_uncompressed_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroySealCommitPhase1Response function as declared in filecoin-ffi/filcrypto.h:776
 func FilDestroySealCommitPhase1Response(ptr *FilSealCommitPhase1Response) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_seal_commit_phase1_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroySealCommitPhase2Response function as declared in filecoin-ffi/filcrypto.h:537
+// FilDestroySealCommitPhase1ToFileResponse function as declared in filecoin-ffi/filcrypto.h:778
+func FilDestroySealCommitPhase1ToFileResponse(ptr *FilSealCommitPhase1ToFileResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_seal_commit_phase1_to_file_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroySealCommitPhase2BatchResponse function as declared in filecoin-ffi/filcrypto.h:780
+func FilDestroySealCommitPhase2BatchResponse(ptr *FilSealCommitPhase2BatchResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_seal_commit_phase2_batch_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroySealCommitPhase2Response function as declared in filecoin-ffi/filcrypto.h:782
 func FilDestroySealCommitPhase2Response(ptr *FilSealCommitPhase2Response) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_seal_commit_phase2_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroySealPreCommitPhase1Response function as declared in filecoin-ffi/filcrypto.h:539
+// FilDestroySealPreCommitPhase1Response function as declared in filecoin-ffi/filcrypto.h:784
 func FilDestroySealPreCommitPhase1Response(ptr *FilSealPreCommitPhase1Response) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_seal_pre_commit_phase1_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroySealPreCommitPhase2Response function as declared in filecoin-ffi/filcrypto.h:541
+// FilDestroySealPreCommitPhase2Response function as declared in filecoin-ffi/filcrypto.h:786
 func FilDestroySealPreCommitPhase2Response(ptr *FilSealPreCommitPhase2Response) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_seal_pre_commit_phase2_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyStringResponse function as declared in filecoin-ffi/filcrypto.h:543
+// FilDestroySignatureFromUncompressedResponse function as declared in filecoin-ffi/filcrypto.h:788
+func FilDestroySignatureFromUncompressedResponse(ptr *FilSignatureFromUncompressedResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_signature_from_uncompressed_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroySignatureG1Response function as declared in filecoin-ffi/filcrypto.h:790
+func FilDestroySignatureG1Response(ptr *FilSignatureG1Response) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_signature_g1_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroySignatureToUncompressedResponse function as declared in filecoin-ffi/filcrypto.h:792
+func FilDestroySignatureToUncompressedResponse(ptr *FilSignatureToUncompressedResponse) {
+	cptr, cptrAllocMap := ptr.PassRef()
+	C.fil_destroy_signature_to_uncompressed_response(cptr)
+	runtime.KeepAlive(cptrAllocMap)
+}
+
+// FilDestroyStringResponse function as declared in filecoin-ffi/filcrypto.h:794
 func FilDestroyStringResponse(ptr *FilStringResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_string_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyUnsealRangeResponse function as declared in filecoin-ffi/filcrypto.h:545
+// FilDestroyUnsealRangeResponse function as declared in filecoin-ffi/filcrypto.h:796
 func FilDestroyUnsealRangeResponse(ptr *FilUnsealRangeResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_unseal_range_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyVerifyAggregateSealResponse function as declared in filecoin-ffi/filcrypto.h:551
+// FilDestroyVerifyAggregateSealResponse function as declared in filecoin-ffi/filcrypto.h:802
 func FilDestroyVerifyAggregateSealResponse(ptr *FilVerifyAggregateSealProofResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_verify_aggregate_seal_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyVerifyEmptySectorUpdatePartitionProofResponse function as declared in filecoin-ffi/filcrypto.h:557
+// FilDestroyVerifyEmptySectorUpdatePartitionProofResponse function as declared in filecoin-ffi/filcrypto.h:808
 func FilDestroyVerifyEmptySectorUpdatePartitionProofResponse(ptr *FilVerifyPartitionProofResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_verify_empty_sector_update_partition_proof_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyVerifySealResponse function as declared in filecoin-ffi/filcrypto.h:563
+// FilDestroyVerifySealResponse function as declared in filecoin-ffi/filcrypto.h:814
 func FilDestroyVerifySealResponse(ptr *FilVerifySealResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_verify_seal_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyVerifyWindowPostResponse function as declared in filecoin-ffi/filcrypto.h:565
+// FilDestroyVerifyWindowPostResponse function as declared in filecoin-ffi/filcrypto.h:816
 func FilDestroyVerifyWindowPostResponse(ptr *FilVerifyWindowPoStResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_verify_window_post_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyVerifyWinningPostResponse function as declared in filecoin-ffi/filcrypto.h:571
+// FilDestroyVerifyWinningPostResponse function as declared in filecoin-ffi/filcrypto.h:822
 func FilDestroyVerifyWinningPostResponse(ptr *FilVerifyWinningPoStResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_verify_winning_post_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyWriteWithAlignmentResponse function as declared in filecoin-ffi/filcrypto.h:573
+// FilDestroyWriteWithAlignmentResponse function as declared in filecoin-ffi/filcrypto.h:824
 func FilDestroyWriteWithAlignmentResponse(ptr *FilWriteWithAlignmentResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_write_with_alignment_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyWriteWithoutAlignmentResponse function as declared in filecoin-ffi/filcrypto.h:575
+// FilDestroyWriteWithoutAlignmentResponse function as declared in filecoin-ffi/filcrypto.h:826
 func FilDestroyWriteWithoutAlignmentResponse(ptr *FilWriteWithoutAlignmentResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_write_without_alignment_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDestroyZeroSignatureResponse function as declared in filecoin-ffi/filcrypto.h:577
+// FilDestroyZeroSignatureResponse function as declared in filecoin-ffi/filcrypto.h:828
 func FilDestroyZeroSignatureResponse(ptr *FilZeroSignatureResponse) {
 	cptr, cptrAllocMap := ptr.PassRef()
 	C.fil_destroy_zero_signature_response(cptr)
 	runtime.KeepAlive(cptrAllocMap)
 }
 
-// FilDropSignature function as declared in filecoin-ffi/filcrypto.h:582
+// FilDropSignature function as declared in filecoin-ffi/filcrypto.h:833
 func FilDropSignature(sig []byte) {
 	csig, csigAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&sig)))
 	C.fil_drop_signature(csig)
 	runtime.KeepAlive(csigAllocMap)
 }
 
-// FilEmptySectorUpdateDecodeFrom function as declared in filecoin-ffi/filcrypto.h:588
+// FilEmptySectorUpdateDecodeFrom function as declared in filecoin-ffi/filcrypto.h:839
 func FilEmptySectorUpdateDecodeFrom(registeredProof FilRegisteredUpdateProof, outDataPath string, replicaPath string, sectorKeyPath string, sectorKeyCacheDirPath string, commDNew Fil32ByteArray) *FilEmptySectorUpdateDecodeFromResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredUpdateProof)(registeredProof), cgoAllocsUnknown
 	outDataPath = safeString(outDataPath)
@@ -394,7 +563,7 @@ func FilEmptySectorUpdateDecodeFrom(registeredProof FilRegisteredUpdateProof, ou
 	return __v
 }
 
-// FilEmptySectorUpdateEncodeInto function as declared in filecoin-ffi/filcrypto.h:599
+// FilEmptySectorUpdateEncodeInto function as declared in filecoin-ffi/filcrypto.h:850
 func FilEmptySectorUpdateEncodeInto(registeredProof FilRegisteredUpdateProof, newReplicaPath string, newCacheDirPath string, sectorKeyPath string, sectorKeyCacheDirPath string, stagedDataPath string, piecesPtr []FilPublicPieceInfo, piecesLen uint) *FilEmptySectorUpdateEncodeIntoResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredUpdateProof)(registeredProof), cgoAllocsUnknown
 	newReplicaPath = safeString(newReplicaPath)
@@ -428,7 +597,7 @@ func FilEmptySectorUpdateEncodeInto(registeredProof FilRegisteredUpdateProof, ne
 	return __v
 }
 
-// FilEmptySectorUpdateRemoveEncodedData function as declared in filecoin-ffi/filcrypto.h:612
+// FilEmptySectorUpdateRemoveEncodedData function as declared in filecoin-ffi/filcrypto.h:863
 func FilEmptySectorUpdateRemoveEncodedData(registeredProof FilRegisteredUpdateProof, sectorKeyPath string, sectorKeyCacheDirPath string, replicaPath string, replicaCachePath string, dataPath string, commDNew Fil32ByteArray) *FilEmptySectorUpdateRemoveEncodedDataResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredUpdateProof)(registeredProof), cgoAllocsUnknown
 	sectorKeyPath = safeString(sectorKeyPath)
@@ -459,7 +628,22 @@ func FilEmptySectorUpdateRemoveEncodedData(registeredProof FilRegisteredUpdatePr
 	return __v
 }
 
-// FilFauxrep function as declared in filecoin-ffi/filcrypto.h:620
+// FilFastAggregateVerify function as declared in filecoin-ffi/filcrypto.h:885
+func FilFastAggregateVerify(signaturePtr []byte, digestPtr []byte, flattenedPublicKeysPtr []byte, flattenedPublicKeysLen uint) int32 {
+	csignaturePtr, csignaturePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&signaturePtr)))
+	cdigestPtr, cdigestPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&digestPtr)))
+	cflattenedPublicKeysPtr, cflattenedPublicKeysPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedPublicKeysPtr)))
+	cflattenedPublicKeysLen, cflattenedPublicKeysLenAllocMap := (C.size_t)(flattenedPublicKeysLen), cgoAllocsUnknown
+	__ret := C.fil_fast_aggregate_verify(csignaturePtr, cdigestPtr, cflattenedPublicKeysPtr, cflattenedPublicKeysLen)
+	runtime.KeepAlive(cflattenedPublicKeysLenAllocMap)
+	runtime.KeepAlive(cflattenedPublicKeysPtrAllocMap)
+	runtime.KeepAlive(cdigestPtrAllocMap)
+	runtime.KeepAlive(csignaturePtrAllocMap)
+	__v := (int32)(__ret)
+	return __v
+}
+
+// FilFauxrep function as declared in filecoin-ffi/filcrypto.h:890
 func FilFauxrep(registeredProof FilRegisteredSealProof, cacheDirPath string, sealedSectorPath string) *FilFauxRepResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	cacheDirPath = safeString(cacheDirPath)
@@ -476,7 +660,7 @@ func FilFauxrep(registeredProof FilRegisteredSealProof, cacheDirPath string, sea
 	return __v
 }
 
-// FilFauxrep2 function as declared in filecoin-ffi/filcrypto.h:624
+// FilFauxrep2 function as declared in filecoin-ffi/filcrypto.h:894
 func FilFauxrep2(registeredProof FilRegisteredSealProof, cacheDirPath string, existingPAuxPath string) *FilFauxRepResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	cacheDirPath = safeString(cacheDirPath)
@@ -493,7 +677,7 @@ func FilFauxrep2(registeredProof FilRegisteredSealProof, cacheDirPath string, ex
 	return __v
 }
 
-// FilGenerateDataCommitment function as declared in filecoin-ffi/filcrypto.h:631
+// FilGenerateDataCommitment function as declared in filecoin-ffi/filcrypto.h:901
 func FilGenerateDataCommitment(registeredProof FilRegisteredSealProof, piecesPtr []FilPublicPieceInfo, piecesLen uint) *FilGenerateDataCommitmentResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	cpiecesPtr, cpiecesPtrAllocMap := unpackArgSFilPublicPieceInfo(piecesPtr)
@@ -507,7 +691,7 @@ func FilGenerateDataCommitment(registeredProof FilRegisteredSealProof, piecesPtr
 	return __v
 }
 
-// FilGenerateEmptySectorUpdatePartitionProofs function as declared in filecoin-ffi/filcrypto.h:639
+// FilGenerateEmptySectorUpdatePartitionProofs function as declared in filecoin-ffi/filcrypto.h:909
 func FilGenerateEmptySectorUpdatePartitionProofs(registeredProof FilRegisteredUpdateProof, commROld Fil32ByteArray, commRNew Fil32ByteArray, commDNew Fil32ByteArray, sectorKeyPath string, sectorKeyCacheDirPath string, replicaPath string, replicaCachePath string) *FilPartitionProofResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredUpdateProof)(registeredProof), cgoAllocsUnknown
 	ccommROld, ccommROldAllocMap := commROld.PassValue()
@@ -538,7 +722,7 @@ func FilGenerateEmptySectorUpdatePartitionProofs(registeredProof FilRegisteredUp
 	return __v
 }
 
-// FilGenerateEmptySectorUpdateProof function as declared in filecoin-ffi/filcrypto.h:652
+// FilGenerateEmptySectorUpdateProof function as declared in filecoin-ffi/filcrypto.h:922
 func FilGenerateEmptySectorUpdateProof(registeredProof FilRegisteredUpdateProof, commROld Fil32ByteArray, commRNew Fil32ByteArray, commDNew Fil32ByteArray, sectorKeyPath string, sectorKeyCacheDirPath string, replicaPath string, replicaCachePath string) *FilEmptySectorUpdateProofResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredUpdateProof)(registeredProof), cgoAllocsUnknown
 	ccommROld, ccommROldAllocMap := commROld.PassValue()
@@ -569,7 +753,7 @@ func FilGenerateEmptySectorUpdateProof(registeredProof FilRegisteredUpdateProof,
 	return __v
 }
 
-// FilGenerateEmptySectorUpdateProofWithVanilla function as declared in filecoin-ffi/filcrypto.h:665
+// FilGenerateEmptySectorUpdateProofWithVanilla function as declared in filecoin-ffi/filcrypto.h:935
 func FilGenerateEmptySectorUpdateProofWithVanilla(registeredProof FilRegisteredUpdateProof, vanillaProofsPtr []FilPartitionProof, vanillaProofsLen uint, commROld Fil32ByteArray, commRNew Fil32ByteArray, commDNew Fil32ByteArray) *FilEmptySectorUpdateProofResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredUpdateProof)(registeredProof), cgoAllocsUnknown
 	cvanillaProofsPtr, cvanillaProofsPtrAllocMap := unpackArgSFilPartitionProof(vanillaProofsPtr)
@@ -589,7 +773,7 @@ func FilGenerateEmptySectorUpdateProofWithVanilla(registeredProof FilRegisteredU
 	return __v
 }
 
-// FilGenerateFallbackSectorChallenges function as declared in filecoin-ffi/filcrypto.h:676
+// FilGenerateFallbackSectorChallenges function as declared in filecoin-ffi/filcrypto.h:946
 func FilGenerateFallbackSectorChallenges(registeredProof FilRegisteredPoStProof, randomness Fil32ByteArray, sectorIdsPtr []uint64, sectorIdsLen uint, proverId Fil32ByteArray) *FilGenerateFallbackSectorChallengesResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	crandomness, crandomnessAllocMap := randomness.PassValue()
@@ -606,7 +790,7 @@ func FilGenerateFallbackSectorChallenges(registeredProof FilRegisteredPoStProof,
 	return __v
 }
 
-// FilGeneratePieceCommitment function as declared in filecoin-ffi/filcrypto.h:686
+// FilGeneratePieceCommitment function as declared in filecoin-ffi/filcrypto.h:956
 func FilGeneratePieceCommitment(registeredProof FilRegisteredSealProof, pieceFdRaw int32, unpaddedPieceSize uint64) *FilGeneratePieceCommitmentResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	cpieceFdRaw, cpieceFdRawAllocMap := (C.int)(pieceFdRaw), cgoAllocsUnknown
@@ -619,7 +803,22 @@ func FilGeneratePieceCommitment(registeredProof FilRegisteredSealProof, pieceFdR
 	return __v
 }
 
-// FilGenerateSingleVanillaProof function as declared in filecoin-ffi/filcrypto.h:694
+// FilGenerateSdr function as declared in filecoin-ffi/filcrypto.h:964
+func FilGenerateSdr(registeredProof FilRegisteredSealProof, cacheDirPath string, replicaId Fil32ByteArray) *FilGenerateSDRResponse {
+	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
+	cacheDirPath = safeString(cacheDirPath)
+	ccacheDirPath, ccacheDirPathAllocMap := unpackPCharString(cacheDirPath)
+	creplicaId, creplicaIdAllocMap := replicaId.PassValue()
+	__ret := C.fil_generate_sdr(cregisteredProof, ccacheDirPath, creplicaId)
+	runtime.KeepAlive(creplicaIdAllocMap)
+	runtime.KeepAlive(cacheDirPath)
+	runtime.KeepAlive(ccacheDirPathAllocMap)
+	runtime.KeepAlive(cregisteredProofAllocMap)
+	__v := NewFilGenerateSDRResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilGenerateSingleVanillaProof function as declared in filecoin-ffi/filcrypto.h:972
 func FilGenerateSingleVanillaProof(replica FilPrivateReplicaInfo, challengesPtr []uint64, challengesLen uint) *FilGenerateSingleVanillaProofResponse {
 	creplica, creplicaAllocMap := replica.PassValue()
 	cchallengesPtr, cchallengesPtrAllocMap := copyPUint64TBytes((*sliceHeader)(unsafe.Pointer(&challengesPtr)))
@@ -632,7 +831,7 @@ func FilGenerateSingleVanillaProof(replica FilPrivateReplicaInfo, challengesPtr
 	return __v
 }
 
-// FilGenerateSingleWindowPostWithVanilla function as declared in filecoin-ffi/filcrypto.h:702
+// FilGenerateSingleWindowPostWithVanilla function as declared in filecoin-ffi/filcrypto.h:980
 func FilGenerateSingleWindowPostWithVanilla(registeredProof FilRegisteredPoStProof, randomness Fil32ByteArray, proverId Fil32ByteArray, vanillaProofsPtr []FilVanillaProof, vanillaProofsLen uint, partitionIndex uint) *FilGenerateSingleWindowPoStWithVanillaResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	crandomness, crandomnessAllocMap := randomness.PassValue()
@@ -652,7 +851,7 @@ func FilGenerateSingleWindowPostWithVanilla(registeredProof FilRegisteredPoStPro
 	return __v
 }
 
-// FilGenerateWindowPost function as declared in filecoin-ffi/filcrypto.h:713
+// FilGenerateWindowPost function as declared in filecoin-ffi/filcrypto.h:991
 func FilGenerateWindowPost(randomness Fil32ByteArray, replicasPtr []FilPrivateReplicaInfo, replicasLen uint, proverId Fil32ByteArray) *FilGenerateWindowPoStResponse {
 	crandomness, crandomnessAllocMap := randomness.PassValue()
 	creplicasPtr, creplicasPtrAllocMap := unpackArgSFilPrivateReplicaInfo(replicasPtr)
@@ -668,7 +867,7 @@ func FilGenerateWindowPost(randomness Fil32ByteArray, replicasPtr []FilPrivateRe
 	return __v
 }
 
-// FilGenerateWindowPostWithVanilla function as declared in filecoin-ffi/filcrypto.h:722
+// FilGenerateWindowPostWithVanilla function as declared in filecoin-ffi/filcrypto.h:1000
 func FilGenerateWindowPostWithVanilla(registeredProof FilRegisteredPoStProof, randomness Fil32ByteArray, proverId Fil32ByteArray, vanillaProofsPtr []FilVanillaProof, vanillaProofsLen uint) *FilGenerateWindowPoStResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	crandomness, crandomnessAllocMap := randomness.PassValue()
@@ -686,7 +885,7 @@ func FilGenerateWindowPostWithVanilla(registeredProof FilRegisteredPoStProof, ra
 	return __v
 }
 
-// FilGenerateWinningPost function as declared in filecoin-ffi/filcrypto.h:732
+// FilGenerateWinningPost function as declared in filecoin-ffi/filcrypto.h:1010
 func FilGenerateWinningPost(randomness Fil32ByteArray, replicasPtr []FilPrivateReplicaInfo, replicasLen uint, proverId Fil32ByteArray) *FilGenerateWinningPoStResponse {
 	crandomness, crandomnessAllocMap := randomness.PassValue()
 	creplicasPtr, creplicasPtrAllocMap := unpackArgSFilPrivateReplicaInfo(replicasPtr)
@@ -702,7 +901,7 @@ func FilGenerateWinningPost(randomness Fil32ByteArray, replicasPtr []FilPrivateR
 	return __v
 }
 
-// FilGenerateWinningPostSectorChallenge function as declared in filecoin-ffi/filcrypto.h:741
+// FilGenerateWinningPostSectorChallenge function as declared in filecoin-ffi/filcrypto.h:1019
 func FilGenerateWinningPostSectorChallenge(registeredProof FilRegisteredPoStProof, randomness Fil32ByteArray, sectorSetLen uint64, proverId Fil32ByteArray) *FilGenerateWinningPoStSectorChallenge {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	crandomness, crandomnessAllocMap := randomness.PassValue()
@@ -717,7 +916,7 @@ func FilGenerateWinningPostSectorChallenge(registeredProof FilRegisteredPoStProo
 	return __v
 }
 
-// FilGenerateWinningPostWithVanilla function as declared in filecoin-ffi/filcrypto.h:750
+// FilGenerateWinningPostWithVanilla function as declared in filecoin-ffi/filcrypto.h:1028
 func FilGenerateWinningPostWithVanilla(registeredProof FilRegisteredPoStProof, randomness Fil32ByteArray, proverId Fil32ByteArray, vanillaProofsPtr []FilVanillaProof, vanillaProofsLen uint) *FilGenerateWinningPoStResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	crandomness, crandomnessAllocMap := randomness.PassValue()
@@ -735,14 +934,14 @@ func FilGenerateWinningPostWithVanilla(registeredProof FilRegisteredPoStProof, r
 	return __v
 }
 
-// FilGetGpuDevices function as declared in filecoin-ffi/filcrypto.h:759
+// FilGetGpuDevices function as declared in filecoin-ffi/filcrypto.h:1037
 func FilGetGpuDevices() *FilGpuDeviceResponse {
 	__ret := C.fil_get_gpu_devices()
 	__v := NewFilGpuDeviceResponseRef(unsafe.Pointer(__ret))
 	return __v
 }
 
-// FilGetMaxUserBytesPerStagedSector function as declared in filecoin-ffi/filcrypto.h:765
+// FilGetMaxUserBytesPerStagedSector function as declared in filecoin-ffi/filcrypto.h:1043
 func FilGetMaxUserBytesPerStagedSector(registeredProof FilRegisteredSealProof) uint64 {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_max_user_bytes_per_staged_sector(cregisteredProof)
@@ -751,7 +950,7 @@ func FilGetMaxUserBytesPerStagedSector(registeredProof FilRegisteredSealProof) u
 	return __v
 }
 
-// FilGetNumPartitionForFallbackPost function as declared in filecoin-ffi/filcrypto.h:771
+// FilGetNumPartitionForFallbackPost function as declared in filecoin-ffi/filcrypto.h:1049
 func FilGetNumPartitionForFallbackPost(registeredProof FilRegisteredPoStProof, numSectors uint) *FilGetNumPartitionForFallbackPoStResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	cnumSectors, cnumSectorsAllocMap := (C.size_t)(numSectors), cgoAllocsUnknown
@@ -762,7 +961,7 @@ func FilGetNumPartitionForFallbackPost(registeredProof FilRegisteredPoStProof, n
 	return __v
 }
 
-// FilGetPostCircuitIdentifier function as declared in filecoin-ffi/filcrypto.h:778
+// FilGetPostCircuitIdentifier function as declared in filecoin-ffi/filcrypto.h:1056
 func FilGetPostCircuitIdentifier(registeredProof FilRegisteredPoStProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_post_circuit_identifier(cregisteredProof)
@@ -771,7 +970,7 @@ func FilGetPostCircuitIdentifier(registeredProof FilRegisteredPoStProof) *FilStr
 	return __v
 }
 
-// FilGetPostParamsCid function as declared in filecoin-ffi/filcrypto.h:784
+// FilGetPostParamsCid function as declared in filecoin-ffi/filcrypto.h:1062
 func FilGetPostParamsCid(registeredProof FilRegisteredPoStProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_post_params_cid(cregisteredProof)
@@ -780,7 +979,7 @@ func FilGetPostParamsCid(registeredProof FilRegisteredPoStProof) *FilStringRespo
 	return __v
 }
 
-// FilGetPostParamsPath function as declared in filecoin-ffi/filcrypto.h:791
+// FilGetPostParamsPath function as declared in filecoin-ffi/filcrypto.h:1069
 func FilGetPostParamsPath(registeredProof FilRegisteredPoStProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_post_params_path(cregisteredProof)
@@ -789,7 +988,7 @@ func FilGetPostParamsPath(registeredProof FilRegisteredPoStProof) *FilStringResp
 	return __v
 }
 
-// FilGetPostVerifyingKeyCid function as declared in filecoin-ffi/filcrypto.h:797
+// FilGetPostVerifyingKeyCid function as declared in filecoin-ffi/filcrypto.h:1075
 func FilGetPostVerifyingKeyCid(registeredProof FilRegisteredPoStProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_post_verifying_key_cid(cregisteredProof)
@@ -798,7 +997,7 @@ func FilGetPostVerifyingKeyCid(registeredProof FilRegisteredPoStProof) *FilStrin
 	return __v
 }
 
-// FilGetPostVerifyingKeyPath function as declared in filecoin-ffi/filcrypto.h:804
+// FilGetPostVerifyingKeyPath function as declared in filecoin-ffi/filcrypto.h:1082
 func FilGetPostVerifyingKeyPath(registeredProof FilRegisteredPoStProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_post_verifying_key_path(cregisteredProof)
@@ -807,7 +1006,7 @@ func FilGetPostVerifyingKeyPath(registeredProof FilRegisteredPoStProof) *FilStri
 	return __v
 }
 
-// FilGetPostVersion function as declared in filecoin-ffi/filcrypto.h:810
+// FilGetPostVersion function as declared in filecoin-ffi/filcrypto.h:1088
 func FilGetPostVersion(registeredProof FilRegisteredPoStProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_post_version(cregisteredProof)
@@ -816,7 +1015,7 @@ func FilGetPostVersion(registeredProof FilRegisteredPoStProof) *FilStringRespons
 	return __v
 }
 
-// FilGetSealCircuitIdentifier function as declared in filecoin-ffi/filcrypto.h:816
+// FilGetSealCircuitIdentifier function as declared in filecoin-ffi/filcrypto.h:1094
 func FilGetSealCircuitIdentifier(registeredProof FilRegisteredSealProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_seal_circuit_identifier(cregisteredProof)
@@ -825,7 +1024,7 @@ func FilGetSealCircuitIdentifier(registeredProof FilRegisteredSealProof) *FilStr
 	return __v
 }
 
-// FilGetSealParamsCid function as declared in filecoin-ffi/filcrypto.h:822
+// FilGetSealParamsCid function as declared in filecoin-ffi/filcrypto.h:1100
 func FilGetSealParamsCid(registeredProof FilRegisteredSealProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_seal_params_cid(cregisteredProof)
@@ -834,7 +1033,7 @@ func FilGetSealParamsCid(registeredProof FilRegisteredSealProof) *FilStringRespo
 	return __v
 }
 
-// FilGetSealParamsPath function as declared in filecoin-ffi/filcrypto.h:829
+// FilGetSealParamsPath function as declared in filecoin-ffi/filcrypto.h:1107
 func FilGetSealParamsPath(registeredProof FilRegisteredSealProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_seal_params_path(cregisteredProof)
@@ -843,7 +1042,7 @@ func FilGetSealParamsPath(registeredProof FilRegisteredSealProof) *FilStringResp
 	return __v
 }
 
-// FilGetSealVerifyingKeyCid function as declared in filecoin-ffi/filcrypto.h:835
+// FilGetSealVerifyingKeyCid function as declared in filecoin-ffi/filcrypto.h:1113
 func FilGetSealVerifyingKeyCid(registeredProof FilRegisteredSealProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_seal_verifying_key_cid(cregisteredProof)
@@ -852,7 +1051,7 @@ func FilGetSealVerifyingKeyCid(registeredProof FilRegisteredSealProof) *FilStrin
 	return __v
 }
 
-// FilGetSealVerifyingKeyPath function as declared in filecoin-ffi/filcrypto.h:842
+// FilGetSealVerifyingKeyPath function as declared in filecoin-ffi/filcrypto.h:1120
 func FilGetSealVerifyingKeyPath(registeredProof FilRegisteredSealProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_seal_verifying_key_path(cregisteredProof)
@@ -861,7 +1060,7 @@ func FilGetSealVerifyingKeyPath(registeredProof FilRegisteredSealProof) *FilStri
 	return __v
 }
 
-// FilGetSealVersion function as declared in filecoin-ffi/filcrypto.h:848
+// FilGetSealVersion function as declared in filecoin-ffi/filcrypto.h:1126
 func FilGetSealVersion(registeredProof FilRegisteredSealProof) *FilStringResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	__ret := C.fil_get_seal_version(cregisteredProof)
@@ -870,7 +1069,7 @@ func FilGetSealVersion(registeredProof FilRegisteredSealProof) *FilStringRespons
 	return __v
 }
 
-// FilHash function as declared in filecoin-ffi/filcrypto.h:858
+// FilHash function as declared in filecoin-ffi/filcrypto.h:1136
 func FilHash(messagePtr []byte, messageLen uint) *FilHashResponse {
 	cmessagePtr, cmessagePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&messagePtr)))
 	cmessageLen, cmessageLenAllocMap := (C.size_t)(messageLen), cgoAllocsUnknown
@@ -881,7 +1080,7 @@ func FilHash(messagePtr []byte, messageLen uint) *FilHashResponse {
 	return __v
 }
 
-// FilHashVerify function as declared in filecoin-ffi/filcrypto.h:872
+// FilHashVerify function as declared in filecoin-ffi/filcrypto.h:1150
 func FilHashVerify(signaturePtr []byte, flattenedMessagesPtr []byte, flattenedMessagesLen uint, messageSizesPtr []uint, messageSizesLen uint, flattenedPublicKeysPtr []byte, flattenedPublicKeysLen uint) int32 {
 	csignaturePtr, csignaturePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&signaturePtr)))
 	cflattenedMessagesPtr, cflattenedMessagesPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedMessagesPtr)))
@@ -902,7 +1101,66 @@ func FilHashVerify(signaturePtr []byte, flattenedMessagesPtr []byte, flattenedMe
 	return __v
 }
 
-// FilInitLogFd function as declared in filecoin-ffi/filcrypto.h:889
+// FilHashVerifyBatch function as declared in filecoin-ffi/filcrypto.h:1179
+func FilHashVerifyBatch(flattenedMessagesPtr []byte, flattenedMessagesLen uint, messageSizesPtr []uint, messageSizesLen uint, flattenedPublicKeysPtr []byte, flattenedPublicKeysLen uint, flattenedSignaturesPtr []byte, flattenedSignaturesLen uint) *FilHashVerifyBatchResponse {
+	cflattenedMessagesPtr, cflattenedMessagesPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedMessagesPtr)))
+	cflattenedMessagesLen, cflattenedMessagesLenAllocMap := (C.size_t)(flattenedMessagesLen), cgoAllocsUnknown
+	cmessageSizesPtr, cmessageSizesPtrAllocMap := copyPSizeTBytes((*sliceHeader)(unsafe.Pointer(&messageSizesPtr)))
+	cmessageSizesLen, cmessageSizesLenAllocMap := (C.size_t)(messageSizesLen), cgoAllocsUnknown
+	cflattenedPublicKeysPtr, cflattenedPublicKeysPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedPublicKeysPtr)))
+	cflattenedPublicKeysLen, cflattenedPublicKeysLenAllocMap := (C.size_t)(flattenedPublicKeysLen), cgoAllocsUnknown
+	cflattenedSignaturesPtr, cflattenedSignaturesPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedSignaturesPtr)))
+	cflattenedSignaturesLen, cflattenedSignaturesLenAllocMap := (C.size_t)(flattenedSignaturesLen), cgoAllocsUnknown
+	__ret := C.fil_hash_verify_batch(cflattenedMessagesPtr, cflattenedMessagesLen, cmessageSizesPtr, cmessageSizesLen, cflattenedPublicKeysPtr, cflattenedPublicKeysLen, cflattenedSignaturesPtr, cflattenedSignaturesLen)
+	runtime.KeepAlive(cflattenedSignaturesLenAllocMap)
+	runtime.KeepAlive(cflattenedSignaturesPtrAllocMap)
+	runtime.KeepAlive(cflattenedPublicKeysLenAllocMap)
+	runtime.KeepAlive(cflattenedPublicKeysPtrAllocMap)
+	runtime.KeepAlive(cmessageSizesLenAllocMap)
+	runtime.KeepAlive(cmessageSizesPtrAllocMap)
+	runtime.KeepAlive(cflattenedMessagesLenAllocMap)
+	runtime.KeepAlive(cflattenedMessagesPtrAllocMap)
+	__v := NewFilHashVerifyBatchResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilHashVerifyG1 function as declared in filecoin-ffi/filcrypto.h:1202
+func FilHashVerifyG1(signaturePtr []byte, flattenedMessagesPtr []byte, flattenedMessagesLen uint, messageSizesPtr []uint, messageSizesLen uint, flattenedPublicKeysPtr []byte, flattenedPublicKeysLen uint) int32 {
+	csignaturePtr, csignaturePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&signaturePtr)))
+	cflattenedMessagesPtr, cflattenedMessagesPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedMessagesPtr)))
+	cflattenedMessagesLen, cflattenedMessagesLenAllocMap := (C.size_t)(flattenedMessagesLen), cgoAllocsUnknown
+	cmessageSizesPtr, cmessageSizesPtrAllocMap := copyPSizeTBytes((*sliceHeader)(unsafe.Pointer(&messageSizesPtr)))
+	cmessageSizesLen, cmessageSizesLenAllocMap := (C.size_t)(messageSizesLen), cgoAllocsUnknown
+	cflattenedPublicKeysPtr, cflattenedPublicKeysPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedPublicKeysPtr)))
+	cflattenedPublicKeysLen, cflattenedPublicKeysLenAllocMap := (C.size_t)(flattenedPublicKeysLen), cgoAllocsUnknown
+	__ret := C.fil_hash_verify_g1(csignaturePtr, cflattenedMessagesPtr, cflattenedMessagesLen, cmessageSizesPtr, cmessageSizesLen, cflattenedPublicKeysPtr, cflattenedPublicKeysLen)
+	runtime.KeepAlive(cflattenedPublicKeysLenAllocMap)
+	runtime.KeepAlive(cflattenedPublicKeysPtrAllocMap)
+	runtime.KeepAlive(cmessageSizesLenAllocMap)
+	runtime.KeepAlive(cmessageSizesPtrAllocMap)
+	runtime.KeepAlive(cflattenedMessagesLenAllocMap)
+	runtime.KeepAlive(cflattenedMessagesPtrAllocMap)
+	runtime.KeepAlive(csignaturePtrAllocMap)
+	__v := (int32)(__ret)
+	return __v
+}
+
+// FilHashWithDst function as declared in filecoin-ffi/filcrypto.h:1222
+func FilHashWithDst(messagePtr []byte, messageLen uint, dstPtr []byte, dstLen uint) *FilHashResponse {
+	cmessagePtr, cmessagePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&messagePtr)))
+	cmessageLen, cmessageLenAllocMap := (C.size_t)(messageLen), cgoAllocsUnknown
+	cdstPtr, cdstPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&dstPtr)))
+	cdstLen, cdstLenAllocMap := (C.size_t)(dstLen), cgoAllocsUnknown
+	__ret := C.fil_hash_with_dst(cmessagePtr, cmessageLen, cdstPtr, cdstLen)
+	runtime.KeepAlive(cdstLenAllocMap)
+	runtime.KeepAlive(cdstPtrAllocMap)
+	runtime.KeepAlive(cmessageLenAllocMap)
+	runtime.KeepAlive(cmessagePtrAllocMap)
+	__v := NewFilHashResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilInitLogFd function as declared in filecoin-ffi/filcrypto.h:1236
 func FilInitLogFd(logFd int32) *FilInitLogFdResponse {
 	clogFd, clogFdAllocMap := (C.int)(logFd), cgoAllocsUnknown
 	__ret := C.fil_init_log_fd(clogFd)
@@ -911,7 +1169,16 @@ func FilInitLogFd(logFd int32) *FilInitLogFdResponse {
 	return __v
 }
 
-// FilMergeWindowPostPartitionProofs function as declared in filecoin-ffi/filcrypto.h:895
+// FilMapToG2 function as declared in filecoin-ffi/filcrypto.h:1249
+func FilMapToG2(fieldElementsPtr []byte) *FilHashResponse {
+	cfieldElementsPtr, cfieldElementsPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&fieldElementsPtr)))
+	__ret := C.fil_map_to_g2(cfieldElementsPtr)
+	runtime.KeepAlive(cfieldElementsPtrAllocMap)
+	__v := NewFilHashResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilMergeWindowPostPartitionProofs function as declared in filecoin-ffi/filcrypto.h:1255
 func FilMergeWindowPostPartitionProofs(registeredProof FilRegisteredPoStProof, partitionProofsPtr []FilPartitionSnarkProof, partitionProofsLen uint) *FilMergeWindowPoStPartitionProofsResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredPoStProof)(registeredProof), cgoAllocsUnknown
 	cpartitionProofsPtr, cpartitionProofsPtrAllocMap := unpackArgSFilPartitionSnarkProof(partitionProofsPtr)
@@ -925,14 +1192,34 @@ func FilMergeWindowPostPartitionProofs(registeredProof FilRegisteredPoStProof, p
 	return __v
 }
 
-// FilPrivateKeyGenerate function as declared in filecoin-ffi/filcrypto.h:902
+// FilPopProve function as declared in filecoin-ffi/filcrypto.h:1269
+func FilPopProve(rawPrivateKeyPtr []byte) *FilPopProveResponse {
+	crawPrivateKeyPtr, crawPrivateKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&rawPrivateKeyPtr)))
+	__ret := C.fil_pop_prove(crawPrivateKeyPtr)
+	runtime.KeepAlive(crawPrivateKeyPtrAllocMap)
+	__v := NewFilPopProveResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilPopVerify function as declared in filecoin-ffi/filcrypto.h:1279
+func FilPopVerify(publicKeyPtr []byte, signaturePtr []byte) int32 {
+	cpublicKeyPtr, cpublicKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&publicKeyPtr)))
+	csignaturePtr, csignaturePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&signaturePtr)))
+	__ret := C.fil_pop_verify(cpublicKeyPtr, csignaturePtr)
+	runtime.KeepAlive(csignaturePtrAllocMap)
+	runtime.KeepAlive(cpublicKeyPtrAllocMap)
+	__v := (int32)(__ret)
+	return __v
+}
+
+// FilPrivateKeyGenerate function as declared in filecoin-ffi/filcrypto.h:1284
 func FilPrivateKeyGenerate() *FilPrivateKeyGenerateResponse {
 	__ret := C.fil_private_key_generate()
 	__v := NewFilPrivateKeyGenerateResponseRef(unsafe.Pointer(__ret))
 	return __v
 }
 
-// FilPrivateKeyGenerateWithSeed function as declared in filecoin-ffi/filcrypto.h:915
+// FilPrivateKeyGenerateWithSeed function as declared in filecoin-ffi/filcrypto.h:1297
 func FilPrivateKeyGenerateWithSeed(rawSeed Fil32ByteArray) *FilPrivateKeyGenerateResponse {
 	crawSeed, crawSeedAllocMap := rawSeed.PassValue()
 	__ret := C.fil_private_key_generate_with_seed(crawSeed)
@@ -941,7 +1228,7 @@ func FilPrivateKeyGenerateWithSeed(rawSeed Fil32ByteArray) *FilPrivateKeyGenerat
 	return __v
 }
 
-// FilPrivateKeyPublicKey function as declared in filecoin-ffi/filcrypto.h:926
+// FilPrivateKeyPublicKey function as declared in filecoin-ffi/filcrypto.h:1308
 func FilPrivateKeyPublicKey(rawPrivateKeyPtr []byte) *FilPrivateKeyPublicKeyResponse {
 	crawPrivateKeyPtr, crawPrivateKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&rawPrivateKeyPtr)))
 	__ret := C.fil_private_key_public_key(crawPrivateKeyPtr)
@@ -950,7 +1237,16 @@ func FilPrivateKeyPublicKey(rawPrivateKeyPtr []byte) *FilPrivateKeyPublicKeyResp
 	return __v
 }
 
-// FilPrivateKeySign function as declared in filecoin-ffi/filcrypto.h:939
+// FilPrivateKeyPublicKeyG2 function as declared in filecoin-ffi/filcrypto.h:1319
+func FilPrivateKeyPublicKeyG2(rawPrivateKeyPtr []byte) *FilPrivateKeyPublicKeyG2Response {
+	crawPrivateKeyPtr, crawPrivateKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&rawPrivateKeyPtr)))
+	__ret := C.fil_private_key_public_key_g2(crawPrivateKeyPtr)
+	runtime.KeepAlive(crawPrivateKeyPtrAllocMap)
+	__v := NewFilPrivateKeyPublicKeyG2ResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilPrivateKeySign function as declared in filecoin-ffi/filcrypto.h:1332
 func FilPrivateKeySign(rawPrivateKeyPtr []byte, messagePtr []byte, messageLen uint) *FilPrivateKeySignResponse {
 	crawPrivateKeyPtr, crawPrivateKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&rawPrivateKeyPtr)))
 	cmessagePtr, cmessagePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&messagePtr)))
@@ -963,7 +1259,77 @@ func FilPrivateKeySign(rawPrivateKeyPtr []byte, messagePtr []byte, messageLen ui
 	return __v
 }
 
-// FilSealCommitPhase1 function as declared in filecoin-ffi/filcrypto.h:947
+// FilPrivateKeySignDigest function as declared in filecoin-ffi/filcrypto.h:1348
+func FilPrivateKeySignDigest(rawPrivateKeyPtr []byte, digestPtr []byte) *FilPrivateKeySignResponse {
+	crawPrivateKeyPtr, crawPrivateKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&rawPrivateKeyPtr)))
+	cdigestPtr, cdigestPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&digestPtr)))
+	__ret := C.fil_private_key_sign_digest(crawPrivateKeyPtr, cdigestPtr)
+	runtime.KeepAlive(cdigestPtrAllocMap)
+	runtime.KeepAlive(crawPrivateKeyPtrAllocMap)
+	__v := NewFilPrivateKeySignResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilPrivateKeySignG1 function as declared in filecoin-ffi/filcrypto.h:1363
+func FilPrivateKeySignG1(rawPrivateKeyPtr []byte, messagePtr []byte, messageLen uint) *FilSignatureG1Response {
+	crawPrivateKeyPtr, crawPrivateKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&rawPrivateKeyPtr)))
+	cmessagePtr, cmessagePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&messagePtr)))
+	cmessageLen, cmessageLenAllocMap := (C.size_t)(messageLen), cgoAllocsUnknown
+	__ret := C.fil_private_key_sign_g1(crawPrivateKeyPtr, cmessagePtr, cmessageLen)
+	runtime.KeepAlive(cmessageLenAllocMap)
+	runtime.KeepAlive(cmessagePtrAllocMap)
+	runtime.KeepAlive(crawPrivateKeyPtrAllocMap)
+	__v := NewFilSignatureG1ResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilPrivateKeySignWithDst function as declared in filecoin-ffi/filcrypto.h:1381
+func FilPrivateKeySignWithDst(rawPrivateKeyPtr []byte, messagePtr []byte, messageLen uint, dstPtr []byte, dstLen uint) *FilPrivateKeySignResponse {
+	crawPrivateKeyPtr, crawPrivateKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&rawPrivateKeyPtr)))
+	cmessagePtr, cmessagePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&messagePtr)))
+	cmessageLen, cmessageLenAllocMap := (C.size_t)(messageLen), cgoAllocsUnknown
+	cdstPtr, cdstPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&dstPtr)))
+	cdstLen, cdstLenAllocMap := (C.size_t)(dstLen), cgoAllocsUnknown
+	__ret := C.fil_private_key_sign_with_dst(crawPrivateKeyPtr, cmessagePtr, cmessageLen, cdstPtr, cdstLen)
+	runtime.KeepAlive(cdstLenAllocMap)
+	runtime.KeepAlive(cdstPtrAllocMap)
+	runtime.KeepAlive(cmessageLenAllocMap)
+	runtime.KeepAlive(cmessagePtrAllocMap)
+	runtime.KeepAlive(crawPrivateKeyPtrAllocMap)
+	__v := NewFilPrivateKeySignResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilPrivateKeysPublicKeys function as declared in filecoin-ffi/filcrypto.h:1399
+func FilPrivateKeysPublicKeys(flattenedPrivateKeysPtr []byte, flattenedPrivateKeysLen uint) *FilPrivateKeysPublicKeysResponse {
+	cflattenedPrivateKeysPtr, cflattenedPrivateKeysPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedPrivateKeysPtr)))
+	cflattenedPrivateKeysLen, cflattenedPrivateKeysLenAllocMap := (C.size_t)(flattenedPrivateKeysLen), cgoAllocsUnknown
+	__ret := C.fil_private_keys_public_keys(cflattenedPrivateKeysPtr, cflattenedPrivateKeysLen)
+	runtime.KeepAlive(cflattenedPrivateKeysLenAllocMap)
+	runtime.KeepAlive(cflattenedPrivateKeysPtrAllocMap)
+	__v := NewFilPrivateKeysPublicKeysResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilPublicKeyFromUncompressed function as declared in filecoin-ffi/filcrypto.h:1412
+func FilPublicKeyFromUncompressed(uncompressedPublicKeyPtr []byte) *FilPublicKeyFromUncompressedResponse {
+	cuncompressedPublicKeyPtr, cuncompressedPublicKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&uncompressedPublicKeyPtr)))
+	__ret := C.fil_public_key_from_uncompressed(cuncompressedPublicKeyPtr)
+	runtime.KeepAlive(cuncompressedPublicKeyPtrAllocMap)
+	__v := NewFilPublicKeyFromUncompressedResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilPublicKeyToUncompressed function as declared in filecoin-ffi/filcrypto.h:1423
+func FilPublicKeyToUncompressed(publicKeyPtr []byte) *FilPublicKeyToUncompressedResponse {
+	cpublicKeyPtr, cpublicKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&publicKeyPtr)))
+	__ret := C.fil_public_key_to_uncompressed(cpublicKeyPtr)
+	runtime.KeepAlive(cpublicKeyPtrAllocMap)
+	__v := NewFilPublicKeyToUncompressedResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilSealCommitPhase1 function as declared in filecoin-ffi/filcrypto.h:1429
 func FilSealCommitPhase1(registeredProof FilRegisteredSealProof, commR Fil32ByteArray, commD Fil32ByteArray, cacheDirPath string, replicaPath string, sectorId uint64, proverId Fil32ByteArray, ticket Fil32ByteArray, seed Fil32ByteArray, piecesPtr []FilPublicPieceInfo, piecesLen uint) *FilSealCommitPhase1Response {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	ccommR, ccommRAllocMap := commR.PassValue()
@@ -997,7 +1363,45 @@ func FilSealCommitPhase1(registeredProof FilRegisteredSealProof, commR Fil32Byte
 	return __v
 }
 
-// FilSealCommitPhase2 function as declared in filecoin-ffi/filcrypto.h:959
+// FilSealCommitPhase1ToFile function as declared in filecoin-ffi/filcrypto.h:1446
+func FilSealCommitPhase1ToFile(registeredProof FilRegisteredSealProof, commR Fil32ByteArray, commD Fil32ByteArray, cacheDirPath string, replicaPath string, sectorId uint64, proverId Fil32ByteArray, ticket Fil32ByteArray, seed Fil32ByteArray, piecesPtr []FilPublicPieceInfo, piecesLen uint, outputPath string) *FilSealCommitPhase1ToFileResponse {
+	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
+	ccommR, ccommRAllocMap := commR.PassValue()
+	ccommD, ccommDAllocMap := commD.PassValue()
+	cacheDirPath = safeString(cacheDirPath)
+	ccacheDirPath, ccacheDirPathAllocMap := unpackPCharString(cacheDirPath)
+	replicaPath = safeString(replicaPath)
+	creplicaPath, creplicaPathAllocMap := unpackPCharString(replicaPath)
+	csectorId, csectorIdAllocMap := (C.uint64_t)(sectorId), cgoAllocsUnknown
+	cproverId, cproverIdAllocMap := proverId.PassValue()
+	cticket, cticketAllocMap := ticket.PassValue()
+	cseed, cseedAllocMap := seed.PassValue()
+	cpiecesPtr, cpiecesPtrAllocMap := unpackArgSFilPublicPieceInfo(piecesPtr)
+	cpiecesLen, cpiecesLenAllocMap := (C.size_t)(piecesLen), cgoAllocsUnknown
+	outputPath = safeString(outputPath)
+	coutputPath, coutputPathAllocMap := unpackPCharString(outputPath)
+	__ret := C.fil_seal_commit_phase1_to_file(cregisteredProof, ccommR, ccommD, ccacheDirPath, creplicaPath, csectorId, cproverId, cticket, cseed, cpiecesPtr, cpiecesLen, coutputPath)
+	runtime.KeepAlive(outputPath)
+	runtime.KeepAlive(coutputPathAllocMap)
+	runtime.KeepAlive(cpiecesLenAllocMap)
+	packSFilPublicPieceInfo(piecesPtr, cpiecesPtr)
+	runtime.KeepAlive(cpiecesPtrAllocMap)
+	runtime.KeepAlive(cseedAllocMap)
+	runtime.KeepAlive(cticketAllocMap)
+	runtime.KeepAlive(cproverIdAllocMap)
+	runtime.KeepAlive(csectorIdAllocMap)
+	runtime.KeepAlive(replicaPath)
+	runtime.KeepAlive(creplicaPathAllocMap)
+	runtime.KeepAlive(cacheDirPath)
+	runtime.KeepAlive(ccacheDirPathAllocMap)
+	runtime.KeepAlive(ccommDAllocMap)
+	runtime.KeepAlive(ccommRAllocMap)
+	runtime.KeepAlive(cregisteredProofAllocMap)
+	__v := NewFilSealCommitPhase1ToFileResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilSealCommitPhase2 function as declared in filecoin-ffi/filcrypto.h:1459
 func FilSealCommitPhase2(sealCommitPhase1OutputPtr []byte, sealCommitPhase1OutputLen uint, sectorId uint64, proverId Fil32ByteArray) *FilSealCommitPhase2Response {
 	csealCommitPhase1OutputPtr, csealCommitPhase1OutputPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&sealCommitPhase1OutputPtr)))
 	csealCommitPhase1OutputLen, csealCommitPhase1OutputLenAllocMap := (C.size_t)(sealCommitPhase1OutputLen), cgoAllocsUnknown
@@ -1012,7 +1416,34 @@ func FilSealCommitPhase2(sealCommitPhase1OutputPtr []byte, sealCommitPhase1Outpu
 	return __v
 }
 
-// FilSealPreCommitPhase1 function as declared in filecoin-ffi/filcrypto.h:968
+// FilSealCommitPhase2Batch function as declared in filecoin-ffi/filcrypto.h:1474
+func FilSealCommitPhase2Batch(inputsPtr []FilSealCommitPhase2BatchInput, inputsLen uint) *FilSealCommitPhase2BatchResponse {
+	cinputsPtr, cinputsPtrAllocMap := unpackArgSFilSealCommitPhase2BatchInput(inputsPtr)
+	cinputsLen, cinputsLenAllocMap := (C.size_t)(inputsLen), cgoAllocsUnknown
+	__ret := C.fil_seal_commit_phase2_batch(cinputsPtr, cinputsLen)
+	runtime.KeepAlive(cinputsLenAllocMap)
+	packSFilSealCommitPhase2BatchInput(inputsPtr, cinputsPtr)
+	runtime.KeepAlive(cinputsPtrAllocMap)
+	__v := NewFilSealCommitPhase2BatchResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilSealCommitPhase2FromFile function as declared in filecoin-ffi/filcrypto.h:1481
+func FilSealCommitPhase2FromFile(sealCommitPhase1OutputPath string, sectorId uint64, proverId Fil32ByteArray) *FilSealCommitPhase2Response {
+	sealCommitPhase1OutputPath = safeString(sealCommitPhase1OutputPath)
+	csealCommitPhase1OutputPath, csealCommitPhase1OutputPathAllocMap := unpackPCharString(sealCommitPhase1OutputPath)
+	csectorId, csectorIdAllocMap := (C.uint64_t)(sectorId), cgoAllocsUnknown
+	cproverId, cproverIdAllocMap := proverId.PassValue()
+	__ret := C.fil_seal_commit_phase2_from_file(csealCommitPhase1OutputPath, csectorId, cproverId)
+	runtime.KeepAlive(cproverIdAllocMap)
+	runtime.KeepAlive(csectorIdAllocMap)
+	runtime.KeepAlive(sealCommitPhase1OutputPath)
+	runtime.KeepAlive(csealCommitPhase1OutputPathAllocMap)
+	__v := NewFilSealCommitPhase2ResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilSealPreCommitPhase1 function as declared in filecoin-ffi/filcrypto.h:1489
 func FilSealPreCommitPhase1(registeredProof FilRegisteredSealProof, cacheDirPath string, stagedSectorPath string, sealedSectorPath string, sectorId uint64, proverId Fil32ByteArray, ticket Fil32ByteArray, piecesPtr []FilPublicPieceInfo, piecesLen uint) *FilSealPreCommitPhase1Response {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	cacheDirPath = safeString(cacheDirPath)
@@ -1044,7 +1475,7 @@ func FilSealPreCommitPhase1(registeredProof FilRegisteredSealProof, cacheDirPath
 	return __v
 }
 
-// FilSealPreCommitPhase2 function as declared in filecoin-ffi/filcrypto.h:982
+// FilSealPreCommitPhase2 function as declared in filecoin-ffi/filcrypto.h:1503
 func FilSealPreCommitPhase2(sealPreCommitPhase1OutputPtr []byte, sealPreCommitPhase1OutputLen uint, cacheDirPath string, sealedSectorPath string) *FilSealPreCommitPhase2Response {
 	csealPreCommitPhase1OutputPtr, csealPreCommitPhase1OutputPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&sealPreCommitPhase1OutputPtr)))
 	csealPreCommitPhase1OutputLen, csealPreCommitPhase1OutputLenAllocMap := (C.size_t)(sealPreCommitPhase1OutputLen), cgoAllocsUnknown
@@ -1063,8 +1494,41 @@ func FilSealPreCommitPhase2(sealPreCommitPhase1OutputPtr []byte, sealPreCommitPh
 	return __v
 }
 
-// FilUnsealRange function as declared in filecoin-ffi/filcrypto.h:990
-func FilUnsealRange(registeredProof FilRegisteredSealProof, cacheDirPath string, sealedSectorFdRaw int32, unsealOutputFdRaw int32, sectorId uint64, proverId Fil32ByteArray, ticket Fil32ByteArray, commD Fil32ByteArray, unpaddedByteIndex uint64, unpaddedBytesAmount uint64) *FilUnsealRangeResponse {
+// FilSignatureFromUncompressed function as declared in filecoin-ffi/filcrypto.h:1518
+func FilSignatureFromUncompressed(uncompressedSignaturePtr []byte) *FilSignatureFromUncompressedResponse {
+	cuncompressedSignaturePtr, cuncompressedSignaturePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&uncompressedSignaturePtr)))
+	__ret := C.fil_signature_from_uncompressed(cuncompressedSignaturePtr)
+	runtime.KeepAlive(cuncompressedSignaturePtrAllocMap)
+	__v := NewFilSignatureFromUncompressedResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilSignatureLinearCombination function as declared in filecoin-ffi/filcrypto.h:1534
+func FilSignatureLinearCombination(flattenedSignaturesPtr []byte, flattenedSignaturesLen uint, flattenedScalarsPtr []byte, flattenedScalarsLen uint) *FilAggregateResponse {
+	cflattenedSignaturesPtr, cflattenedSignaturesPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedSignaturesPtr)))
+	cflattenedSignaturesLen, cflattenedSignaturesLenAllocMap := (C.size_t)(flattenedSignaturesLen), cgoAllocsUnknown
+	cflattenedScalarsPtr, cflattenedScalarsPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedScalarsPtr)))
+	cflattenedScalarsLen, cflattenedScalarsLenAllocMap := (C.size_t)(flattenedScalarsLen), cgoAllocsUnknown
+	__ret := C.fil_signature_linear_combination(cflattenedSignaturesPtr, cflattenedSignaturesLen, cflattenedScalarsPtr, cflattenedScalarsLen)
+	runtime.KeepAlive(cflattenedScalarsLenAllocMap)
+	runtime.KeepAlive(cflattenedScalarsPtrAllocMap)
+	runtime.KeepAlive(cflattenedSignaturesLenAllocMap)
+	runtime.KeepAlive(cflattenedSignaturesPtrAllocMap)
+	__v := NewFilAggregateResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilSignatureToUncompressed function as declared in filecoin-ffi/filcrypto.h:1548
+func FilSignatureToUncompressed(signaturePtr []byte) *FilSignatureToUncompressedResponse {
+	csignaturePtr, csignaturePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&signaturePtr)))
+	__ret := C.fil_signature_to_uncompressed(csignaturePtr)
+	runtime.KeepAlive(csignaturePtrAllocMap)
+	__v := NewFilSignatureToUncompressedResponseRef(unsafe.Pointer(__ret))
+	return __v
+}
+
+// FilUnsealRange function as declared in filecoin-ffi/filcrypto.h:1555
+func FilUnsealRange(registeredProof FilRegisteredSealProof, cacheDirPath string, sealedSectorFdRaw int32, unsealOutputFdRaw int32, sectorId uint64, proverId Fil32ByteArray, ticket Fil32ByteArray, commD Fil32ByteArray, unpaddedByteIndex uint64, unpaddedBytesAmount uint64, numThreads uint) *FilUnsealRangeResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	cacheDirPath = safeString(cacheDirPath)
 	ccacheDirPath, ccacheDirPathAllocMap := unpackPCharString(cacheDirPath)
@@ -1076,7 +1540,9 @@ func FilUnsealRange(registeredProof FilRegisteredSealProof, cacheDirPath string,
 	ccommD, ccommDAllocMap := commD.PassValue()
 	cunpaddedByteIndex, cunpaddedByteIndexAllocMap := (C.uint64_t)(unpaddedByteIndex), cgoAllocsUnknown
 	cunpaddedBytesAmount, cunpaddedBytesAmountAllocMap := (C.uint64_t)(unpaddedBytesAmount), cgoAllocsUnknown
-	__ret := C.fil_unseal_range(cregisteredProof, ccacheDirPath, csealedSectorFdRaw, cunsealOutputFdRaw, csectorId, cproverId, cticket, ccommD, cunpaddedByteIndex, cunpaddedBytesAmount)
+	cnumThreads, cnumThreadsAllocMap := (C.size_t)(numThreads), cgoAllocsUnknown
+	__ret := C.fil_unseal_range(cregisteredProof, ccacheDirPath, csealedSectorFdRaw, cunsealOutputFdRaw, csectorId, cproverId, cticket, ccommD, cunpaddedByteIndex, cunpaddedBytesAmount, cnumThreads)
+	runtime.KeepAlive(cnumThreadsAllocMap)
 	runtime.KeepAlive(cunpaddedBytesAmountAllocMap)
 	runtime.KeepAlive(cunpaddedByteIndexAllocMap)
 	runtime.KeepAlive(ccommDAllocMap)
@@ -1092,7 +1558,25 @@ func FilUnsealRange(registeredProof FilRegisteredSealProof, cacheDirPath string,
 	return __v
 }
 
-// FilVerify function as declared in filecoin-ffi/filcrypto.h:1012
+// FilValidatePublicKey function as declared in filecoin-ffi/filcrypto.h:1576
+func FilValidatePublicKey(publicKeyPtr []byte) FilBLSPointValidation {
+	cpublicKeyPtr, cpublicKeyPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&publicKeyPtr)))
+	__ret := C.fil_validate_public_key(cpublicKeyPtr)
+	runtime.KeepAlive(cpublicKeyPtrAllocMap)
+	__v := (FilBLSPointValidation)(__ret)
+	return __v
+}
+
+// FilValidateSignature function as declared in filecoin-ffi/filcrypto.h:1587
+func FilValidateSignature(signaturePtr []byte) FilBLSPointValidation {
+	csignaturePtr, csignaturePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&signaturePtr)))
+	__ret := C.fil_validate_signature(csignaturePtr)
+	runtime.KeepAlive(csignaturePtrAllocMap)
+	__v := (FilBLSPointValidation)(__ret)
+	return __v
+}
+
+// FilVerify function as declared in filecoin-ffi/filcrypto.h:1600
 func FilVerify(signaturePtr []byte, flattenedDigestsPtr []byte, flattenedDigestsLen uint, flattenedPublicKeysPtr []byte, flattenedPublicKeysLen uint) int32 {
 	csignaturePtr, csignaturePtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&signaturePtr)))
 	cflattenedDigestsPtr, cflattenedDigestsPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedDigestsPtr)))
@@ -1109,7 +1593,7 @@ func FilVerify(signaturePtr []byte, flattenedDigestsPtr []byte, flattenedDigests
 	return __v
 }
 
-// FilVerifyAggregateSealProof function as declared in filecoin-ffi/filcrypto.h:1022
+// FilVerifyAggregateSealProof function as declared in filecoin-ffi/filcrypto.h:1610
 func FilVerifyAggregateSealProof(registeredProof FilRegisteredSealProof, registeredAggregation FilRegisteredAggregationProof, proverId Fil32ByteArray, proofPtr []byte, proofLen uint, commitInputsPtr []FilAggregationInputs, commitInputsLen uint) *FilVerifyAggregateSealProofResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	cregisteredAggregation, cregisteredAggregationAllocMap := (C.fil_RegisteredAggregationProof)(registeredAggregation), cgoAllocsUnknown
@@ -1131,7 +1615,26 @@ func FilVerifyAggregateSealProof(registeredProof FilRegisteredSealProof, registe
 	return __v
 }
 
-// FilVerifyEmptySectorUpdatePartitionProofs function as declared in filecoin-ffi/filcrypto.h:1034
+// FilVerifyBatch function as declared in filecoin-ffi/filcrypto.h:1637
+func FilVerifyBatch(flattenedDigestsPtr []byte, flattenedDigestsLen uint, flattenedPublicKeysPtr []byte, flattenedPublicKeysLen uint, flattenedSignaturesPtr []byte, flattenedSignaturesLen uint) int32 {
+	cflattenedDigestsPtr, cflattenedDigestsPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedDigestsPtr)))
+	cflattenedDigestsLen, cflattenedDigestsLenAllocMap := (C.size_t)(flattenedDigestsLen), cgoAllocsUnknown
+	cflattenedPublicKeysPtr, cflattenedPublicKeysPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedPublicKeysPtr)))
+	cflattenedPublicKeysLen, cflattenedPublicKeysLenAllocMap := (C.size_t)(flattenedPublicKeysLen), cgoAllocsUnknown
+	cflattenedSignaturesPtr, cflattenedSignaturesPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&flattenedSignaturesPtr)))
+	cflattenedSignaturesLen, cflattenedSignaturesLenAllocMap := (C.size_t)(flattenedSignaturesLen), cgoAllocsUnknown
+	__ret := C.fil_verify_batch(cflattenedDigestsPtr, cflattenedDigestsLen, cflattenedPublicKeysPtr, cflattenedPublicKeysLen, cflattenedSignaturesPtr, cflattenedSignaturesLen)
+	runtime.KeepAlive(cflattenedSignaturesLenAllocMap)
+	runtime.KeepAlive(cflattenedSignaturesPtrAllocMap)
+	runtime.KeepAlive(cflattenedPublicKeysLenAllocMap)
+	runtime.KeepAlive(cflattenedPublicKeysPtrAllocMap)
+	runtime.KeepAlive(cflattenedDigestsLenAllocMap)
+	runtime.KeepAlive(cflattenedDigestsPtrAllocMap)
+	__v := (int32)(__ret)
+	return __v
+}
+
+// FilVerifyEmptySectorUpdatePartitionProofs function as declared in filecoin-ffi/filcrypto.h:1648
 func FilVerifyEmptySectorUpdatePartitionProofs(registeredProof FilRegisteredUpdateProof, proofsLen uint, proofsPtr []FilPartitionProof, commROld Fil32ByteArray, commRNew Fil32ByteArray, commDNew Fil32ByteArray) *FilVerifyPartitionProofResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredUpdateProof)(registeredProof), cgoAllocsUnknown
 	cproofsLen, cproofsLenAllocMap := (C.size_t)(proofsLen), cgoAllocsUnknown
@@ -1151,7 +1654,7 @@ func FilVerifyEmptySectorUpdatePartitionProofs(registeredProof FilRegisteredUpda
 	return __v
 }
 
-// FilVerifyEmptySectorUpdateProof function as declared in filecoin-ffi/filcrypto.h:1045
+// FilVerifyEmptySectorUpdateProof function as declared in filecoin-ffi/filcrypto.h:1659
 func FilVerifyEmptySectorUpdateProof(registeredProof FilRegisteredUpdateProof, proofPtr []byte, proofLen uint, commROld Fil32ByteArray, commRNew Fil32ByteArray, commDNew Fil32ByteArray) *FilVerifyEmptySectorUpdateProofResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredUpdateProof)(registeredProof), cgoAllocsUnknown
 	cproofPtr, cproofPtrAllocMap := copyPUint8TBytes((*sliceHeader)(unsafe.Pointer(&proofPtr)))
@@ -1170,7 +1673,7 @@ func FilVerifyEmptySectorUpdateProof(registeredProof FilRegisteredUpdateProof, p
 	return __v
 }
 
-// FilVerifySeal function as declared in filecoin-ffi/filcrypto.h:1056
+// FilVerifySeal function as declared in filecoin-ffi/filcrypto.h:1670
 func FilVerifySeal(registeredProof FilRegisteredSealProof, commR Fil32ByteArray, commD Fil32ByteArray, proverId Fil32ByteArray, ticket Fil32ByteArray, seed Fil32ByteArray, sectorId uint64, proofPtr []byte, proofLen uint) *FilVerifySealResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	ccommR, ccommRAllocMap := commR.PassValue()
@@ -1195,7 +1698,7 @@ func FilVerifySeal(registeredProof FilRegisteredSealProof, commR Fil32ByteArray,
 	return __v
 }
 
-// FilVerifyWindowPost function as declared in filecoin-ffi/filcrypto.h:1069
+// FilVerifyWindowPost function as declared in filecoin-ffi/filcrypto.h:1683
 func FilVerifyWindowPost(randomness Fil32ByteArray, replicasPtr []FilPublicReplicaInfo, replicasLen uint, proofsPtr []FilPoStProof, proofsLen uint, proverId Fil32ByteArray) *FilVerifyWindowPoStResponse {
 	crandomness, crandomnessAllocMap := randomness.PassValue()
 	creplicasPtr, creplicasPtrAllocMap := unpackArgSFilPublicReplicaInfo(replicasPtr)
@@ -1216,7 +1719,7 @@ func FilVerifyWindowPost(randomness Fil32ByteArray, replicasPtr []FilPublicRepli
 	return __v
 }
 
-// FilVerifyWinningPost function as declared in filecoin-ffi/filcrypto.h:1079
+// FilVerifyWinningPost function as declared in filecoin-ffi/filcrypto.h:1693
 func FilVerifyWinningPost(randomness Fil32ByteArray, replicasPtr []FilPublicReplicaInfo, replicasLen uint, proofsPtr []FilPoStProof, proofsLen uint, proverId Fil32ByteArray) *FilVerifyWinningPoStResponse {
 	crandomness, crandomnessAllocMap := randomness.PassValue()
 	creplicasPtr, creplicasPtrAllocMap := unpackArgSFilPublicReplicaInfo(replicasPtr)
@@ -1237,7 +1740,7 @@ func FilVerifyWinningPost(randomness Fil32ByteArray, replicasPtr []FilPublicRepl
 	return __v
 }
 
-// FilWriteWithAlignment function as declared in filecoin-ffi/filcrypto.h:1090
+// FilWriteWithAlignment function as declared in filecoin-ffi/filcrypto.h:1704
 func FilWriteWithAlignment(registeredProof FilRegisteredSealProof, srcFd int32, srcSize uint64, dstFd int32, existingPieceSizesPtr []uint64, existingPieceSizesLen uint) *FilWriteWithAlignmentResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	csrcFd, csrcFdAllocMap := (C.int)(srcFd), cgoAllocsUnknown
@@ -1256,7 +1759,7 @@ func FilWriteWithAlignment(registeredProof FilRegisteredSealProof, srcFd int32,
 	return __v
 }
 
-// FilWriteWithoutAlignment function as declared in filecoin-ffi/filcrypto.h:1101
+// FilWriteWithoutAlignment function as declared in filecoin-ffi/filcrypto.h:1715
 func FilWriteWithoutAlignment(registeredProof FilRegisteredSealProof, srcFd int32, srcSize uint64, dstFd int32) *FilWriteWithoutAlignmentResponse {
 	cregisteredProof, cregisteredProofAllocMap := (C.fil_RegisteredSealProof)(registeredProof), cgoAllocsUnknown
 	csrcFd, csrcFdAllocMap := (C.int)(srcFd), cgoAllocsUnknown
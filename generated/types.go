@@ -12,21 +12,49 @@ package generated
 */
 import "C"
 
-// FilBLSSignature as declared in filecoin-ffi/filcrypto.h:73
+// FilBLSSignature as declared in filecoin-ffi/filcrypto.h:95
 type FilBLSSignature struct {
 	Inner          [96]byte
 	refa2ac09ba    *C.fil_BLSSignature
 	allocsa2ac09ba interface{}
 }
 
-// FilAggregateResponse as declared in filecoin-ffi/filcrypto.h:80
+// FilAggregateResponse as declared in filecoin-ffi/filcrypto.h:102
 type FilAggregateResponse struct {
 	Signature      FilBLSSignature
 	refb3efa36d    *C.fil_AggregateResponse
 	allocsb3efa36d interface{}
 }
 
-// FilAggregateProof as declared in filecoin-ffi/filcrypto.h:87
+// FilBLSSignatureG1 as declared in filecoin-ffi/filcrypto.h:106
+type FilBLSSignatureG1 struct {
+	Inner          [48]byte
+	refc8c9973d    *C.fil_BLSSignatureG1
+	allocsc8c9973d interface{}
+}
+
+// FilSignatureG1Response as declared in filecoin-ffi/filcrypto.h:113
+type FilSignatureG1Response struct {
+	Signature      FilBLSSignatureG1
+	ref803049a4    *C.fil_SignatureG1Response
+	allocs803049a4 interface{}
+}
+
+// FilBLSPublicKey as declared in filecoin-ffi/filcrypto.h:117
+type FilBLSPublicKey struct {
+	Inner          [48]byte
+	ref6d0cab13    *C.fil_BLSPublicKey
+	allocs6d0cab13 interface{}
+}
+
+// FilAggregatePublicKeysResponse as declared in filecoin-ffi/filcrypto.h:124
+type FilAggregatePublicKeysResponse struct {
+	PublicKey      FilBLSPublicKey
+	refc61cdc81    *C.fil_AggregatePublicKeysResponse
+	allocsc61cdc81 interface{}
+}
+
+// FilAggregateProof as declared in filecoin-ffi/filcrypto.h:131
 type FilAggregateProof struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -36,14 +64,14 @@ type FilAggregateProof struct {
 	allocs22b6c4f6 interface{}
 }
 
-// Fil32ByteArray as declared in filecoin-ffi/filcrypto.h:91
+// Fil32ByteArray as declared in filecoin-ffi/filcrypto.h:135
 type Fil32ByteArray struct {
 	Inner          [32]byte
 	ref373ec61a    *C.fil_32ByteArray
 	allocs373ec61a interface{}
 }
 
-// FilAggregationInputs as declared in filecoin-ffi/filcrypto.h:99
+// FilAggregationInputs as declared in filecoin-ffi/filcrypto.h:143
 type FilAggregationInputs struct {
 	CommR          Fil32ByteArray
 	CommD          Fil32ByteArray
@@ -54,7 +82,7 @@ type FilAggregationInputs struct {
 	allocs90b967c9 interface{}
 }
 
-// FilSealCommitPhase2Response as declared in filecoin-ffi/filcrypto.h:108
+// FilSealCommitPhase2Response as declared in filecoin-ffi/filcrypto.h:152
 type FilSealCommitPhase2Response struct {
 	StatusCode      FCPResponseStatus
 	ErrorMsg        string
@@ -66,7 +94,35 @@ type FilSealCommitPhase2Response struct {
 	allocs5860b9a4  interface{}
 }
 
-// FilClearCacheResponse as declared in filecoin-ffi/filcrypto.h:113
+// FilBLSPrivateKey as declared in filecoin-ffi/filcrypto.h:156
+type FilBLSPrivateKey struct {
+	Inner          [32]byte
+	ref2f77fe3a    *C.fil_BLSPrivateKey
+	allocs2f77fe3a interface{}
+}
+
+// FilBLSSigner as declared in filecoin-ffi/filcrypto.h:163
+type FilBLSSigner struct {
+	PrivateKey     FilBLSPrivateKey
+	refc6220aac    *C.fil_BLSSigner
+	allocsc6220aac interface{}
+}
+
+// FilPrivateKeyPublicKeyResponse as declared in filecoin-ffi/filcrypto.h:170
+type FilPrivateKeyPublicKeyResponse struct {
+	PublicKey      FilBLSPublicKey
+	refee14e59d    *C.fil_PrivateKeyPublicKeyResponse
+	allocsee14e59d interface{}
+}
+
+// FilPrivateKeySignResponse as declared in filecoin-ffi/filcrypto.h:177
+type FilPrivateKeySignResponse struct {
+	Signature      FilBLSSignature
+	refcdf97b28    *C.fil_PrivateKeySignResponse
+	allocscdf97b28 interface{}
+}
+
+// FilClearCacheResponse as declared in filecoin-ffi/filcrypto.h:182
 type FilClearCacheResponse struct {
 	ErrorMsg       string
 	StatusCode     FCPResponseStatus
@@ -74,14 +130,23 @@ type FilClearCacheResponse struct {
 	allocsa9a80400 interface{}
 }
 
-// FilZeroSignatureResponse as declared in filecoin-ffi/filcrypto.h:120
+// FilComputeCommRResponse as declared in filecoin-ffi/filcrypto.h:188
+type FilComputeCommRResponse struct {
+	ErrorMsg       string
+	StatusCode     FCPResponseStatus
+	CommR          [32]byte
+	reffb946967    *C.fil_ComputeCommRResponse
+	allocsfb946967 interface{}
+}
+
+// FilZeroSignatureResponse as declared in filecoin-ffi/filcrypto.h:195
 type FilZeroSignatureResponse struct {
 	Signature      FilBLSSignature
 	ref835a0405    *C.fil_ZeroSignatureResponse
 	allocs835a0405 interface{}
 }
 
-// FilEmptySectorUpdateDecodeFromResponse as declared in filecoin-ffi/filcrypto.h:125
+// FilEmptySectorUpdateDecodeFromResponse as declared in filecoin-ffi/filcrypto.h:200
 type FilEmptySectorUpdateDecodeFromResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -89,7 +154,7 @@ type FilEmptySectorUpdateDecodeFromResponse struct {
 	allocsf02a01b8 interface{}
 }
 
-// FilEmptySectorUpdateEncodeIntoResponse as declared in filecoin-ffi/filcrypto.h:133
+// FilEmptySectorUpdateEncodeIntoResponse as declared in filecoin-ffi/filcrypto.h:208
 type FilEmptySectorUpdateEncodeIntoResponse struct {
 	ErrorMsg       string
 	StatusCode     FCPResponseStatus
@@ -100,7 +165,7 @@ type FilEmptySectorUpdateEncodeIntoResponse struct {
 	allocs8d3238a7 interface{}
 }
 
-// FilEmptySectorUpdateProofResponse as declared in filecoin-ffi/filcrypto.h:140
+// FilEmptySectorUpdateProofResponse as declared in filecoin-ffi/filcrypto.h:215
 type FilEmptySectorUpdateProofResponse struct {
 	StatusCode    FCPResponseStatus
 	ErrorMsg      string
@@ -110,7 +175,7 @@ type FilEmptySectorUpdateProofResponse struct {
 	allocs5c2faef interface{}
 }
 
-// FilEmptySectorUpdateRemoveEncodedDataResponse as declared in filecoin-ffi/filcrypto.h:145
+// FilEmptySectorUpdateRemoveEncodedDataResponse as declared in filecoin-ffi/filcrypto.h:220
 type FilEmptySectorUpdateRemoveEncodedDataResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -118,7 +183,7 @@ type FilEmptySectorUpdateRemoveEncodedDataResponse struct {
 	allocs50783b83 interface{}
 }
 
-// FilVerifyEmptySectorUpdateProofResponse as declared in filecoin-ffi/filcrypto.h:151
+// FilVerifyEmptySectorUpdateProofResponse as declared in filecoin-ffi/filcrypto.h:226
 type FilVerifyEmptySectorUpdateProofResponse struct {
 	StatusCode    FCPResponseStatus
 	ErrorMsg      string
@@ -127,7 +192,7 @@ type FilVerifyEmptySectorUpdateProofResponse struct {
 	allocs50b7b13 interface{}
 }
 
-// FilFauxRepResponse as declared in filecoin-ffi/filcrypto.h:157
+// FilFauxRepResponse as declared in filecoin-ffi/filcrypto.h:232
 type FilFauxRepResponse struct {
 	ErrorMsg       string
 	StatusCode     FCPResponseStatus
@@ -136,7 +201,7 @@ type FilFauxRepResponse struct {
 	allocsaa003f71 interface{}
 }
 
-// FilFinalizeTicketResponse as declared in filecoin-ffi/filcrypto.h:163
+// FilFinalizeTicketResponse as declared in filecoin-ffi/filcrypto.h:238
 type FilFinalizeTicketResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -145,7 +210,7 @@ type FilFinalizeTicketResponse struct {
 	allocsb370fa86 interface{}
 }
 
-// FilGenerateDataCommitmentResponse as declared in filecoin-ffi/filcrypto.h:169
+// FilGenerateDataCommitmentResponse as declared in filecoin-ffi/filcrypto.h:244
 type FilGenerateDataCommitmentResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -154,7 +219,7 @@ type FilGenerateDataCommitmentResponse struct {
 	allocs87da7dd9 interface{}
 }
 
-// FilPartitionProof as declared in filecoin-ffi/filcrypto.h:174
+// FilPartitionProof as declared in filecoin-ffi/filcrypto.h:249
 type FilPartitionProof struct {
 	ProofLen       uint
 	ProofPtr       []byte
@@ -162,7 +227,7 @@ type FilPartitionProof struct {
 	allocs566a2be6 interface{}
 }
 
-// FilPartitionProofResponse as declared in filecoin-ffi/filcrypto.h:181
+// FilPartitionProofResponse as declared in filecoin-ffi/filcrypto.h:256
 type FilPartitionProofResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -172,7 +237,7 @@ type FilPartitionProofResponse struct {
 	allocs51343e7a interface{}
 }
 
-// FilGenerateFallbackSectorChallengesResponse as declared in filecoin-ffi/filcrypto.h:191
+// FilGenerateFallbackSectorChallengesResponse as declared in filecoin-ffi/filcrypto.h:266
 type FilGenerateFallbackSectorChallengesResponse struct {
 	ErrorMsg         string
 	StatusCode       FCPResponseStatus
@@ -185,7 +250,7 @@ type FilGenerateFallbackSectorChallengesResponse struct {
 	allocs7047a3fa   interface{}
 }
 
-// FilGeneratePieceCommitmentResponse as declared in filecoin-ffi/filcrypto.h:202
+// FilGeneratePieceCommitmentResponse as declared in filecoin-ffi/filcrypto.h:277
 type FilGeneratePieceCommitmentResponse struct {
 	StatusCode      FCPResponseStatus
 	ErrorMsg        string
@@ -195,7 +260,15 @@ type FilGeneratePieceCommitmentResponse struct {
 	allocs4b00fda4  interface{}
 }
 
-// FilVanillaProof as declared in filecoin-ffi/filcrypto.h:207
+// FilGenerateSDRResponse as declared in filecoin-ffi/filcrypto.h:282
+type FilGenerateSDRResponse struct {
+	ErrorMsg      string
+	StatusCode    FCPResponseStatus
+	ref19054da    *C.fil_GenerateSDRResponse
+	allocs19054da interface{}
+}
+
+// FilVanillaProof as declared in filecoin-ffi/filcrypto.h:287
 type FilVanillaProof struct {
 	ProofLen       uint
 	ProofPtr       []byte
@@ -203,7 +276,7 @@ type FilVanillaProof struct {
 	allocsb3e7638c interface{}
 }
 
-// FilGenerateSingleVanillaProofResponse as declared in filecoin-ffi/filcrypto.h:213
+// FilGenerateSingleVanillaProofResponse as declared in filecoin-ffi/filcrypto.h:293
 type FilGenerateSingleVanillaProofResponse struct {
 	ErrorMsg       string
 	VanillaProof   FilVanillaProof
@@ -212,7 +285,7 @@ type FilGenerateSingleVanillaProofResponse struct {
 	allocsf9d21b04 interface{}
 }
 
-// FilPartitionSnarkProof as declared in filecoin-ffi/filcrypto.h:219
+// FilPartitionSnarkProof as declared in filecoin-ffi/filcrypto.h:299
 type FilPartitionSnarkProof struct {
 	RegisteredProof FilRegisteredPoStProof
 	ProofLen        uint
@@ -221,7 +294,7 @@ type FilPartitionSnarkProof struct {
 	allocs4de03739  interface{}
 }
 
-// FilGenerateSingleWindowPoStWithVanillaResponse as declared in filecoin-ffi/filcrypto.h:227
+// FilGenerateSingleWindowPoStWithVanillaResponse as declared in filecoin-ffi/filcrypto.h:307
 type FilGenerateSingleWindowPoStWithVanillaResponse struct {
 	ErrorMsg         string
 	PartitionProof   FilPartitionSnarkProof
@@ -232,7 +305,7 @@ type FilGenerateSingleWindowPoStWithVanillaResponse struct {
 	allocs96c012c3   interface{}
 }
 
-// FilPoStProof as declared in filecoin-ffi/filcrypto.h:233
+// FilPoStProof as declared in filecoin-ffi/filcrypto.h:313
 type FilPoStProof struct {
 	RegisteredProof FilRegisteredPoStProof
 	ProofLen        uint
@@ -241,7 +314,7 @@ type FilPoStProof struct {
 	allocs3451bfa   interface{}
 }
 
-// FilGenerateWindowPoStResponse as declared in filecoin-ffi/filcrypto.h:242
+// FilGenerateWindowPoStResponse as declared in filecoin-ffi/filcrypto.h:322
 type FilGenerateWindowPoStResponse struct {
 	ErrorMsg         string
 	ProofsLen        uint
@@ -253,7 +326,7 @@ type FilGenerateWindowPoStResponse struct {
 	allocs2a5f3ba8   interface{}
 }
 
-// FilGenerateWinningPoStResponse as declared in filecoin-ffi/filcrypto.h:249
+// FilGenerateWinningPoStResponse as declared in filecoin-ffi/filcrypto.h:329
 type FilGenerateWinningPoStResponse struct {
 	ErrorMsg       string
 	ProofsLen      uint
@@ -263,7 +336,7 @@ type FilGenerateWinningPoStResponse struct {
 	allocs1405b8ec interface{}
 }
 
-// FilGenerateWinningPoStSectorChallenge as declared in filecoin-ffi/filcrypto.h:256
+// FilGenerateWinningPoStSectorChallenge as declared in filecoin-ffi/filcrypto.h:336
 type FilGenerateWinningPoStSectorChallenge struct {
 	ErrorMsg       string
 	StatusCode     FCPResponseStatus
@@ -273,7 +346,7 @@ type FilGenerateWinningPoStSectorChallenge struct {
 	allocs69d2a405 interface{}
 }
 
-// FilGetNumPartitionForFallbackPoStResponse as declared in filecoin-ffi/filcrypto.h:262
+// FilGetNumPartitionForFallbackPoStResponse as declared in filecoin-ffi/filcrypto.h:342
 type FilGetNumPartitionForFallbackPoStResponse struct {
 	ErrorMsg       string
 	StatusCode     FCPResponseStatus
@@ -282,7 +355,7 @@ type FilGetNumPartitionForFallbackPoStResponse struct {
 	allocsc0084478 interface{}
 }
 
-// FilGpuDeviceResponse as declared in filecoin-ffi/filcrypto.h:269
+// FilGpuDeviceResponse as declared in filecoin-ffi/filcrypto.h:349
 type FilGpuDeviceResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -292,21 +365,29 @@ type FilGpuDeviceResponse struct {
 	allocs58f92915 interface{}
 }
 
-// FilBLSDigest as declared in filecoin-ffi/filcrypto.h:273
+// FilBLSDigest as declared in filecoin-ffi/filcrypto.h:353
 type FilBLSDigest struct {
 	Inner          [96]byte
 	ref215fc78c    *C.fil_BLSDigest
 	allocs215fc78c interface{}
 }
 
-// FilHashResponse as declared in filecoin-ffi/filcrypto.h:280
+// FilHashResponse as declared in filecoin-ffi/filcrypto.h:360
 type FilHashResponse struct {
 	Digest         FilBLSDigest
 	refc52a22ef    *C.fil_HashResponse
 	allocsc52a22ef interface{}
 }
 
-// FilInitLogFdResponse as declared in filecoin-ffi/filcrypto.h:285
+// FilHashVerifyBatchResponse as declared in filecoin-ffi/filcrypto.h:368
+type FilHashVerifyBatchResponse struct {
+	ResultsLen     uint
+	ResultsPtr     []byte
+	ref79b20783    *C.fil_HashVerifyBatchResponse
+	allocs79b20783 interface{}
+}
+
+// FilInitLogFdResponse as declared in filecoin-ffi/filcrypto.h:373
 type FilInitLogFdResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -314,7 +395,7 @@ type FilInitLogFdResponse struct {
 	allocs3c1a0a08 interface{}
 }
 
-// FilMergeWindowPoStPartitionProofsResponse as declared in filecoin-ffi/filcrypto.h:291
+// FilMergeWindowPoStPartitionProofsResponse as declared in filecoin-ffi/filcrypto.h:379
 type FilMergeWindowPoStPartitionProofsResponse struct {
 	ErrorMsg       string
 	Proof          FilPoStProof
@@ -323,42 +404,66 @@ type FilMergeWindowPoStPartitionProofsResponse struct {
 	allocs3369154e interface{}
 }
 
-// FilBLSPrivateKey as declared in filecoin-ffi/filcrypto.h:295
-type FilBLSPrivateKey struct {
-	Inner          [32]byte
-	ref2f77fe3a    *C.fil_BLSPrivateKey
-	allocs2f77fe3a interface{}
+// FilPopProveResponse as declared in filecoin-ffi/filcrypto.h:386
+type FilPopProveResponse struct {
+	Signature      FilBLSSignature
+	ref1f41bfe9    *C.fil_PopProveResponse
+	allocs1f41bfe9 interface{}
 }
 
-// FilPrivateKeyGenerateResponse as declared in filecoin-ffi/filcrypto.h:302
+// FilPrivateKeyGenerateResponse as declared in filecoin-ffi/filcrypto.h:393
 type FilPrivateKeyGenerateResponse struct {
 	PrivateKey    FilBLSPrivateKey
 	ref2dba09f    *C.fil_PrivateKeyGenerateResponse
 	allocs2dba09f interface{}
 }
 
-// FilBLSPublicKey as declared in filecoin-ffi/filcrypto.h:306
-type FilBLSPublicKey struct {
-	Inner          [48]byte
-	ref6d0cab13    *C.fil_BLSPublicKey
-	allocs6d0cab13 interface{}
+// FilBLSPublicKeyG2 as declared in filecoin-ffi/filcrypto.h:397
+type FilBLSPublicKeyG2 struct {
+	Inner          [96]byte
+	ref16ddcc43    *C.fil_BLSPublicKeyG2
+	allocs16ddcc43 interface{}
 }
 
-// FilPrivateKeyPublicKeyResponse as declared in filecoin-ffi/filcrypto.h:313
-type FilPrivateKeyPublicKeyResponse struct {
+// FilPrivateKeyPublicKeyG2Response as declared in filecoin-ffi/filcrypto.h:404
+type FilPrivateKeyPublicKeyG2Response struct {
+	PublicKey     FilBLSPublicKeyG2
+	ref64cfade    *C.fil_PrivateKeyPublicKeyG2Response
+	allocs64cfade interface{}
+}
+
+// FilPrivateKeysPublicKeysResponse as declared in filecoin-ffi/filcrypto.h:414
+type FilPrivateKeysPublicKeysResponse struct {
+	PublicKeysLen  uint
+	PublicKeysPtr  []byte
+	HasInvalidKey  bool
+	InvalidIndex   uint
+	refcf91c784    *C.fil_PrivateKeysPublicKeysResponse
+	allocscf91c784 interface{}
+}
+
+// FilPublicKeyFromUncompressedResponse as declared in filecoin-ffi/filcrypto.h:421
+type FilPublicKeyFromUncompressedResponse struct {
 	PublicKey      FilBLSPublicKey
-	refee14e59d    *C.fil_PrivateKeyPublicKeyResponse
-	allocsee14e59d interface{}
+	ref1947e439    *C.fil_PublicKeyFromUncompressedResponse
+	allocs1947e439 interface{}
 }
 
-// FilPrivateKeySignResponse as declared in filecoin-ffi/filcrypto.h:320
-type FilPrivateKeySignResponse struct {
-	Signature      FilBLSSignature
-	refcdf97b28    *C.fil_PrivateKeySignResponse
-	allocscdf97b28 interface{}
+// FilBLSUncompressedPublicKey as declared in filecoin-ffi/filcrypto.h:425
+type FilBLSUncompressedPublicKey struct {
+	Inner          [96]byte
+	ref940a2dfa    *C.fil_BLSUncompressedPublicKey
+	allocs940a2dfa interface{}
 }
 
-// FilSealCommitPhase1Response as declared in filecoin-ffi/filcrypto.h:327
+// FilPublicKeyToUncompressedResponse as declared in filecoin-ffi/filcrypto.h:432
+type FilPublicKeyToUncompressedResponse struct {
+	PublicKey      FilBLSUncompressedPublicKey
+	ref35e9846c    *C.fil_PublicKeyToUncompressedResponse
+	allocs35e9846c interface{}
+}
+
+// FilSealCommitPhase1Response as declared in filecoin-ffi/filcrypto.h:439
 type FilSealCommitPhase1Response struct {
 	StatusCode                FCPResponseStatus
 	ErrorMsg                  string
@@ -368,7 +473,25 @@ type FilSealCommitPhase1Response struct {
 	allocs61ed8561            interface{}
 }
 
-// FilSealPreCommitPhase1Response as declared in filecoin-ffi/filcrypto.h:334
+// FilSealCommitPhase1ToFileResponse as declared in filecoin-ffi/filcrypto.h:444
+type FilSealCommitPhase1ToFileResponse struct {
+	StatusCode     FCPResponseStatus
+	ErrorMsg       string
+	refcddc8aad    *C.fil_SealCommitPhase1ToFileResponse
+	allocscddc8aad interface{}
+}
+
+// FilSealCommitPhase2BatchResponse as declared in filecoin-ffi/filcrypto.h:451
+type FilSealCommitPhase2BatchResponse struct {
+	StatusCode     FCPResponseStatus
+	ErrorMsg       string
+	ResultsPtr     []FilSealCommitPhase2Response
+	ResultsLen     uint
+	refcd7f5c31    *C.fil_SealCommitPhase2BatchResponse
+	allocscd7f5c31 interface{}
+}
+
+// FilSealPreCommitPhase1Response as declared in filecoin-ffi/filcrypto.h:458
 type FilSealPreCommitPhase1Response struct {
 	ErrorMsg                     string
 	StatusCode                   FCPResponseStatus
@@ -378,7 +501,7 @@ type FilSealPreCommitPhase1Response struct {
 	allocs132bbfd8               interface{}
 }
 
-// FilSealPreCommitPhase2Response as declared in filecoin-ffi/filcrypto.h:342
+// FilSealPreCommitPhase2Response as declared in filecoin-ffi/filcrypto.h:466
 type FilSealPreCommitPhase2Response struct {
 	ErrorMsg        string
 	StatusCode      FCPResponseStatus
@@ -389,7 +512,28 @@ type FilSealPreCommitPhase2Response struct {
 	allocs2aa6831d  interface{}
 }
 
-// FilStringResponse as declared in filecoin-ffi/filcrypto.h:351
+// FilSignatureFromUncompressedResponse as declared in filecoin-ffi/filcrypto.h:473
+type FilSignatureFromUncompressedResponse struct {
+	Signature      FilBLSSignature
+	ref50d2d7d1    *C.fil_SignatureFromUncompressedResponse
+	allocs50d2d7d1 interface{}
+}
+
+// FilBLSUncompressedSignature as declared in filecoin-ffi/filcrypto.h:477
+type FilBLSUncompressedSignature struct {
+	Inner          [192]byte
+	ref5baa8f53    *C.fil_BLSUncompressedSignature
+	allocs5baa8f53 interface{}
+}
+
+// FilSignatureToUncompressedResponse as declared in filecoin-ffi/filcrypto.h:484
+type FilSignatureToUncompressedResponse struct {
+	Signature      FilBLSUncompressedSignature
+	reff8c41e37    *C.fil_SignatureToUncompressedResponse
+	allocsf8c41e37 interface{}
+}
+
+// FilStringResponse as declared in filecoin-ffi/filcrypto.h:493
 type FilStringResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -398,7 +542,7 @@ type FilStringResponse struct {
 	allocs4f413043 interface{}
 }
 
-// FilUnsealRangeResponse as declared in filecoin-ffi/filcrypto.h:356
+// FilUnsealRangeResponse as declared in filecoin-ffi/filcrypto.h:498
 type FilUnsealRangeResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -406,7 +550,7 @@ type FilUnsealRangeResponse struct {
 	allocs61e219c9 interface{}
 }
 
-// FilVerifyAggregateSealProofResponse as declared in filecoin-ffi/filcrypto.h:362
+// FilVerifyAggregateSealProofResponse as declared in filecoin-ffi/filcrypto.h:504
 type FilVerifyAggregateSealProofResponse struct {
 	StatusCode    FCPResponseStatus
 	ErrorMsg      string
@@ -415,7 +559,7 @@ type FilVerifyAggregateSealProofResponse struct {
 	allocs66180e0 interface{}
 }
 
-// FilVerifyPartitionProofResponse as declared in filecoin-ffi/filcrypto.h:368
+// FilVerifyPartitionProofResponse as declared in filecoin-ffi/filcrypto.h:510
 type FilVerifyPartitionProofResponse struct {
 	StatusCode    FCPResponseStatus
 	ErrorMsg      string
@@ -424,7 +568,7 @@ type FilVerifyPartitionProofResponse struct {
 	allocsaed1b67 interface{}
 }
 
-// FilVerifySealResponse as declared in filecoin-ffi/filcrypto.h:374
+// FilVerifySealResponse as declared in filecoin-ffi/filcrypto.h:516
 type FilVerifySealResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -433,7 +577,7 @@ type FilVerifySealResponse struct {
 	allocsd4397079 interface{}
 }
 
-// FilVerifyWindowPoStResponse as declared in filecoin-ffi/filcrypto.h:380
+// FilVerifyWindowPoStResponse as declared in filecoin-ffi/filcrypto.h:522
 type FilVerifyWindowPoStResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -442,7 +586,7 @@ type FilVerifyWindowPoStResponse struct {
 	allocs34c4d49f interface{}
 }
 
-// FilVerifyWinningPoStResponse as declared in filecoin-ffi/filcrypto.h:386
+// FilVerifyWinningPoStResponse as declared in filecoin-ffi/filcrypto.h:528
 type FilVerifyWinningPoStResponse struct {
 	StatusCode     FCPResponseStatus
 	ErrorMsg       string
@@ -451,7 +595,7 @@ type FilVerifyWinningPoStResponse struct {
 	allocsaca6860c interface{}
 }
 
-// FilWriteWithAlignmentResponse as declared in filecoin-ffi/filcrypto.h:394
+// FilWriteWithAlignmentResponse as declared in filecoin-ffi/filcrypto.h:536
 type FilWriteWithAlignmentResponse struct {
 	CommP                 [32]byte
 	ErrorMsg              string
@@ -462,7 +606,7 @@ type FilWriteWithAlignmentResponse struct {
 	allocsa330e79         interface{}
 }
 
-// FilWriteWithoutAlignmentResponse as declared in filecoin-ffi/filcrypto.h:401
+// FilWriteWithoutAlignmentResponse as declared in filecoin-ffi/filcrypto.h:543
 type FilWriteWithoutAlignmentResponse struct {
 	CommP              [32]byte
 	ErrorMsg           string
@@ -472,7 +616,7 @@ type FilWriteWithoutAlignmentResponse struct {
 	allocsc8e1ed8      interface{}
 }
 
-// FilPublicPieceInfo as declared in filecoin-ffi/filcrypto.h:406
+// FilPublicPieceInfo as declared in filecoin-ffi/filcrypto.h:548
 type FilPublicPieceInfo struct {
 	NumBytes       uint64
 	CommP          [32]byte
@@ -480,7 +624,7 @@ type FilPublicPieceInfo struct {
 	allocsd00025ac interface{}
 }
 
-// FilPrivateReplicaInfo as declared in filecoin-ffi/filcrypto.h:414
+// FilPrivateReplicaInfo as declared in filecoin-ffi/filcrypto.h:556
 type FilPrivateReplicaInfo struct {
 	RegisteredProof FilRegisteredPoStProof
 	CacheDirPath    string
@@ -491,7 +635,17 @@ type FilPrivateReplicaInfo struct {
 	allocs81a31e9b  interface{}
 }
 
-// FilPublicReplicaInfo as declared in filecoin-ffi/filcrypto.h:420
+// FilSealCommitPhase2BatchInput as declared in filecoin-ffi/filcrypto.h:563
+type FilSealCommitPhase2BatchInput struct {
+	SealCommitPhase1OutputPtr []byte
+	SealCommitPhase1OutputLen uint
+	SectorId                  uint64
+	ProverId                  Fil32ByteArray
+	refcce47217               *C.fil_SealCommitPhase2BatchInput
+	allocscce47217            interface{}
+}
+
+// FilPublicReplicaInfo as declared in filecoin-ffi/filcrypto.h:569
 type FilPublicReplicaInfo struct {
 	RegisteredProof FilRegisteredPoStProof
 	CommR           [32]byte
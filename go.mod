@@ -12,7 +12,9 @@ require (
 	github.com/ipfs/go-cid v0.0.7
 	github.com/pkg/errors v0.9.1
 	github.com/stretchr/testify v1.7.0
+	github.com/whyrusleeping/cbor-gen v0.0.0-20210118024343-169e9d70c0c2
 	github.com/xlab/c-for-go v0.0.0-20201112171043-ea6dce5809cb
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
 	golang.org/x/tools v0.0.0-20201112185108-eeaa07dd7696 // indirect
 	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1
 	modernc.org/golex v1.0.1 // indirect
@@ -0,0 +1,161 @@
+package ffi
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math/big"
+
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/xerrors"
+)
+
+// Hierarchical deterministic key derivation as specified by EIP-2333
+// (https://eips.ethereum.org/EIPS/eip-2333).
+
+// MinMasterSeedBytes is the minimum length of the seed a master key is derived
+// from
+const MinMasterSeedBytes = 32
+
+// ErrSeedTooShort is returned by DeriveMasterKey when the seed is shorter than
+// MinMasterSeedBytes.
+var ErrSeedTooShort = xerrors.Errorf("seed must be at least %d bytes", MinMasterSeedBytes)
+
+const (
+	keygenSalt = "BLS-SIG-KEYGEN-SALT-"
+
+	// number of bytes of key material expanded before reducing modulo r
+	keygenOKMBytes = 48
+
+	// the lamport keys are made of 255 chunks of 32 bytes each
+	lamportChunks     = 255
+	lamportChunkBytes = sha256.Size
+)
+
+// curveOrder is the order r of the BLS12-381 prime order subgroup
+var curveOrder, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// DeriveMasterKey derives the master private key of a key tree from seed,
+// which must be at least MinMasterSeedBytes long.
+func DeriveMasterKey(seed []byte) (PrivateKey, error) {
+	if len(seed) < MinMasterSeedBytes {
+		return PrivateKey{}, xerrors.Errorf("failed to derive master key from %d byte seed: %w", len(seed), ErrSeedTooShort)
+	}
+
+	return hkdfModR(seed)
+}
+
+// DeriveChildKey derives the child with the given index of the parent private
+// key.
+func DeriveChildKey(parent PrivateKey, index uint32) (PrivateKey, error) {
+	// private keys are stored little endian, whereas EIP-2333 works on their
+	// big endian encoding
+	var parentBytes [PrivateKeyBytes]byte
+	for i := range parent {
+		parentBytes[PrivateKeyBytes-1-i] = parent[i]
+	}
+	defer zeroBytes(parentBytes[:])
+
+	if scalarFromPrivateKey(parent).Cmp(curveOrder) >= 0 {
+		return PrivateKey{}, xerrors.New("failed to derive child key: parent is not a valid private key")
+	}
+
+	compressedLamportPK, err := parentToCompressedLamportPK(parentBytes[:], index)
+	if err != nil {
+		return PrivateKey{}, xerrors.Errorf("failed to derive child key: %w", err)
+	}
+
+	return hkdfModR(compressedLamportPK)
+}
+
+func hkdfModR(ikm []byte) (PrivateKey, error) {
+	ikmWithSuffix := make([]byte, len(ikm)+1)
+	copy(ikmWithSuffix, ikm)
+	defer zeroBytes(ikmWithSuffix)
+
+	var keyInfo [2]byte
+	binary.BigEndian.PutUint16(keyInfo[:], keygenOKMBytes)
+
+	salt := []byte(keygenSalt)
+	okm := make([]byte, keygenOKMBytes)
+	defer zeroBytes(okm)
+
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		h := sha256.Sum256(salt)
+		salt = h[:]
+
+		prk := hkdf.Extract(sha256.New, ikmWithSuffix, salt)
+		if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, keyInfo[:]), okm); err != nil {
+			return PrivateKey{}, xerrors.Errorf("failed to expand key material: %w", err)
+		}
+		zeroBytes(prk)
+
+		sk.SetBytes(okm)
+		sk.Mod(sk, curveOrder)
+	}
+
+	return privateKeyFromScalar(sk), nil
+}
+
+func parentToCompressedLamportPK(parent []byte, index uint32) ([]byte, error) {
+	var salt [4]byte
+	binary.BigEndian.PutUint32(salt[:], index)
+
+	notParent := make([]byte, len(parent))
+	for i := range parent {
+		notParent[i] = ^parent[i]
+	}
+	defer zeroBytes(notParent)
+
+	lamportPK := sha256.New()
+	for _, ikm := range [][]byte{parent, notParent} {
+		lamportSK := make([]byte, lamportChunks*lamportChunkBytes)
+
+		prk := hkdf.Extract(sha256.New, ikm, salt[:])
+		_, err := io.ReadFull(hkdf.Expand(sha256.New, prk, nil), lamportSK)
+		zeroBytes(prk)
+		if err != nil {
+			zeroBytes(lamportSK)
+			return nil, xerrors.Errorf("failed to expand lamport key: %w", err)
+		}
+
+		for i := 0; i < lamportChunks; i++ {
+			chunk := sha256.Sum256(lamportSK[i*lamportChunkBytes : (i+1)*lamportChunkBytes])
+			lamportPK.Write(chunk[:])
+		}
+		zeroBytes(lamportSK)
+	}
+
+	return lamportPK.Sum(nil), nil
+}
+
+// scalarFromPrivateKey reads the little endian scalar of a private key.
+func scalarFromPrivateKey(p PrivateKey) *big.Int {
+	var be [PrivateKeyBytes]byte
+	for i := range p {
+		be[PrivateKeyBytes-1-i] = p[i]
+	}
+	defer zeroBytes(be[:])
+
+	return new(big.Int).SetBytes(be[:])
+}
+
+// privateKeyFromScalar encodes a scalar, which must be reduced modulo the curve
+// order, as a little endian private key.
+func privateKeyFromScalar(n *big.Int) PrivateKey {
+	be := n.Bytes()
+	defer zeroBytes(be)
+
+	var out PrivateKey
+	for i := range be {
+		out[i] = be[len(be)-1-i]
+	}
+	return out
+}
+
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
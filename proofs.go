@@ -7,8 +7,24 @@ package ffi
 // #include "./filcrypto.h"
 import "C"
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/ipfs/go-cid"
@@ -23,6 +39,104 @@ import (
 	"github.com/filecoin-project/filecoin-ffi/generated"
 )
 
+// Errors wrapped by the ProofVerificationError returned when a proof could not
+// be verified.
+var (
+	// ErrProofInvalid is wrapped when the inputs the proof is verified against
+	// are malformed, such as a sealed CID which does not hold a commitment. A
+	// proof which is checked and does not hold is not an error: the verify
+	// functions then return false.
+	ErrProofInvalid = errors.New("invalid proof")
+	// ErrVerifierFailure is wrapped when the FFI fails to check the proof for
+	// another reason than a panic, such as missing or unreadable parameters.
+	ErrVerifierFailure = errors.New("verifier failure")
+	// ErrBadSectorCount is wrapped when the number of sectors the proof is
+	// verified against is not supported.
+	ErrBadSectorCount = errors.New("bad sector count")
+	// ErrUnsupportedProofType is wrapped when the proof type is not supported.
+	ErrUnsupportedProofType = errors.New("unsupported proof type")
+	// ErrFFIPanic is wrapped when the FFI panicked during verification.
+	ErrFFIPanic = errors.New("panic in FFI")
+	// ErrAggregateSize is wrapped when the number of proofs in an aggregate
+	// is not between MinAggregatedProofs and MaxAggregatedProofs. It wraps
+	// ErrBadSectorCount.
+	ErrAggregateSize = xerrors.Errorf("%w: number of aggregated proofs must be between %d and %d", ErrBadSectorCount, MinAggregatedProofs, MaxAggregatedProofs)
+)
+
+// The bounds on the number of seal proofs in an aggregate, as enforced by the
+// miner actor (specs-actors v5 miner.MinAggregatedSectors and
+// miner.MaxAggregatedSectors).
+const (
+	MinAggregatedProofs = 4
+	MaxAggregatedProofs = 819
+)
+
+// rustPanicPrefix prefixes the error messages of FFI calls which panicked
+const rustPanicPrefix = "Rust panic"
+
+// ProofVerificationError is returned when a proof could not be verified. Kind
+// is one of ErrProofInvalid, ErrVerifierFailure, ErrBadSectorCount,
+// ErrAggregateSize, ErrUnsupportedProofType or ErrFFIPanic, which the error
+// unwraps to, and Msg is the underlying error message, such as the one
+// reported by the FFI.
+type ProofVerificationError struct {
+	Kind error
+	Msg  string
+}
+
+func (e *ProofVerificationError) Error() string {
+	if e.Msg == "" {
+		return e.Kind.Error()
+	}
+
+	return e.Kind.Error() + ": " + e.Msg
+}
+
+func (e *ProofVerificationError) Unwrap() error {
+	return e.Kind
+}
+
+// newFFIVerificationError classifies an error message reported by the FFI
+// while verifying a proof.
+func newFFIVerificationError(msg string) error {
+	if strings.HasPrefix(msg, rustPanicPrefix) {
+		return &ProofVerificationError{Kind: ErrFFIPanic, Msg: msg}
+	}
+
+	return &ProofVerificationError{Kind: ErrVerifierFailure, Msg: msg}
+}
+
+// ValidateSealedCID checks that sealedCID is a plausible sealed sector CID for
+// a sector sealed with proofType: a version 1 CID with the sealed commitment
+// codec, hashed with the Poseidon BLS12-381 multihash and holding a 32 byte
+// CommR. Only the encoding is checked, not that a sector with this CommR
+// exists. An error wrapping ErrUnsupportedProofType is returned if proofType is
+// not supported.
+func ValidateSealedCID(sealedCID cid.Cid, proofType abi.RegisteredSealProof) error {
+	if _, err := toFilRegisteredSealProof(proofType); err != nil {
+		return errors.Wrapf(ErrUnsupportedProofType, "%s", err)
+	}
+
+	if !sealedCID.Defined() {
+		return errors.New("invalid sealed CID: undefined")
+	}
+
+	if sealedCID.Version() != 1 {
+		return errors.Errorf("invalid sealed CID: expected a version 1 CID, got version %d", sealedCID.Version())
+	}
+
+	codec, _, _, err := commcid.CIDToCommitment(sealedCID)
+	if err != nil {
+		return errors.Wrap(err, "invalid sealed CID")
+	}
+
+	if codec != cid.FilCommitmentSealed {
+		return errors.Wrapf(commcid.ErrIncorrectCodec, "invalid sealed CID: codec %#x", uint64(codec))
+	}
+
+	return nil
+}
+
 // VerifySeal returns true if the sealing operation from which its inputs were
 // derived was valid, and false if not.
 func VerifySeal(info proof5.SealVerifyInfo) (bool, error) {
@@ -58,9 +172,76 @@ func VerifySeal(info proof5.SealVerifyInfo) (bool, error) {
 	return resp.IsValid, nil
 }
 
+// verificationLimiter bounds the number of VerifyAggregateSeals calls into the
+// FFI running at once.
+var verificationLimiter = newConcurrencyLimiter(0)
+
+// SetMaxConcurrentVerifications limits the number of VerifyAggregateSeals
+// calls which may run in the FFI at once to n; further calls block until one
+// of them returns. A value of n <= 0, the default, removes the limit. The new
+// limit applies immediately, including to calls already waiting.
+//
+// Verifying a large aggregate can take several hundred megabytes of memory,
+// so allowing about one verification per GiB of RAM left over by the rest of
+// the node, e.g. 8 on a machine with 16 GiB to spare, is a reasonable start.
+func SetMaxConcurrentVerifications(n int) {
+	verificationLimiter.setLimit(n)
+}
+
+// concurrencyLimiter is a counting semaphore whose limit can be changed while
+// it is in use.
+type concurrencyLimiter struct {
+	lk     sync.Mutex
+	cond   *sync.Cond
+	limit  int
+	active int
+}
+
+func newConcurrencyLimiter(limit int) *concurrencyLimiter {
+	l := &concurrencyLimiter{limit: limit}
+	l.cond = sync.NewCond(&l.lk)
+	return l
+}
+
+func (l *concurrencyLimiter) setLimit(limit int) {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	l.limit = limit
+	l.cond.Broadcast()
+}
+
+func (l *concurrencyLimiter) acquire() {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	for l.limit > 0 && l.active >= l.limit {
+		l.cond.Wait()
+	}
+	l.active++
+}
+
+func (l *concurrencyLimiter) release() {
+	l.lk.Lock()
+	defer l.lk.Unlock()
+
+	l.active--
+	l.cond.Broadcast()
+}
+
+// VerifyAggregateSeals returns true if the aggregated seal proof is valid for
+// the given seal verify infos, and false if not. Errors preventing the proof
+// from being checked are returned as a *ProofVerificationError, which tells
+// malformed inputs, failures of the verifier such as missing parameters and
+// unsupported configurations apart. The number of calls running at once can
+// be bounded with SetMaxConcurrentVerifications.
 func VerifyAggregateSeals(aggregate proof5.AggregateSealVerifyProofAndInfos) (bool, error) {
 	if len(aggregate.Infos) == 0 {
-		return false, xerrors.New("no seal verify infos")
+		return false, &ProofVerificationError{Kind: ErrBadSectorCount, Msg: "no seal verify infos"}
+	}
+
+	if len(aggregate.Infos) < MinAggregatedProofs || len(aggregate.Infos) > MaxAggregatedProofs {
+		return false, &ProofVerificationError{Kind: ErrAggregateSize, Msg: fmt.Sprintf("got %d seal verify infos", len(aggregate.Infos))}
 	}
 
 	spt := aggregate.SealProof // todo assuming this needs to be the same for all sectors, potentially makes sense to put in AggregateSealVerifyProofAndInfos
@@ -69,12 +250,12 @@ func VerifyAggregateSeals(aggregate proof5.AggregateSealVerifyProofAndInfos) (bo
 	for i, info := range aggregate.Infos {
 		commR, err := to32ByteCommR(info.SealedCID)
 		if err != nil {
-			return false, err
+			return false, &ProofVerificationError{Kind: ErrProofInvalid, Msg: fmt.Sprintf("seal verify info %d: %s", i, err)}
 		}
 
 		commD, err := to32ByteCommD(info.UnsealedCID)
 		if err != nil {
-			return false, err
+			return false, &ProofVerificationError{Kind: ErrProofInvalid, Msg: fmt.Sprintf("seal verify info %d: %s", i, err)}
 		}
 
 		inputs[i] = generated.FilAggregationInputs{
@@ -88,31 +269,63 @@ func VerifyAggregateSeals(aggregate proof5.AggregateSealVerifyProofAndInfos) (bo
 
 	sp, err := toFilRegisteredSealProof(spt)
 	if err != nil {
-		return false, err
+		return false, &ProofVerificationError{Kind: ErrUnsupportedProofType, Msg: err.Error()}
 	}
 
 	proverID, err := toProverID(aggregate.Miner)
 	if err != nil {
-		return false, err
+		return false, &ProofVerificationError{Kind: ErrProofInvalid, Msg: err.Error()}
 	}
 
 	rap, err := toFilRegisteredAggregationProof(aggregate.AggregateProof)
 	if err != nil {
-		return false, err
+		return false, &ProofVerificationError{Kind: ErrUnsupportedProofType, Msg: err.Error()}
 	}
 
+	// released even if the call panics, after the response has been freed
+	verificationLimiter.acquire()
+	defer verificationLimiter.release()
+
 	resp := generated.FilVerifyAggregateSealProof(sp, rap, proverID, aggregate.Proof, uint(len(aggregate.Proof)), inputs, uint(len(inputs)))
 	resp.Deref()
 
 	defer generated.FilDestroyVerifyAggregateSealResponse(resp)
 
 	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
-		return false, errors.New(generated.RawString(resp.ErrorMsg).Copy())
+		return false, newFFIVerificationError(generated.RawString(resp.ErrorMsg).Copy())
 	}
 
 	return resp.IsValid, nil
 }
 
+// VerifyAggregateSealsWithContext behaves like VerifyAggregateSeals, but gives
+// up early and returns ctx.Err() if ctx is done before the verification
+// completes. The native call cannot be interrupted, so it will keep running in
+// the background until it finishes, at which point its result is discarded.
+func VerifyAggregateSealsWithContext(ctx context.Context, aggregate proof5.AggregateSealVerifyProofAndInfos) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	type result struct {
+		isValid bool
+		err     error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		isValid, err := VerifyAggregateSeals(aggregate)
+		done <- result{isValid: isValid, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case res := <-done:
+		return res.isValid, res.err
+	}
+}
+
 // VerifyWinningPoSt returns true if the Winning PoSt-generation operation from which its
 // inputs were derived was valid, and false if not.
 func VerifyWinningPoSt(info proof5.WinningPoStVerifyInfo) (bool, error) {
@@ -151,6 +364,55 @@ func VerifyWinningPoSt(info proof5.WinningPoStVerifyInfo) (bool, error) {
 	return resp.IsValid, nil
 }
 
+// DefaultVerifyWinningPoStTimeout is the timeout VerifyWinningPoStWithTimeout
+// uses when given a timeout of zero or less. It leaves plenty of headroom over
+// the time a Winning PoSt takes to verify, while keeping block validation well
+// within the block propagation delay.
+const DefaultVerifyWinningPoStTimeout = 5 * time.Second
+
+// ErrVerificationTimeout is returned by VerifyWinningPoStWithTimeout when the
+// verification does not complete in time.
+var ErrVerificationTimeout = errors.New("proof verification timed out")
+
+// VerifyWinningPoStWithTimeout behaves like VerifyWinningPoSt, but gives up
+// and returns false and ErrVerificationTimeout if the verification does not
+// complete within timeout, or within DefaultVerifyWinningPoStTimeout if timeout
+// is zero or less. The native call cannot be interrupted, so it will keep
+// running in the background until it finishes, at which point its result is
+// discarded.
+func VerifyWinningPoStWithTimeout(timeout time.Duration, info proof5.WinningPoStVerifyInfo) (bool, error) {
+	if timeout <= 0 {
+		timeout = DefaultVerifyWinningPoStTimeout
+	}
+
+	return verifyWithTimeout(timeout, func() (bool, error) {
+		return VerifyWinningPoSt(info)
+	})
+}
+
+func verifyWithTimeout(timeout time.Duration, verify func() (bool, error)) (bool, error) {
+	type result struct {
+		isValid bool
+		err     error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		isValid, err := verify()
+		done <- result{isValid: isValid, err: err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return false, ErrVerificationTimeout
+	case res := <-done:
+		return res.isValid, res.err
+	}
+}
+
 // VerifyWindowPoSt returns true if the Winning PoSt-generation operation from which its
 // inputs were derived was valid, and false if not.
 func VerifyWindowPoSt(info proof5.WindowPoStVerifyInfo) (bool, error) {
@@ -324,84 +586,215 @@ func WriteWithoutAlignment(
 	return abi.UnpaddedPieceSize(resp.TotalWriteUnpadded), commP, nil
 }
 
-// SealPreCommitPhase1
-func SealPreCommitPhase1(
-	proofType abi.RegisteredSealProof,
-	cacheDirPath string,
-	stagedSectorPath string,
-	sealedSectorPath string,
-	sectorNum abi.SectorNumber,
-	minerID abi.ActorID,
-	ticket abi.SealRandomness,
-	pieces []abi.PieceInfo,
-) (phase1Output []byte, err error) {
-	sp, err := toFilRegisteredSealProof(proofType)
+// StagedSectorBuilder writes pieces to a staged sector one at a time, taking
+// care of their alignment, and computes the unsealed CID of the sector from
+// them. A StagedSectorBuilder is not safe for concurrent use.
+type StagedSectorBuilder struct {
+	proofType  abi.RegisteredSealProof
+	staged     *os.File
+	sectorSize abi.PaddedPieceSize
+
+	written  abi.PaddedPieceSize
+	sizes    []abi.UnpaddedPieceSize
+	pieces   []abi.PieceInfo
+	finished bool
+}
+
+// NewStagedSectorBuilder returns a StagedSectorBuilder writing to staged,
+// which must be empty.
+func NewStagedSectorBuilder(proofType abi.RegisteredSealProof, staged *os.File) (*StagedSectorBuilder, error) {
+	sectorSize, err := proofType.SectorSize()
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrapf(ErrUnsupportedProofType, "%s", err)
 	}
 
-	proverID, err := toProverID(minerID)
-	if err != nil {
-		return nil, err
+	return &StagedSectorBuilder{
+		proofType:  proofType,
+		staged:     staged,
+		sectorSize: abi.PaddedPieceSize(sectorSize),
+	}, nil
+}
+
+// AddPiece writes size bytes read from r to the staged sector as a new piece,
+// preceded by the zero padding aligning it, and returns its piece info. The
+// piece is rejected if it does not fit in the rest of the sector.
+func (b *StagedSectorBuilder) AddPiece(r io.Reader, size abi.UnpaddedPieceSize) (abi.PieceInfo, error) {
+	if b.finished {
+		return abi.PieceInfo{}, errors.New("staged sector is finished")
+	}
+	if err := size.Validate(); err != nil {
+		return abi.PieceInfo{}, errors.Wrap(err, "invalid piece size")
 	}
 
-	filPublicPieceInfos, filPublicPieceInfosLen, err := toFilPublicPieceInfos(pieces)
+	padded := size.Padded()
+	leftAlignment := (padded - b.written%padded) % padded
+	if b.written+leftAlignment+padded > b.sectorSize {
+		return abi.PieceInfo{}, errors.Errorf("piece of %d bytes does not fit in the %d bytes left in the sector", padded, b.sectorSize-b.written)
+	}
+
+	var total abi.UnpaddedPieceSize
+	var pieceCID cid.Cid
+	if pieceFile, ok := r.(*os.File); ok {
+		var err error
+		if _, total, pieceCID, err = WriteWithAlignment(b.proofType, pieceFile, size, b.staged, b.sizes); err != nil {
+			return abi.PieceInfo{}, err
+		}
+	} else {
+		pr, pw, err := os.Pipe()
+		if err != nil {
+			return abi.PieceInfo{}, errors.Wrap(err, "failed to create pipe")
+		}
+		defer pr.Close()
+
+		copyErr := make(chan error, 1)
+		go func() {
+			_, err := io.CopyN(pw, r, int64(size))
+			copyErr <- err
+			pw.Close()
+		}()
+
+		var writeErr error
+		_, total, pieceCID, writeErr = WriteWithAlignment(b.proofType, pr, size, b.staged, b.sizes)
+		// unblock the copy if the write stopped reading early
+		pr.Close()
+		if err := <-copyErr; err != nil && !writeFailedFirst(err, writeErr) {
+			return abi.PieceInfo{}, errors.Wrap(err, "failed to read piece data")
+		}
+		if writeErr != nil {
+			return abi.PieceInfo{}, writeErr
+		}
+	}
+
+	b.written += total.Padded()
+	b.sizes = append(b.sizes, size)
+	piece := abi.PieceInfo{Size: padded, PieceCID: pieceCID}
+	b.pieces = append(b.pieces, piece)
+
+	return piece, nil
+}
+
+// Pad fills the rest of the sector with zero pieces, which are added to the
+// pieces of the sector like any other.
+func (b *StagedSectorBuilder) Pad() error {
+	for b.written < b.sectorSize {
+		// the largest piece which is aligned where the previous one ends and
+		// fits in the rest of the sector
+		size := b.sectorSize
+		if b.written > 0 {
+			size = b.written & -b.written
+		}
+		for size > b.sectorSize-b.written {
+			size /= 2
+		}
+
+		if _, err := b.AddPiece(zeroReader{}, size.Unpadded()); err != nil {
+			return errors.Wrap(err, "failed to add zero piece")
+		}
+	}
+
+	return nil
+}
+
+// Finish returns the unsealed CID of the staged sector and its pieces, to pass
+// to SealPreCommitPhase1. No pieces can be added afterwards.
+func (b *StagedSectorBuilder) Finish() (commD cid.Cid, pieces []abi.PieceInfo, err error) {
+	if len(b.pieces) == 0 {
+		return cid.Undef, nil, errors.New("no pieces added to the staged sector")
+	}
+
+	commD, err = GenerateUnsealedCID(b.proofType, b.pieces)
 	if err != nil {
-		return nil, err
+		return cid.Undef, nil, err
 	}
 
-	resp := generated.FilSealPreCommitPhase1(sp, cacheDirPath, stagedSectorPath, sealedSectorPath, uint64(sectorNum), proverID, to32ByteArray(ticket), filPublicPieceInfos, filPublicPieceInfosLen)
-	resp.Deref()
+	b.finished = true
+	return commD, append([]abi.PieceInfo{}, b.pieces...), nil
+}
 
-	defer generated.FilDestroySealPreCommitPhase1Response(resp)
+// zeroReader reads an endless stream of zeros.
+type zeroReader struct{}
 
-	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
-		return nil, errors.New(generated.RawString(resp.ErrorMsg).Copy())
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
 	}
+	return len(p), nil
+}
 
-	return copyBytes(resp.SealPreCommitPhase1OutputPtr, resp.SealPreCommitPhase1OutputLen), nil
+type sealPreCommitPhase1Config struct {
+	layerProgress func(layer, totalLayers int)
+	scratchDir    string
 }
 
-// SealPreCommitPhase2
-func SealPreCommitPhase2(
-	phase1Output []byte,
-	cacheDirPath string,
-	sealedSectorPath string,
-) (sealedCID cid.Cid, unsealedCID cid.Cid, err error) {
-	resp := generated.FilSealPreCommitPhase2(phase1Output, uint(len(phase1Output)), cacheDirPath, sealedSectorPath)
-	resp.Deref()
+// SealPreCommitPhase1Option configures how SealPreCommitPhase1 and its
+// variants seal a sector.
+type SealPreCommitPhase1Option func(*sealPreCommitPhase1Config)
 
-	defer generated.FilDestroySealPreCommitPhase2Response(resp)
+// WithLayerProgress makes SealPreCommitPhase1 report the number of SDR layers
+// completed so far. The layers are tracked by polling the cache directory for
+// the layer files the proofs library writes, so fn may skip layers that
+// complete in quick succession, and is called from a separate goroutine which
+// never blocks sealing. fn is not called after SealPreCommitPhase1 returns.
+func WithLayerProgress(fn func(layer, totalLayers int)) SealPreCommitPhase1Option {
+	return func(cfg *sealPreCommitPhase1Config) {
+		cfg.layerProgress = fn
+	}
+}
 
-	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
-		return cid.Undef, cid.Undef, errors.New(generated.RawString(resp.ErrorMsg).Copy())
+// WithScratchDir makes SealPreCommitPhase1FromReader create its staged sector
+// in dir instead of next to the sealed sector. It is removed before returning,
+// whether sealing succeeds or not. SealPreCommitPhase1 and
+// SealPreCommitPhase1Resume reject the option, as they create no temporary
+// files.
+//
+// SealPreCommitPhase2 and SealCommitPhase1 take no such option either: the
+// proofs library writes only to the cache directory and the sealed sector,
+// apart from the parent and parameter caches, whose locations are process-wide
+// settings, so there is nothing a per-call scratch directory could hold.
+func WithScratchDir(dir string) SealPreCommitPhase1Option {
+	return func(cfg *sealPreCommitPhase1Config) {
+		cfg.scratchDir = dir
 	}
+}
 
-	commR, errCommrSize := commcid.ReplicaCommitmentV1ToCID(resp.CommR[:])
-	if errCommrSize != nil {
-		return cid.Undef, cid.Undef, errCommrSize
+// layerProgressInterval is how often the cache directory is polled for
+// completed SDR layers.
+var layerProgressInterval = time.Second
+
+// SealPreCommitPhase1
+func SealPreCommitPhase1(
+	proofType abi.RegisteredSealProof,
+	cacheDirPath string,
+	stagedSectorPath string,
+	sealedSectorPath string,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+	ticket abi.SealRandomness,
+	pieces []abi.PieceInfo,
+	opts ...SealPreCommitPhase1Option,
+) (phase1Output []byte, err error) {
+	var cfg sealPreCommitPhase1Config
+	for _, opt := range opts {
+		opt(&cfg)
 	}
-	commD, errCommdSize := commcid.DataCommitmentV1ToCID(resp.CommD[:])
-	if errCommdSize != nil {
-		return cid.Undef, cid.Undef, errCommdSize
+	if cfg.scratchDir != "" {
+		return nil, errors.New("WithScratchDir only applies to SealPreCommitPhase1FromReader")
 	}
 
-	return commR, commD, nil
+	return sealPreCommitPhase1(proofType, cacheDirPath, stagedSectorPath, sealedSectorPath, sectorNum, minerID, ticket, pieces, cfg)
 }
 
-// SealCommitPhase1
-func SealCommitPhase1(
+func sealPreCommitPhase1(
 	proofType abi.RegisteredSealProof,
-	sealedCID cid.Cid,
-	unsealedCID cid.Cid,
 	cacheDirPath string,
+	stagedSectorPath string,
 	sealedSectorPath string,
 	sectorNum abi.SectorNumber,
 	minerID abi.ActorID,
 	ticket abi.SealRandomness,
-	seed abi.InteractiveSealRandomness,
 	pieces []abi.PieceInfo,
-) (phase1Output []byte, err error) {
+	cfg sealPreCommitPhase1Config,
+) ([]byte, error) {
 	sp, err := toFilRegisteredSealProof(proofType)
 	if err != nil {
 		return nil, err
@@ -412,115 +805,1121 @@ func SealCommitPhase1(
 		return nil, err
 	}
 
-	commR, err := to32ByteCommR(sealedCID)
+	filPublicPieceInfos, filPublicPieceInfosLen, err := toFilPublicPieceInfos(pieces)
 	if err != nil {
 		return nil, err
 	}
 
-	commD, err := to32ByteCommD(unsealedCID)
-	if err != nil {
-		return nil, err
-	}
+	if cfg.layerProgress != nil {
+		sectorSize, err := proofType.SectorSize()
+		if err != nil {
+			return nil, errors.Wrapf(ErrUnsupportedProofType, "%s", err)
+		}
 
-	filPublicPieceInfos, filPublicPieceInfosLen, err := toFilPublicPieceInfos(pieces)
-	if err != nil {
-		return nil, err
+		stop := watchSDRLayers(cacheDirPath, sectorSize, cfg.layerProgress)
+		defer stop()
 	}
 
-	resp := generated.FilSealCommitPhase1(sp, commR, commD, cacheDirPath, sealedSectorPath, uint64(sectorNum), proverID, to32ByteArray(ticket), to32ByteArray(seed), filPublicPieceInfos, filPublicPieceInfosLen)
+	resp := generated.FilSealPreCommitPhase1(sp, cacheDirPath, stagedSectorPath, sealedSectorPath, uint64(sectorNum), proverID, to32ByteArray(ticket), filPublicPieceInfos, filPublicPieceInfosLen)
 	resp.Deref()
 
-	defer generated.FilDestroySealCommitPhase1Response(resp)
+	defer generated.FilDestroySealPreCommitPhase1Response(resp)
 
 	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
 		return nil, errors.New(generated.RawString(resp.ErrorMsg).Copy())
 	}
 
-	return copyBytes(resp.SealCommitPhase1OutputPtr, resp.SealCommitPhase1OutputLen), nil
+	return copyBytes(resp.SealPreCommitPhase1OutputPtr, resp.SealPreCommitPhase1OutputLen), nil
 }
 
-// SealCommitPhase2
-func SealCommitPhase2(
-	phase1Output []byte,
+// SealPreCommitPhase1Resume is like SealPreCommitPhase1, but resumes an
+// interrupted run from the SDR layers it already wrote to cacheDirPath. The
+// layer files are checked in order, and the first missing or truncated layer
+// and every layer after it are removed so that they are recomputed, while the
+// complete layers before it are reused by the proofs library. It returns the
+// number of layers that were reused.
+func SealPreCommitPhase1Resume(
+	proofType abi.RegisteredSealProof,
+	cacheDirPath string,
+	stagedSectorPath string,
+	sealedSectorPath string,
 	sectorNum abi.SectorNumber,
 	minerID abi.ActorID,
-) ([]byte, error) {
-	proverID, err := toProverID(minerID)
+	ticket abi.SealRandomness,
+	pieces []abi.PieceInfo,
+	opts ...SealPreCommitPhase1Option,
+) (phase1Output []byte, skippedLayers int, err error) {
+	skippedLayers, err = prepareSDRLayers(proofType, cacheDirPath)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	resp := generated.FilSealCommitPhase2(phase1Output, uint(len(phase1Output)), uint64(sectorNum), proverID)
-	resp.Deref()
+	phase1Output, err = SealPreCommitPhase1(proofType, cacheDirPath, stagedSectorPath, sealedSectorPath, sectorNum, minerID, ticket, pieces, opts...)
+	if err != nil {
+		return nil, 0, err
+	}
 
-	defer generated.FilDestroySealCommitPhase2Response(resp)
+	return phase1Output, skippedLayers, nil
+}
 
-	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
-		return nil, errors.New(generated.RawString(resp.ErrorMsg).Copy())
-	}
+// sdrLayerPath returns the path of the file the proofs library stores the
+// labels of the given SDR layer in.
+func sdrLayerPath(cacheDirPath string, layer int) string {
+	return filepath.Join(cacheDirPath, fmt.Sprintf("sc-02-data-layer-%d.dat", layer))
+}
 
-	return copyBytes(resp.ProofPtr, resp.ProofLen), nil
+// sdrLayers returns the number of SDR layers sectors of the given size are
+// sealed with.
+func sdrLayers(sectorSize abi.SectorSize) int {
+	if sectorSize >= 32<<30 {
+		return 11
+	}
+	return 2
 }
 
-// TODO AggregateSealProofs it only needs InteractiveRandomness out of the aggregateInfo.Infos
-func AggregateSealProofs(aggregateInfo proof5.AggregateSealVerifyProofAndInfos, proofs [][]byte) (out []byte, err error) {
-	sp, err := toFilRegisteredSealProof(aggregateInfo.SealProof)
+// prepareSDRLayers returns the number of consecutive complete SDR layers in
+// cacheDirPath, and removes the layer files following them.
+func prepareSDRLayers(proofType abi.RegisteredSealProof, cacheDirPath string) (int, error) {
+	sectorSize, err := proofType.SectorSize()
 	if err != nil {
-		return nil, err
+		return 0, errors.Wrapf(ErrUnsupportedProofType, "%s", err)
 	}
 
-	commRs := make([]generated.Fil32ByteArray, len(aggregateInfo.Infos))
-	seeds := make([]generated.Fil32ByteArray, len(aggregateInfo.Infos))
-	for i, info := range aggregateInfo.Infos {
-		seeds[i] = to32ByteArray(info.InteractiveRandomness)
-		commRs[i], err = to32ByteCommR(info.SealedCID)
-		if err != nil {
-			return nil, err
-		}
-	}
+	layers := sdrLayers(sectorSize)
+	complete := completeSDRLayers(cacheDirPath, sectorSize)
 
-	pfs := make([]generated.FilSealCommitPhase2Response, len(proofs))
-	for i := range proofs {
-		pfs[i] = generated.FilSealCommitPhase2Response{
-			ProofPtr: proofs[i],
-			ProofLen: uint(len(proofs[i])),
+	for layer := complete + 1; layer <= layers; layer++ {
+		if err := os.Remove(sdrLayerPath(cacheDirPath, layer)); err != nil && !os.IsNotExist(err) {
+			return 0, errors.Wrapf(err, "failed to remove incomplete layer %d", layer)
 		}
 	}
 
-	rap, err := toFilRegisteredAggregationProof(aggregateInfo.AggregateProof)
+	return complete, nil
+}
+
+// GenerateSDR computes the SDR layers of a sector with the given replica ID,
+// see ComputeReplicaID, and writes them to cacheDirPath, as the labeling step
+// of SealPreCommitPhase1 does. Unlike SealPreCommitPhase1, it does not need
+// the staged sector and does not build tree_d, so SealPreCommitPhase2 can use
+// the layers once tree_d and the phase 1 output are produced elsewhere.
+func GenerateSDR(proofType abi.RegisteredSealProof, cacheDirPath string, replicaID [32]byte) error {
+	sp, err := toFilRegisteredSealProof(proofType)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	resp := generated.FilAggregateSealProofs(sp, rap, commRs, uint(len(commRs)), seeds, uint(len(seeds)), pfs, uint(len(pfs)))
+	resp := generated.FilGenerateSdr(sp, cacheDirPath, generated.Fil32ByteArray{Inner: replicaID})
 	resp.Deref()
 
-	defer generated.FilDestroyAggregateProof(resp)
+	defer generated.FilDestroyGenerateSdrResponse(resp)
 
 	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
-		return nil, errors.New(generated.RawString(resp.ErrorMsg).Copy())
+		return errors.New(generated.RawString(resp.ErrorMsg).Copy())
 	}
 
-	return copyBytes(resp.ProofPtr, resp.ProofLen), nil
+	return nil
 }
 
-// Unseal
-func Unseal(
-	proofType abi.RegisteredSealProof,
-	cacheDirPath string,
-	sealedSector *os.File,
-	unsealOutput *os.File,
-	sectorNum abi.SectorNumber,
-	minerID abi.ActorID,
-	ticket abi.SealRandomness,
-	unsealedCID cid.Cid,
-) error {
-	sectorSize, err := proofType.SectorSize()
-	if err != nil {
-		return err
+// PoRepID returns the PoRep seed of a seal proof type, which, along with the
+// sector and its data, determines its replica ID.
+func PoRepID(proofType abi.RegisteredSealProof) ([32]byte, error) {
+	if _, err := toFilRegisteredSealProof(proofType); err != nil {
+		return [32]byte{}, err
 	}
 
-	unpaddedBytesAmount := abi.PaddedPieceSize(sectorSize).Unpadded()
+	return porepID(proofType), nil
+}
+
+// porepID is PoRepID without checking that proofType is supported
+func porepID(proofType abi.RegisteredSealProof) [32]byte {
+	var id [32]byte
+	binary.LittleEndian.PutUint64(id[:8], uint64(proofType))
+	return id
+}
+
+// ComputeReplicaID computes the replica ID SealPreCommitPhase1 labels a sector
+// with: the SHA-256 digest of the prover ID, the big endian sector number, the
+// ticket, commD and the PoRep seed, see PoRepID, truncated to a field element.
+func ComputeReplicaID(minerID abi.ActorID, sectorNum abi.SectorNumber, ticket abi.SealRandomness, commD cid.Cid, porepSeed [32]byte) ([32]byte, error) {
+	proverID, err := toProverID(minerID)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	commDBytes, err := to32ByteCommD(commD)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return replicaID(proverID.Inner, sectorNum, to32ByteArray(ticket).Inner, commDBytes.Inner, porepSeed), nil
+}
+
+// completeSDRLayers returns the number of consecutive complete SDR layers in
+// cacheDirPath, starting from the first one.
+func completeSDRLayers(cacheDirPath string, sectorSize abi.SectorSize) int {
+	complete := 0
+	for ; complete < sdrLayers(sectorSize); complete++ {
+		info, err := os.Stat(sdrLayerPath(cacheDirPath, complete+1))
+		if err != nil || !info.Mode().IsRegular() || info.Size() != int64(sectorSize) {
+			break
+		}
+	}
+
+	return complete
+}
+
+// watchSDRLayers calls fn whenever more SDR layers are complete in
+// cacheDirPath, until the returned function is called.
+func watchSDRLayers(cacheDirPath string, sectorSize abi.SectorSize, fn func(layer, totalLayers int)) (stop func()) {
+	reported := 0
+	return pollProgress(func() {
+		if complete := completeSDRLayers(cacheDirPath, sectorSize); complete > reported {
+			reported = complete
+			fn(complete, sdrLayers(sectorSize))
+		}
+	})
+}
+
+// pollProgress calls poll every layerProgressInterval from a new goroutine,
+// until the returned function is called. That function polls a last time and
+// waits for poll to return, so that poll is never called after it.
+func pollProgress(poll func()) (stop func()) {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(layerProgressInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				poll()
+			case <-done:
+				poll()
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			<-stopped
+		})
+	}
+}
+
+// SealPreCommitPhase1FromReader is like SealPreCommitPhase1, but reads the
+// unpadded sector data from unsealed rather than from a staged sector file.
+// unsealed must yield exactly the unpadded sector size of proofType, with the
+// pieces laid out as they would be in a staged sector, including any alignment
+// padding. The data is staged into a temporary file next to sealedSectorPath,
+// or in the directory of WithScratchDir, which is removed before returning.
+func SealPreCommitPhase1FromReader(
+	proofType abi.RegisteredSealProof,
+	cacheDirPath string,
+	unsealed io.Reader,
+	sealedSectorPath string,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+	ticket abi.SealRandomness,
+	pieces []abi.PieceInfo,
+	opts ...SealPreCommitPhase1Option,
+) (phase1Output []byte, err error) {
+	sectorSize, err := proofType.SectorSize()
+	if err != nil {
+		return nil, errors.Wrapf(ErrUnsupportedProofType, "%s", err)
+	}
+	unpaddedSize := abi.PaddedPieceSize(sectorSize).Unpadded()
+
+	var cfg sealPreCommitPhase1Config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	scratchDir := filepath.Dir(sealedSectorPath)
+	if cfg.scratchDir != "" {
+		scratchDir = cfg.scratchDir
+	}
+
+	stagedSectorFile, err := ioutil.TempFile(scratchDir, "staged-sector-")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create staged sector file")
+	}
+	defer os.Remove(stagedSectorFile.Name())
+	defer stagedSectorFile.Close()
+
+	pr, pw, err := os.Pipe()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create pipe")
+	}
+	defer pr.Close()
+
+	copyErr := make(chan error, 1)
+	go func() {
+		copyErr <- copyExactly(pw, unsealed, int64(unpaddedSize))
+		pw.Close()
+	}()
+
+	_, _, writeErr := WriteWithoutAlignment(proofType, pr, unpaddedSize, stagedSectorFile)
+	// unblock the copy if the write stopped reading early
+	pr.Close()
+	if err := <-copyErr; err != nil && !writeFailedFirst(err, writeErr) {
+		return nil, errors.Wrap(err, "failed to read unsealed sector data")
+	}
+	if writeErr != nil {
+		return nil, errors.Wrap(writeErr, "failed to stage unsealed sector data")
+	}
+
+	return sealPreCommitPhase1(proofType, cacheDirPath, stagedSectorFile.Name(), sealedSectorPath, sectorNum, minerID, ticket, pieces, cfg)
+}
+
+// copyExactly copies n bytes from src to dst and fails if src holds fewer or
+// more than n bytes.
+func copyExactly(dst io.Writer, src io.Reader, n int64) error {
+	if written, err := io.CopyN(dst, src, n); err != nil {
+		if err == io.EOF {
+			return errors.Errorf("expected %d bytes, got %d", n, written)
+		}
+		return err
+	}
+
+	var extra [1]byte
+	if k, _ := io.ReadFull(src, extra[:]); k != 0 {
+		return errors.Errorf("expected %d bytes, got more", n)
+	}
+
+	return nil
+}
+
+// writeFailedFirst reports whether copyErr, returned by a copy into a pipe,
+// only followed from the reader of the pipe failing with writeErr and closing
+// it, in which case writeErr is the error to report.
+func writeFailedFirst(copyErr, writeErr error) bool {
+	return writeErr != nil && (errors.Is(copyErr, syscall.EPIPE) || errors.Is(copyErr, io.ErrClosedPipe))
+}
+
+// SealPreCommitPhase2Stage is a stage of SealPreCommitPhase2, as reported by
+// WithTreeProgress.
+type SealPreCommitPhase2Stage int
+
+const (
+	// SealPreCommitPhase2TreeC is the building of tree_c over the SDR layers.
+	SealPreCommitPhase2TreeC SealPreCommitPhase2Stage = iota
+	// SealPreCommitPhase2TreeRLast is the encoding of the replica and the
+	// building of tree_r_last over it.
+	SealPreCommitPhase2TreeRLast
+	// SealPreCommitPhase2CommR is reached once CommR is computed from the
+	// roots of both trees.
+	SealPreCommitPhase2CommR
+)
+
+func (s SealPreCommitPhase2Stage) String() string {
+	switch s {
+	case SealPreCommitPhase2TreeC:
+		return "tree_c"
+	case SealPreCommitPhase2TreeRLast:
+		return "tree_r_last"
+	case SealPreCommitPhase2CommR:
+		return "comm_r"
+	default:
+		return fmt.Sprintf("SealPreCommitPhase2Stage(%d)", int(s))
+	}
+}
+
+type sealPreCommitPhase2Config struct {
+	treeProgress func(stage SealPreCommitPhase2Stage, percent int)
+}
+
+// SealPreCommitPhase2Option configures how SealPreCommitPhase2 seals a sector.
+type SealPreCommitPhase2Option func(*sealPreCommitPhase2Config)
+
+// WithTreeProgress makes SealPreCommitPhase2 report each stage it enters, and
+// the percentage of the trees of that stage built so far. Like
+// WithLayerProgress, the trees are tracked by polling the cache directory, and
+// fn is called from a separate goroutine and not after SealPreCommitPhase2
+// returns. The stages are reported in order, each completed stage with 100
+// percent, even when they complete in quick succession. A nil fn disables
+// reporting and no cache directory polling takes place.
+func WithTreeProgress(fn func(stage SealPreCommitPhase2Stage, percent int)) SealPreCommitPhase2Option {
+	return func(cfg *sealPreCommitPhase2Config) {
+		cfg.treeProgress = fn
+	}
+}
+
+// SealPreCommitPhase2
+func SealPreCommitPhase2(
+	phase1Output []byte,
+	cacheDirPath string,
+	sealedSectorPath string,
+	opts ...SealPreCommitPhase2Option,
+) (sealedCID cid.Cid, unsealedCID cid.Cid, err error) {
+	var cfg sealPreCommitPhase2Config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.treeProgress != nil {
+		// phase 1 leaves a sealed sector file of the full sector size
+		info, err := os.Stat(sealedSectorPath)
+		if err != nil {
+			return cid.Undef, cid.Undef, errors.Wrap(err, "failed to stat sealed sector")
+		}
+
+		stop := watchPreCommitPhase2Trees(cacheDirPath, abi.SectorSize(info.Size()), cfg.treeProgress)
+		defer stop()
+	}
+
+	resp := generated.FilSealPreCommitPhase2(phase1Output, uint(len(phase1Output)), cacheDirPath, sealedSectorPath)
+	resp.Deref()
+
+	defer generated.FilDestroySealPreCommitPhase2Response(resp)
+
+	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
+		return cid.Undef, cid.Undef, errors.New(generated.RawString(resp.ErrorMsg).Copy())
+	}
+
+	commR, errCommrSize := commcid.ReplicaCommitmentV1ToCID(resp.CommR[:])
+	if errCommrSize != nil {
+		return cid.Undef, cid.Undef, errCommrSize
+	}
+	commD, errCommdSize := commcid.DataCommitmentV1ToCID(resp.CommD[:])
+	if errCommdSize != nil {
+		return cid.Undef, cid.Undef, errCommdSize
+	}
+
+	return commR, commD, nil
+}
+
+// sectorTrees returns the number of sub-trees tree_c and tree_r_last of
+// sectors of the given size are split into, one file each.
+func sectorTrees(sectorSize abi.SectorSize) int {
+	switch {
+	case sectorSize >= 64<<30:
+		return 16
+	case sectorSize >= 32<<30:
+		return 8
+	default:
+		return 1
+	}
+}
+
+// preCommitPhase2Progress returns the stage SealPreCommitPhase2 reached
+// according to the files in cacheDirPath, and the percentage of the trees of
+// that stage which are built. A tree file is only known to be complete once
+// the next one appears.
+func preCommitPhase2Progress(cacheDirPath string, sectorSize abi.SectorSize) (SealPreCommitPhase2Stage, int) {
+	if _, err := os.Stat(filepath.Join(cacheDirPath, "p_aux")); err == nil {
+		return SealPreCommitPhase2CommR, 100
+	}
+
+	trees := sectorTrees(sectorSize)
+	builtPercent := func(pattern string) (int, bool) {
+		files, _ := filepath.Glob(filepath.Join(cacheDirPath, pattern))
+		if len(files) == 0 {
+			return 0, false
+		}
+		if len(files) > trees {
+			return 100, true
+		}
+		return 100 * (len(files) - 1) / trees, true
+	}
+
+	if percent, ok := builtPercent("sc-02-data-tree-r-last*.dat"); ok {
+		return SealPreCommitPhase2TreeRLast, percent
+	}
+
+	percent, _ := builtPercent("sc-02-data-tree-c*.dat")
+	return SealPreCommitPhase2TreeC, percent
+}
+
+// watchPreCommitPhase2Trees calls fn with the progress of SealPreCommitPhase2
+// in cacheDirPath whenever it changes, until the returned function is called.
+// Every stage passed since the last call is reported as complete first.
+func watchPreCommitPhase2Trees(cacheDirPath string, sectorSize abi.SectorSize, fn func(stage SealPreCommitPhase2Stage, percent int)) (stop func()) {
+	reportedStage, reportedPercent := SealPreCommitPhase2TreeC, -1
+	return pollProgress(func() {
+		stage, percent := preCommitPhase2Progress(cacheDirPath, sectorSize)
+		if stage < reportedStage || (stage == reportedStage && percent <= reportedPercent) {
+			return
+		}
+
+		for ; reportedStage < stage; reportedStage++ {
+			if reportedPercent < 100 {
+				fn(reportedStage, 100)
+			}
+			reportedPercent = -1
+		}
+
+		reportedPercent = percent
+		fn(stage, percent)
+	})
+}
+
+// SealCommitPhase1
+func SealCommitPhase1(
+	proofType abi.RegisteredSealProof,
+	sealedCID cid.Cid,
+	unsealedCID cid.Cid,
+	cacheDirPath string,
+	sealedSectorPath string,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+	ticket abi.SealRandomness,
+	seed abi.InteractiveSealRandomness,
+	pieces []abi.PieceInfo,
+) (phase1Output []byte, err error) {
+	sp, err := toFilRegisteredSealProof(proofType)
+	if err != nil {
+		return nil, err
+	}
+
+	proverID, err := toProverID(minerID)
+	if err != nil {
+		return nil, err
+	}
+
+	commR, err := to32ByteCommR(sealedCID)
+	if err != nil {
+		return nil, err
+	}
+
+	commD, err := to32ByteCommD(unsealedCID)
+	if err != nil {
+		return nil, err
+	}
+
+	filPublicPieceInfos, filPublicPieceInfosLen, err := toFilPublicPieceInfos(pieces)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := generated.FilSealCommitPhase1(sp, commR, commD, cacheDirPath, sealedSectorPath, uint64(sectorNum), proverID, to32ByteArray(ticket), to32ByteArray(seed), filPublicPieceInfos, filPublicPieceInfosLen)
+	resp.Deref()
+
+	defer generated.FilDestroySealCommitPhase1Response(resp)
+
+	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
+		return nil, errors.New(generated.RawString(resp.ErrorMsg).Copy())
+	}
+
+	return copyBytes(resp.SealCommitPhase1OutputPtr, resp.SealCommitPhase1OutputLen), nil
+}
+
+// sealCommitPhase2External generates the proof of SealCommitPhase2 with prove,
+// and checks it against the sector and randomness of the phase 1 output.
+func sealCommitPhase2External(ctx context.Context, prove Commit2Prover, phase1Output []byte, sectorNum abi.SectorNumber, minerID abi.ActorID) ([]byte, error) {
+	info, err := checkSealCommitPhase1Output(bytes.NewReader(phase1Output), int64(len(phase1Output)), sectorNum, minerID)
+	if err != nil {
+		return nil, err
+	}
+
+	sector := abi.SectorID{Miner: minerID, Number: sectorNum}
+	proof, err := prove(ctx, phase1Output, sector)
+	if err != nil {
+		return nil, errors.Wrap(err, "external prover failed")
+	}
+
+	sealedCID, err := commcid.ReplicaCommitmentV1ToCID(info.CommR[:])
+	if err != nil {
+		return nil, err
+	}
+
+	unsealedCID, err := commcid.DataCommitmentV1ToCID(info.CommD[:])
+	if err != nil {
+		return nil, err
+	}
+
+	valid, err := VerifySeal(proof5.SealVerifyInfo{
+		SealProof:             info.proofType,
+		SectorID:              sector,
+		DealIDs:               []abi.DealID{},
+		Randomness:            info.Ticket[:],
+		InteractiveRandomness: info.Seed[:],
+		Proof:                 proof,
+		SealedCID:             sealedCID,
+		UnsealedCID:           unsealedCID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(ErrExternalProofInvalid, "failed to verify proof of sector %d: %s", sectorNum, err)
+	}
+	if !valid {
+		return nil, errors.Wrapf(ErrExternalProofInvalid, "sector %d", sectorNum)
+	}
+
+	return proof, nil
+}
+
+// SealCommitPhase1ToFile is like SealCommitPhase1, but has the proofs library
+// write the output to outputPath instead of returning it, which keeps the
+// output off the Go heap. The output is in the same serialization format as
+// the one SealCommitPhase1 returns, and outputPath is only replaced once the
+// output is completely written. Pass outputPath to SealCommitPhase2FromFile to
+// generate the proof.
+func SealCommitPhase1ToFile(
+	proofType abi.RegisteredSealProof,
+	sealedCID cid.Cid,
+	unsealedCID cid.Cid,
+	cacheDirPath string,
+	sealedSectorPath string,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+	ticket abi.SealRandomness,
+	seed abi.InteractiveSealRandomness,
+	pieces []abi.PieceInfo,
+	outputPath string,
+) error {
+	sp, err := toFilRegisteredSealProof(proofType)
+	if err != nil {
+		return err
+	}
+
+	proverID, err := toProverID(minerID)
+	if err != nil {
+		return err
+	}
+
+	commR, err := to32ByteCommR(sealedCID)
+	if err != nil {
+		return err
+	}
+
+	commD, err := to32ByteCommD(unsealedCID)
+	if err != nil {
+		return err
+	}
+
+	filPublicPieceInfos, filPublicPieceInfosLen, err := toFilPublicPieceInfos(pieces)
+	if err != nil {
+		return err
+	}
+
+	resp := generated.FilSealCommitPhase1ToFile(sp, commR, commD, cacheDirPath, sealedSectorPath, uint64(sectorNum), proverID, to32ByteArray(ticket), to32ByteArray(seed), filPublicPieceInfos, filPublicPieceInfosLen, outputPath)
+	resp.Deref()
+
+	defer generated.FilDestroySealCommitPhase1ToFileResponse(resp)
+
+	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
+		return errors.New(generated.RawString(resp.ErrorMsg).Copy())
+	}
+
+	return nil
+}
+
+// Commit2Prover generates the proof of SealCommitPhase2 from the output of
+// SealCommitPhase1, such as by sending it to an external proving service.
+type Commit2Prover func(ctx context.Context, phase1Output []byte, sector abi.SectorID) ([]byte, error)
+
+// ErrExternalProofInvalid is wrapped by the error SealCommitPhase2 returns when
+// the proof of a registered Commit2Prover does not verify.
+var ErrExternalProofInvalid = errors.New("external prover returned an invalid proof")
+
+var commit2Prover struct {
+	lk    sync.RWMutex
+	prove Commit2Prover
+}
+
+// RegisterCommit2Prover makes SealCommitPhase2 and its variants generate their
+// proofs with prove instead of the native prover, and verify them with
+// VerifySeal before returning them. Every call to SealCommitPhase2 makes its own
+// call to prove. Registering a nil prover restores the native prover.
+func RegisterCommit2Prover(prove Commit2Prover) {
+	commit2Prover.lk.Lock()
+	defer commit2Prover.lk.Unlock()
+
+	commit2Prover.prove = prove
+}
+
+// registeredCommit2Prover returns the prover of RegisterCommit2Prover, or nil
+// if the native prover is used.
+func registeredCommit2Prover() Commit2Prover {
+	commit2Prover.lk.RLock()
+	defer commit2Prover.lk.RUnlock()
+
+	return commit2Prover.prove
+}
+
+// SealCommitPhase2
+func SealCommitPhase2(
+	phase1Output []byte,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+) ([]byte, error) {
+	if prove := registeredCommit2Prover(); prove != nil {
+		return sealCommitPhase2External(context.Background(), prove, phase1Output, sectorNum, minerID)
+	}
+
+	proverID, err := toProverID(minerID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := generated.FilSealCommitPhase2(phase1Output, uint(len(phase1Output)), uint64(sectorNum), proverID)
+	resp.Deref()
+
+	defer generated.FilDestroySealCommitPhase2Response(resp)
+
+	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
+		return nil, errors.New(generated.RawString(resp.ErrorMsg).Copy())
+	}
+
+	return copyBytes(resp.ProofPtr, resp.ProofLen), nil
+}
+
+// SealCommitPhase2FromFile is like SealCommitPhase2, but reads the output of
+// phase 1 from the file SealCommitPhase1ToFile wrote to phase1OutputPath. An
+// error is returned if the file is corrupted or truncated.
+//
+// ctx is passed to a registered Commit2Prover, which is handed the contents of
+// the file. The native prover cannot be interrupted, so ctx is only checked
+// before it starts.
+func SealCommitPhase2FromFile(
+	ctx context.Context,
+	phase1OutputPath string,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if prove := registeredCommit2Prover(); prove != nil {
+		phase1Output, err := ioutil.ReadFile(phase1OutputPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read phase 1 output file")
+		}
+		return sealCommitPhase2External(ctx, prove, phase1Output, sectorNum, minerID)
+	}
+
+	return sealCommitPhase2FromFile(phase1OutputPath, sectorNum, minerID)
+}
+
+// sealCommitPhase2FromFile is SealCommitPhase2FromFile with the native prover.
+func sealCommitPhase2FromFile(phase1OutputPath string, sectorNum abi.SectorNumber, minerID abi.ActorID) ([]byte, error) {
+	proverID, err := toProverID(minerID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := generated.FilSealCommitPhase2FromFile(phase1OutputPath, uint64(sectorNum), proverID)
+	resp.Deref()
+
+	defer generated.FilDestroySealCommitPhase2Response(resp)
+
+	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
+		return nil, errors.New(generated.RawString(resp.ErrorMsg).Copy())
+	}
+
+	return copyBytes(resp.ProofPtr, resp.ProofLen), nil
+}
+
+// Commit1Output is the output of SealCommitPhase1 for a sector, as passed to
+// SealCommitPhase2Batch.
+type Commit1Output struct {
+	Phase1Output []byte
+	SectorNum    abi.SectorNumber
+	MinerID      abi.ActorID
+}
+
+// SealCommitPhase2BatchError is returned by SealCommitPhase2Batch when some of
+// its inputs failed. Errors maps the index of each failed input to its error.
+type SealCommitPhase2BatchError struct {
+	Errors map[int]error
+}
+
+func (e *SealCommitPhase2BatchError) Error() string {
+	indices := make([]int, 0, len(e.Errors))
+	for idx := range e.Errors {
+		indices = append(indices, idx)
+	}
+	sort.Ints(indices)
+
+	msgs := make([]string, len(indices))
+	for i, idx := range indices {
+		msgs[i] = fmt.Sprintf("input %d: %s", idx, e.Errors[idx])
+	}
+
+	return fmt.Sprintf("seal commit phase 2 failed for %d inputs: %s", len(indices), strings.Join(msgs, "; "))
+}
+
+// SealCommitPhase2Batch runs SealCommitPhase2 on each of inputs, making a
+// single call into the proofs library for up to maxInFlight inputs at a time,
+// which bounds the memory held by the phase 1 outputs and proofs in flight. A
+// maxInFlight of zero or less proves all inputs in a single call.
+//
+// The proofs library has no batched prover, so that call still proves the
+// inputs one after the other, setting up the GPU for each of them; it saves
+// the crossings into the library, not the proving setup.
+//
+// The proofs are returned in input order. If some inputs fail, the proofs of
+// the others are still returned, the entries of the failed inputs are nil, and
+// the error is a *SealCommitPhase2BatchError reporting them by index.
+//
+// If a Commit2Prover is registered, each input is passed to it in turn, as
+// SealCommitPhase2 would.
+func SealCommitPhase2Batch(inputs []Commit1Output, maxInFlight int) ([][]byte, error) {
+	if maxInFlight <= 0 || maxInFlight > len(inputs) {
+		maxInFlight = len(inputs)
+	}
+
+	proofs := make([][]byte, len(inputs))
+	failed := map[int]error{}
+
+	prove := registeredCommit2Prover()
+
+	for start := 0; start < len(inputs); start += maxInFlight {
+		end := start + maxInFlight
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+
+		if prove != nil {
+			for idx := start; idx < end; idx++ {
+				proof, err := sealCommitPhase2External(context.Background(), prove, inputs[idx].Phase1Output, inputs[idx].SectorNum, inputs[idx].MinerID)
+				if err != nil {
+					failed[idx] = err
+					continue
+				}
+				proofs[idx] = proof
+			}
+			continue
+		}
+
+		sealCommitPhase2Batch(inputs, start, end, proofs, failed)
+	}
+
+	if len(failed) > 0 {
+		return proofs, &SealCommitPhase2BatchError{Errors: failed}
+	}
+
+	return proofs, nil
+}
+
+// sealCommitPhase2Batch proves inputs[start:end] with a single call into the
+// proofs library, storing the result of each input at its index in proofs or
+// failed.
+func sealCommitPhase2Batch(inputs []Commit1Output, start, end int, proofs [][]byte, failed map[int]error) {
+	var indices []int
+	var filInputs []generated.FilSealCommitPhase2BatchInput
+	var allocs []AllocationManager
+	defer func() {
+		for idx := range allocs {
+			allocs[idx].Free()
+		}
+	}()
+
+	for idx := start; idx < end; idx++ {
+		proverID, err := toProverID(inputs[idx].MinerID)
+		if err != nil {
+			failed[idx] = err
+			continue
+		}
+
+		in := generated.FilSealCommitPhase2BatchInput{
+			SealCommitPhase1OutputPtr: inputs[idx].Phase1Output,
+			SealCommitPhase1OutputLen: uint(len(inputs[idx].Phase1Output)),
+			SectorId:                  uint64(inputs[idx].SectorNum),
+			ProverId:                  proverID,
+		}
+		_, alloc := in.PassRef()
+
+		indices = append(indices, idx)
+		filInputs = append(filInputs, in)
+		allocs = append(allocs, alloc)
+	}
+
+	if len(filInputs) == 0 {
+		return
+	}
+
+	resp := generated.FilSealCommitPhase2Batch(filInputs, uint(len(filInputs)))
+	resp.Deref()
+	resp.ResultsPtr = make([]generated.FilSealCommitPhase2Response, resp.ResultsLen)
+	resp.Deref()
+
+	defer generated.FilDestroySealCommitPhase2BatchResponse(resp)
+
+	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
+		err := errors.New(generated.RawString(resp.ErrorMsg).Copy())
+		for _, idx := range indices {
+			failed[idx] = err
+		}
+		return
+	}
+
+	if len(resp.ResultsPtr) != len(indices) {
+		err := errors.Errorf("expected %d seal commit phase 2 results, got %d", len(indices), len(resp.ResultsPtr))
+		for _, idx := range indices {
+			failed[idx] = err
+		}
+		return
+	}
+
+	for i, idx := range indices {
+		result := resp.ResultsPtr[i]
+		result.Deref()
+
+		if result.StatusCode != generated.FCPResponseStatusFCPNoError {
+			failed[idx] = errors.New(generated.RawString(result.ErrorMsg).Copy())
+			continue
+		}
+
+		proofs[idx] = copyBytes(result.ProofPtr, result.ProofLen)
+	}
+}
+
+// ErrSealCommitPhase1OutputMismatch is wrapped by the error
+// SealCommitPhase2Reader returns when the phase 1 output belongs to another
+// sector.
+var ErrSealCommitPhase1OutputMismatch = errors.New("seal commit phase 1 output is for another sector")
+
+// SealCommitPhase2Reader is like SealCommitPhase2, but reads the phase 1 output
+// of size bytes from r rather than from memory, so that it can be fetched from
+// a remote worker. Before any proving work starts, the proof type and replica
+// ID found at the start and end of the output are checked against the sector,
+// failing with an error wrapping ErrSealCommitPhase1OutputMismatch if they do
+// not match. The output is then copied in large chunks to a temporary file
+// which the proofs library reads from.
+//
+// If a Commit2Prover is registered, the output is read into memory and passed
+// to it along with ctx instead. The native prover cannot be interrupted, so
+// ctx is only checked before it starts.
+func SealCommitPhase2Reader(
+	ctx context.Context,
+	r io.ReaderAt,
+	size int64,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+) ([]byte, error) {
+	if _, err := checkSealCommitPhase1Output(r, size, sectorNum, minerID); err != nil {
+		return nil, err
+	}
+
+	if prove := registeredCommit2Prover(); prove != nil {
+		phase1Output := make([]byte, size)
+		if _, err := io.ReadFull(io.NewSectionReader(r, 0, size), phase1Output); err != nil {
+			return nil, errors.Wrap(err, "failed to read phase 1 output")
+		}
+		return sealCommitPhase2External(ctx, prove, phase1Output, sectorNum, minerID)
+	}
+
+	phase1OutputFile, err := ioutil.TempFile("", "seal-commit-phase1-output-")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create phase 1 output file")
+	}
+	defer os.Remove(phase1OutputFile.Name())
+	defer phase1OutputFile.Close()
+
+	buf := make([]byte, sealCommitPhase1OutputChunkBytes)
+	if _, err := io.CopyBuffer(phase1OutputFile, io.NewSectionReader(r, 0, size), buf); err != nil {
+		return nil, errors.Wrap(err, "failed to copy phase 1 output")
+	}
+	if err := phase1OutputFile.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to write phase 1 output file")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return sealCommitPhase2FromFile(phase1OutputFile.Name(), sectorNum, minerID)
+}
+
+// sealCommitPhase1OutputChunkBytes is the size of the chunks
+// SealCommitPhase2Reader reads the phase 1 output in.
+const sealCommitPhase1OutputChunkBytes = 4 << 20
+
+// maxSealCommitPhase1OutputTailBytes bounds the trailing fields of a phase 1
+// output, which follow its vanilla proofs.
+const maxSealCommitPhase1OutputTailBytes = 4 << 10
+
+// sealProofTypesByName maps the names of the seal proof types, as serialized in
+// phase 1 outputs, to the proof types.
+var sealProofTypesByName = map[string]abi.RegisteredSealProof{
+	"StackedDrg2KiBV1":     abi.RegisteredSealProof_StackedDrg2KiBV1,
+	"StackedDrg8MiBV1":     abi.RegisteredSealProof_StackedDrg8MiBV1,
+	"StackedDrg512MiBV1":   abi.RegisteredSealProof_StackedDrg512MiBV1,
+	"StackedDrg32GiBV1":    abi.RegisteredSealProof_StackedDrg32GiBV1,
+	"StackedDrg64GiBV1":    abi.RegisteredSealProof_StackedDrg64GiBV1,
+	"StackedDrg2KiBV1_1":   abi.RegisteredSealProof_StackedDrg2KiBV1_1,
+	"StackedDrg8MiBV1_1":   abi.RegisteredSealProof_StackedDrg8MiBV1_1,
+	"StackedDrg512MiBV1_1": abi.RegisteredSealProof_StackedDrg512MiBV1_1,
+	"StackedDrg32GiBV1_1":  abi.RegisteredSealProof_StackedDrg32GiBV1_1,
+	"StackedDrg64GiBV1_1":  abi.RegisteredSealProof_StackedDrg64GiBV1_1,
+}
+
+// sealCommitPhase1OutputInfo holds the fields of a serialized phase 1 output
+// which identify the sector and randomness it was generated for.
+type sealCommitPhase1OutputInfo struct {
+	proofType abi.RegisteredSealProof
+	CommR     [32]byte `json:"comm_r"`
+	CommD     [32]byte `json:"comm_d"`
+	ReplicaID [32]byte `json:"replica_id"`
+	Seed      [32]byte `json:"seed"`
+	Ticket    [32]byte `json:"ticket"`
+}
+
+// parseSealCommitPhase1Output reads the sector fields of the serialized phase 1
+// output of size bytes in r. Only the start and the end of the output are
+// read: the proof type is its first field, and the other fields follow the
+// vanilla proofs.
+func parseSealCommitPhase1Output(r io.ReaderAt, size int64) (sealCommitPhase1OutputInfo, error) {
+	dec := json.NewDecoder(io.NewSectionReader(r, 0, size))
+	var key json.Token
+	if tok, err := dec.Token(); err == nil && tok == json.Delim('{') {
+		key, _ = dec.Token()
+	}
+	var proofName string
+	if key != "registered_proof" || dec.Decode(&proofName) != nil {
+		return sealCommitPhase1OutputInfo{}, errors.New("invalid seal commit phase 1 output: missing proof type")
+	}
+
+	proofType, ok := sealProofTypesByName[proofName]
+	if !ok {
+		return sealCommitPhase1OutputInfo{}, errors.Wrapf(ErrUnsupportedProofType, "seal commit phase 1 output for %s", proofName)
+	}
+
+	tailSize := size
+	if tailSize > maxSealCommitPhase1OutputTailBytes {
+		tailSize = maxSealCommitPhase1OutputTailBytes
+	}
+	tail := make([]byte, tailSize)
+	if _, err := r.ReadAt(tail, size-tailSize); err != nil && err != io.EOF {
+		return sealCommitPhase1OutputInfo{}, errors.Wrap(err, "failed to read seal commit phase 1 output")
+	}
+
+	info := sealCommitPhase1OutputInfo{proofType: proofType}
+	start := bytes.LastIndex(tail, []byte(`"comm_r":`))
+	if start < 0 || json.Unmarshal(append([]byte("{"), tail[start:]...), &info) != nil {
+		return sealCommitPhase1OutputInfo{}, errors.New("invalid seal commit phase 1 output: corrupted or truncated")
+	}
+
+	return info, nil
+}
+
+// checkSealCommitPhase1Output checks that the serialized phase 1 output of size
+// bytes in r is for a supported proof type and that its replica ID is the one
+// of the sector, and returns its sector fields.
+func checkSealCommitPhase1Output(r io.ReaderAt, size int64, sectorNum abi.SectorNumber, minerID abi.ActorID) (sealCommitPhase1OutputInfo, error) {
+	info, err := parseSealCommitPhase1Output(r, size)
+	if err != nil {
+		return sealCommitPhase1OutputInfo{}, err
+	}
+
+	proverID, err := toProverID(minerID)
+	if err != nil {
+		return sealCommitPhase1OutputInfo{}, err
+	}
+
+	if replicaID(proverID.Inner, sectorNum, info.Ticket, info.CommD, porepID(info.proofType)) != info.ReplicaID {
+		return sealCommitPhase1OutputInfo{}, errors.Wrapf(ErrSealCommitPhase1OutputMismatch, "output does not match sector %d of miner %d", sectorNum, minerID)
+	}
+
+	return info, nil
+}
+
+// replicaID computes the replica ID the proofs library seals a sector with:
+// the SHA-256 digest of the prover ID, the big endian sector number, the
+// ticket, CommD and the PoRep seed of the proof type, see PoRepID, truncated
+// to a field element.
+func replicaID(proverID [32]byte, sectorNum abi.SectorNumber, ticket, commD, porepSeed [32]byte) [32]byte {
+	var sectorID [8]byte
+	binary.BigEndian.PutUint64(sectorID[:], uint64(sectorNum))
+
+	h := sha256.New()
+	for _, b := range [][]byte{proverID[:], sectorID[:], ticket[:], commD[:], porepSeed[:]} {
+		h.Write(b)
+	}
+
+	var id [32]byte
+	copy(id[:], h.Sum(nil))
+	// the two most significant bits of the little endian field element are
+	// cleared, as with commitments
+	id[31] &= 0x3f
+
+	return id
+}
+
+// TODO AggregateSealProofs it only needs InteractiveRandomness out of the aggregateInfo.Infos
+func AggregateSealProofs(aggregateInfo proof5.AggregateSealVerifyProofAndInfos, proofs [][]byte) (out []byte, err error) {
+	sp, err := toFilRegisteredSealProof(aggregateInfo.SealProof)
+	if err != nil {
+		return nil, err
+	}
+
+	commRs := make([]generated.Fil32ByteArray, len(aggregateInfo.Infos))
+	seeds := make([]generated.Fil32ByteArray, len(aggregateInfo.Infos))
+	for i, info := range aggregateInfo.Infos {
+		seeds[i] = to32ByteArray(info.InteractiveRandomness)
+		commRs[i], err = to32ByteCommR(info.SealedCID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pfs := make([]generated.FilSealCommitPhase2Response, len(proofs))
+	for i := range proofs {
+		pfs[i] = generated.FilSealCommitPhase2Response{
+			ProofPtr: proofs[i],
+			ProofLen: uint(len(proofs[i])),
+		}
+	}
+
+	rap, err := toFilRegisteredAggregationProof(aggregateInfo.AggregateProof)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := generated.FilAggregateSealProofs(sp, rap, commRs, uint(len(commRs)), seeds, uint(len(seeds)), pfs, uint(len(pfs)))
+	resp.Deref()
+
+	defer generated.FilDestroyAggregateProof(resp)
+
+	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
+		return nil, errors.New(generated.RawString(resp.ErrorMsg).Copy())
+	}
+
+	return copyBytes(resp.ProofPtr, resp.ProofLen), nil
+}
+
+// unsealConcurrency is the number of threads unsealing decodes with, 0 for
+// the default
+var unsealConcurrency int64
+
+// SetUnsealConcurrency sets the number of threads the proofs library spreads
+// the decoding of unsealed data over to n, which applies to the unsealing calls
+// started afterwards. A value of 0, the default, uses the thread pool shared
+// with the rest of the library, which has a thread per core. An error is
+// returned if n is negative.
+func SetUnsealConcurrency(n int) error {
+	if n < 0 {
+		return errors.Errorf("unseal concurrency must not be negative, got %d", n)
+	}
+
+	atomic.StoreInt64(&unsealConcurrency, int64(n))
+	return nil
+}
+
+// Unseal
+func Unseal(
+	proofType abi.RegisteredSealProof,
+	cacheDirPath string,
+	sealedSector *os.File,
+	unsealOutput *os.File,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+	ticket abi.SealRandomness,
+	unsealedCID cid.Cid,
+) error {
+	sectorSize, err := proofType.SectorSize()
+	if err != nil {
+		return err
+	}
+
+	unpaddedBytesAmount := abi.PaddedPieceSize(sectorSize).Unpadded()
 
 	return UnsealRange(proofType, cacheDirPath, sealedSector, unsealOutput, sectorNum, minerID, ticket, unsealedCID, 0, uint64(unpaddedBytesAmount))
 }
@@ -535,37 +1934,495 @@ func UnsealRange(
 	minerID abi.ActorID,
 	ticket abi.SealRandomness,
 	unsealedCID cid.Cid,
-	unpaddedByteIndex uint64,
-	unpaddedBytesAmount uint64,
+	unpaddedByteIndex uint64,
+	unpaddedBytesAmount uint64,
+) error {
+	sp, err := toFilRegisteredSealProof(proofType)
+	if err != nil {
+		return err
+	}
+
+	proverID, err := toProverID(minerID)
+	if err != nil {
+		return err
+	}
+
+	commD, err := to32ByteCommD(unsealedCID)
+	if err != nil {
+		return err
+	}
+
+	sealedSectorFd := sealedSector.Fd()
+	defer runtime.KeepAlive(sealedSector)
+
+	unsealOutputFd := unsealOutput.Fd()
+	defer runtime.KeepAlive(unsealOutput)
+
+	resp := generated.FilUnsealRange(sp, cacheDirPath, int32(sealedSectorFd), int32(unsealOutputFd), uint64(sectorNum), proverID, to32ByteArray(ticket), commD, unpaddedByteIndex, unpaddedBytesAmount, uint(atomic.LoadInt64(&unsealConcurrency)))
+	resp.Deref()
+
+	defer generated.FilDestroyUnsealRangeResponse(resp)
+
+	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
+		return errors.New(generated.RawString(resp.ErrorMsg).Copy())
+	}
+
+	return nil
+}
+
+// UnsealCtx behaves like Unseal, but stops unsealing and returns ctx.Err() if
+// ctx is done before the sector is unsealed. See UnsealRangeCtx.
+func UnsealCtx(
+	ctx context.Context,
+	proofType abi.RegisteredSealProof,
+	cacheDirPath string,
+	sealedSector *os.File,
+	unsealOutput *os.File,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+	ticket abi.SealRandomness,
+	unsealedCID cid.Cid,
+) error {
+	sectorSize, err := proofType.SectorSize()
+	if err != nil {
+		return err
+	}
+
+	unpaddedBytesAmount := abi.PaddedPieceSize(sectorSize).Unpadded()
+
+	return UnsealRangeCtx(ctx, proofType, cacheDirPath, sealedSector, unsealOutput, sectorNum, minerID, ticket, unsealedCID, 0, uint64(unpaddedBytesAmount))
+}
+
+// UnsealRangeCtx behaves like UnsealRange, but returns ctx.Err() if ctx is
+// done before the range is unsealed. The native call cannot be interrupted, so
+// in programs which have called RunUnsealChild it is made in a child process,
+// which is killed when ctx is done; unsealOutput is then truncated back to the
+// offset it was written from. Otherwise ctx is only checked before unsealing.
+func UnsealRangeCtx(
+	ctx context.Context,
+	proofType abi.RegisteredSealProof,
+	cacheDirPath string,
+	sealedSector *os.File,
+	unsealOutput *os.File,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+	ticket abi.SealRandomness,
+	unsealedCID cid.Cid,
+	unpaddedByteIndex uint64,
+	unpaddedBytesAmount uint64,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if atomic.LoadInt32(&unsealChildEnabled) == 0 {
+		return UnsealRange(proofType, cacheDirPath, sealedSector, unsealOutput, sectorNum, minerID, ticket, unsealedCID, unpaddedByteIndex, unpaddedBytesAmount)
+	}
+
+	job, err := json.Marshal(unsealRangeJob{
+		ProofType:    proofType,
+		CacheDirPath: cacheDirPath,
+		SectorNum:    sectorNum,
+		MinerID:      minerID,
+		Ticket:       ticket,
+		UnsealedCID:  unsealedCID,
+		Offset:       unpaddedByteIndex,
+		Length:       unpaddedBytesAmount,
+		Concurrency:  atomic.LoadInt64(&unsealConcurrency),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to encode unseal job")
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return errors.Wrap(err, "failed to find the executable to unseal with")
+	}
+
+	start, err := unsealOutput.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errors.Wrap(err, "failed to get the unseal output offset")
+	}
+
+	errReader, errWriter, err := os.Pipe()
+	if err != nil {
+		return errors.Wrap(err, "failed to create unseal error pipe")
+	}
+	defer errReader.Close()
+
+	cmd := exec.CommandContext(ctx, executable)
+	cmd.Env = append(os.Environ(), unsealRangeChildEnv+"=1")
+	cmd.Stdin = bytes.NewReader(job)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.ExtraFiles = []*os.File{sealedSector, unsealOutput, errWriter}
+
+	err = cmd.Start()
+	errWriter.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to start unseal process")
+	}
+
+	// the pipe is closed once the child exits, including when it is killed
+	childErr, _ := ioutil.ReadAll(errReader)
+
+	if err := cmd.Wait(); err != nil {
+		if ctx.Err() != nil {
+			if err := unsealOutput.Truncate(start); err != nil {
+				return errors.Wrap(err, "failed to truncate unseal output of cancelled unseal")
+			}
+			if _, err := unsealOutput.Seek(start, io.SeekStart); err != nil {
+				return errors.Wrap(err, "failed to rewind unseal output of cancelled unseal")
+			}
+			return ctx.Err()
+		}
+		if len(childErr) > 0 {
+			return errors.New(string(childErr))
+		}
+		return errors.Wrap(err, "unseal process failed")
+	}
+
+	return nil
+}
+
+// unsealRangeChildEnv is set in the environment of the child processes of
+// UnsealRangeCtx, in which RunUnsealChild runs the unseal job read from stdin
+// rather than returning to the program.
+const unsealRangeChildEnv = "FIL_FFI_UNSEAL_RANGE_CHILD"
+
+// unsealChildEnabled is set once RunUnsealChild has been called
+var unsealChildEnabled int32
+
+// RunUnsealChild lets UnsealRangeCtx and UnsealCtx stop unsealing when their
+// context is done, by unsealing in child processes running the executable of
+// the program. A child process runs the program up to the call of
+// RunUnsealChild, which then unseals and exits, so it must be called at the
+// start of main, before anything with side effects.
+func RunUnsealChild() {
+	if os.Getenv(unsealRangeChildEnv) != "" {
+		os.Exit(runUnsealRangeJob())
+	}
+
+	atomic.StoreInt32(&unsealChildEnabled, 1)
+}
+
+// unsealRangeJob is the UnsealRange call a child process of UnsealRangeCtx
+// makes, with the sealed sector as file descriptor 3, the output as 4 and a
+// pipe for its error as 5.
+type unsealRangeJob struct {
+	ProofType    abi.RegisteredSealProof
+	CacheDirPath string
+	SectorNum    abi.SectorNumber
+	MinerID      abi.ActorID
+	Ticket       abi.SealRandomness
+	UnsealedCID  cid.Cid
+	Offset       uint64
+	Length       uint64
+	Concurrency  int64
+}
+
+// runUnsealRangeJob runs the job of a child process of UnsealRangeCtx and
+// returns its exit code
+func runUnsealRangeJob() int {
+	errPipe := os.NewFile(5, "unseal-error")
+	defer errPipe.Close()
+
+	var job unsealRangeJob
+	if err := json.NewDecoder(os.Stdin).Decode(&job); err != nil {
+		fmt.Fprintf(errPipe, "failed to decode unseal job: %s", err)
+		return 1
+	}
+	atomic.StoreInt64(&unsealConcurrency, job.Concurrency)
+
+	sealed := os.NewFile(3, "sealed-sector")
+	output := os.NewFile(4, "unseal-output")
+	if err := UnsealRange(job.ProofType, job.CacheDirPath, sealed, output, job.SectorNum, job.MinerID, job.Ticket, job.UnsealedCID, job.Offset, job.Length); err != nil {
+		fmt.Fprint(errPipe, err)
+		return 1
+	}
+
+	return 0
+}
+
+// unpaddedChunkBytes is the number of unpadded bytes which are padded to 128
+// bytes, which are the smallest ranges that can be unsealed
+const unpaddedChunkBytes = 127
+
+// UnsealRangeToWriter unseals length unpadded bytes of the sector starting at
+// offset, writing them to w as they are decoded. Only the nodes covering the
+// range are decoded, and the range may start or end in the middle of one. An
+// empty range writes nothing, and a range extending past the unsealed size of
+// the sector is an error. If sealed is not an *os.File, it is first copied to
+// a temporary file, as the proofs library reads the sealed sector from a path.
+func UnsealRangeToWriter(
+	proofType abi.RegisteredSealProof,
+	cacheDirPath string,
+	sealed io.ReaderAt,
+	w io.Writer,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+	ticket abi.SealRandomness,
+	unsealedCID cid.Cid,
+	offset, length uint64,
 ) error {
-	sp, err := toFilRegisteredSealProof(proofType)
+	sectorSize, err := proofType.SectorSize()
 	if err != nil {
 		return err
 	}
 
-	proverID, err := toProverID(minerID)
+	unsealedSize := uint64(abi.PaddedPieceSize(sectorSize).Unpadded())
+	if offset > unsealedSize || length > unsealedSize-offset {
+		return errors.Errorf("range of %d bytes at offset %d extends past the %d unsealed bytes of the sector", length, offset, unsealedSize)
+	}
+
+	if length == 0 {
+		return nil
+	}
+
+	sealedSector, cleanup, err := sealedSectorFile(sealed, sectorSize)
 	if err != nil {
 		return err
 	}
+	defer cleanup()
 
-	commD, err := to32ByteCommD(unsealedCID)
+	// unseal whole chunks, and only write the requested bytes of them
+	start := offset / unpaddedChunkBytes * unpaddedChunkBytes
+	end := (offset + length + unpaddedChunkBytes - 1) / unpaddedChunkBytes * unpaddedChunkBytes
+	if end > unsealedSize {
+		end = unsealedSize
+	}
+
+	pr, pw, err := os.Pipe()
 	if err != nil {
 		return err
 	}
 
-	sealedSectorFd := sealedSector.Fd()
-	defer runtime.KeepAlive(sealedSector)
+	copied := make(chan error, 1)
+	go func() {
+		defer pr.Close()
 
-	unsealOutputFd := unsealOutput.Fd()
-	defer runtime.KeepAlive(unsealOutput)
+		copied <- copyUnsealedRange(w, pr, offset-start, length)
 
-	resp := generated.FilUnsealRange(sp, cacheDirPath, int32(sealedSectorFd), int32(unsealOutputFd), uint64(sectorNum), proverID, to32ByteArray(ticket), commD, unpaddedByteIndex, unpaddedBytesAmount)
-	resp.Deref()
+		// drain what is left so that the native side never blocks writing
+		io.Copy(ioutil.Discard, pr)
+	}()
 
-	defer generated.FilDestroyUnsealRangeResponse(resp)
+	err = UnsealRange(proofType, cacheDirPath, sealedSector, pw, sectorNum, minerID, ticket, unsealedCID, start, end-start)
+	pw.Close()
 
-	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
-		return errors.New(generated.RawString(resp.ErrorMsg).Copy())
+	copyErr := <-copied
+	if err != nil {
+		return err
+	}
+
+	return copyErr
+}
+
+// Range is a range of the unpadded bytes of a sector.
+type Range struct {
+	Offset uint64
+	Length uint64
+}
+
+// UnsealRanges unseals several ranges of the sector, returning a reader over
+// the plaintext of each range in the order of ranges. The ranges are rounded
+// out to whole chunks, sorted and merged, then the span from the first to the
+// last merged range is unsealed in a single pass, with one call into the
+// proofs library, keeping only the bytes of the merged ranges. Each range
+// reads the same bytes UnsealRangeToWriter would write for it, and the same
+// rules apply to them.
+func UnsealRanges(
+	proofType abi.RegisteredSealProof,
+	cacheDirPath string,
+	sealed io.ReaderAt,
+	sectorNum abi.SectorNumber,
+	minerID abi.ActorID,
+	ticket abi.SealRandomness,
+	unsealedCID cid.Cid,
+	ranges []Range,
+) ([]io.Reader, error) {
+	sectorSize, err := proofType.SectorSize()
+	if err != nil {
+		return nil, err
+	}
+
+	unsealedSize := uint64(abi.PaddedPieceSize(sectorSize).Unpadded())
+	for idx, r := range ranges {
+		if r.Offset > unsealedSize || r.Length > unsealedSize-r.Offset {
+			return nil, errors.Errorf("range %d of %d bytes at offset %d extends past the %d unsealed bytes of the sector", idx, r.Length, r.Offset, unsealedSize)
+		}
+	}
+
+	merged := mergeUnsealRanges(ranges, unsealedSize)
+
+	unsealed := make([][]byte, len(merged))
+	if len(merged) > 0 {
+		sealedSector, cleanup, err := sealedSectorFile(sealed, sectorSize)
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
+
+		first, last := merged[0], merged[len(merged)-1]
+		collector := &rangeCollector{ranges: merged, out: unsealed, offset: first.Offset}
+		if err := unsealRangeToWriter(proofType, cacheDirPath, sealedSector, collector, sectorNum, minerID, ticket, unsealedCID, first.Offset, last.Offset+last.Length-first.Offset); err != nil {
+			return nil, err
+		}
+
+		for idx, m := range merged {
+			if uint64(len(unsealed[idx])) != m.Length {
+				return nil, errors.Errorf("unsealed %d of the %d bytes at offset %d", len(unsealed[idx]), m.Length, m.Offset)
+			}
+		}
+	}
+
+	readers := make([]io.Reader, len(ranges))
+	for idx, r := range ranges {
+		if r.Length == 0 {
+			readers[idx] = bytes.NewReader(nil)
+			continue
+		}
+
+		// the merged range holding r is the last one starting at or before it
+		m := sort.Search(len(merged), func(i int) bool {
+			return merged[i].Offset > r.Offset
+		}) - 1
+
+		start := r.Offset - merged[m].Offset
+		readers[idx] = bytes.NewReader(unsealed[m][start : start+r.Length])
+	}
+
+	return readers, nil
+}
+
+// unsealRangeToWriter is UnsealRangeToWriter, which tests replace to count the
+// calls into the proofs library.
+var unsealRangeToWriter = UnsealRangeToWriter
+
+// rangeCollector keeps the bytes written to it which fall into one of ranges,
+// which are sorted and disjoint, storing those of each range at its index in
+// out. offset is the offset in the sector of the next byte written.
+type rangeCollector struct {
+	ranges []Range
+	out    [][]byte
+	offset uint64
+	next   int
+}
+
+func (c *rangeCollector) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 && c.next < len(c.ranges) {
+		r := c.ranges[c.next]
+
+		// skip the gap up to the next range
+		if c.offset < r.Offset {
+			skip := r.Offset - c.offset
+			if skip > uint64(len(p)) {
+				skip = uint64(len(p))
+			}
+			p = p[skip:]
+			c.offset += skip
+			continue
+		}
+
+		take := r.Offset + r.Length - c.offset
+		if take > uint64(len(p)) {
+			take = uint64(len(p))
+		}
+		if c.out[c.next] == nil {
+			c.out[c.next] = make([]byte, 0, r.Length)
+		}
+		c.out[c.next] = append(c.out[c.next], p[:take]...)
+		p = p[take:]
+		c.offset += take
+
+		if c.offset == r.Offset+r.Length {
+			c.next++
+		}
+	}
+	c.offset += uint64(len(p))
+
+	return n, nil
+}
+
+// mergeUnsealRanges rounds the non-empty ranges out to whole chunks of an
+// unsealed sector of unsealedSize bytes, then sorts them and merges those which
+// overlap or are adjacent.
+func mergeUnsealRanges(ranges []Range, unsealedSize uint64) []Range {
+	var aligned []Range
+	for _, r := range ranges {
+		if r.Length == 0 {
+			continue
+		}
+
+		start := r.Offset / unpaddedChunkBytes * unpaddedChunkBytes
+		end := (r.Offset + r.Length + unpaddedChunkBytes - 1) / unpaddedChunkBytes * unpaddedChunkBytes
+		if end > unsealedSize {
+			end = unsealedSize
+		}
+
+		aligned = append(aligned, Range{Offset: start, Length: end - start})
+	}
+
+	sort.Slice(aligned, func(i, j int) bool {
+		return aligned[i].Offset < aligned[j].Offset
+	})
+
+	var merged []Range
+	for _, r := range aligned {
+		if len(merged) > 0 {
+			last := &merged[len(merged)-1]
+			if r.Offset <= last.Offset+last.Length {
+				if end := r.Offset + r.Length; end > last.Offset+last.Length {
+					last.Length = end - last.Offset
+				}
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+
+	return merged
+}
+
+// sealedSectorFile returns sealed if it is an *os.File, and otherwise copies
+// the sealed sector of sectorSize bytes it holds to a temporary file which
+// cleanup removes.
+func sealedSectorFile(sealed io.ReaderAt, sectorSize abi.SectorSize) (file *os.File, cleanup func(), err error) {
+	if f, ok := sealed.(*os.File); ok {
+		return f, func() {}, nil
+	}
+
+	staged, err := ioutil.TempFile("", "sealed-sector-")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create temporary sealed sector file")
+	}
+	cleanup = func() {
+		staged.Close()
+		os.Remove(staged.Name())
+	}
+
+	if _, err := io.Copy(staged, io.NewSectionReader(sealed, 0, int64(sectorSize))); err != nil {
+		cleanup()
+		return nil, nil, errors.Wrap(err, "failed to copy sealed sector to temporary file")
+	}
+
+	return staged, cleanup, nil
+}
+
+// copyUnsealedRange skips the first skip bytes of src, then copies the next
+// length bytes to dst
+func copyUnsealedRange(dst io.Writer, src io.Reader, skip, length uint64) error {
+	if _, err := io.CopyN(ioutil.Discard, src, int64(skip)); err != nil {
+		return errors.Wrap(err, "failed to read unsealed range")
+	}
+
+	n, err := io.CopyN(dst, src, int64(length))
+	if err == io.EOF {
+		return errors.Errorf("unsealed range is truncated: expected %d bytes, got %d", length, n)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to copy unsealed range")
 	}
 
 	return nil
@@ -651,11 +2508,106 @@ func GenerateWinningPoSt(
 	return proofs, nil
 }
 
+type windowPoStConfig struct {
+	maxFaults int
+}
+
+// WindowPoStOption configures how GenerateWindowPoSt generates a proof.
+type WindowPoStOption func(*windowPoStConfig)
+
+// WithFaultTolerance lets GenerateWindowPoSt succeed even if up to maxFaults
+// sectors cannot be read. The proof is then generated over the remaining
+// sectors, and the unreadable sectors are returned as faulty.
+func WithFaultTolerance(maxFaults int) WindowPoStOption {
+	return func(cfg *windowPoStConfig) {
+		cfg.maxFaults = maxFaults
+	}
+}
+
 // GenerateWindowPoSt
 func GenerateWindowPoSt(
 	minerID abi.ActorID,
 	privateSectorInfo SortedPrivateSectorInfo,
 	randomness abi.PoStRandomness,
+	opts ...WindowPoStOption,
+) ([]proof5.PoStProof, []abi.SectorNumber, error) {
+	var cfg windowPoStConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return generateWindowPoStTolerant(privateSectorInfo, cfg.maxFaults, PrivateSectorInfo.Validate, func(sectors SortedPrivateSectorInfo) ([]proof5.PoStProof, []abi.SectorNumber, error) {
+		return generateWindowPoSt(minerID, sectors, randomness)
+	})
+}
+
+// generateWindowPoStTolerant drops the sectors which fail validate, then calls
+// generate with the remaining ones, and retries without the sectors reported as
+// faulty as long as there are at most maxFaults of them in total.
+func generateWindowPoStTolerant(
+	sectors SortedPrivateSectorInfo,
+	maxFaults int,
+	validate func(PrivateSectorInfo) error,
+	generate func(SortedPrivateSectorInfo) ([]proof5.PoStProof, []abi.SectorNumber, error),
+) ([]proof5.PoStProof, []abi.SectorNumber, error) {
+	var allFaultySectors []abi.SectorNumber
+	var validateErr error
+	for _, s := range sectors.Values() {
+		if err := validate(s); err != nil {
+			allFaultySectors = append(allFaultySectors, s.SectorNumber)
+			if validateErr == nil {
+				validateErr = err
+			}
+		}
+	}
+
+	if validateErr != nil {
+		if len(allFaultySectors) == sectors.Len() || len(allFaultySectors) > maxFaults {
+			return nil, allFaultySectors, validateErr
+		}
+
+		unreadable := make(map[abi.SectorNumber]struct{}, len(allFaultySectors))
+		for _, sectorNum := range allFaultySectors {
+			unreadable[sectorNum] = struct{}{}
+		}
+		sectors = sectors.FilterFunc(func(s PrivateSectorInfo) bool {
+			_, ok := unreadable[s.SectorNumber]
+			return !ok
+		})
+	}
+
+	for {
+		proofs, faultySectors, err := generate(sectors)
+		if err == nil {
+			return proofs, append(allFaultySectors, faultySectors...), nil
+		}
+
+		faulty := make(map[abi.SectorNumber]struct{}, len(faultySectors))
+		for _, sectorNum := range faultySectors {
+			faulty[sectorNum] = struct{}{}
+		}
+		isFaulty := func(s PrivateSectorInfo) bool {
+			_, ok := faulty[s.SectorNumber]
+			return ok
+		}
+
+		newFaultySectors := sectors.FilterFunc(isFaulty)
+		remaining := sectors.FilterFunc(func(s PrivateSectorInfo) bool { return !isFaulty(s) })
+		if newFaultySectors.Len() == 0 || remaining.Len() == 0 || len(allFaultySectors)+newFaultySectors.Len() > maxFaults {
+			return nil, append(allFaultySectors, faultySectors...), err
+		}
+
+		for _, s := range newFaultySectors.Values() {
+			allFaultySectors = append(allFaultySectors, s.SectorNumber)
+		}
+		sectors = remaining
+	}
+}
+
+func generateWindowPoSt(
+	minerID abi.ActorID,
+	privateSectorInfo SortedPrivateSectorInfo,
+	randomness abi.PoStRandomness,
 ) ([]proof5.PoStProof, []abi.SectorNumber, error) {
 	filReplicas, filReplicasLen, free, err := toFilPrivateReplicaInfos(privateSectorInfo.Values(), "window")
 	if err != nil {
@@ -779,6 +2731,334 @@ func ClearCache(sectorSize uint64, cacheDirPath string) error {
 	return nil
 }
 
+// CacheKeepSet selects the files ClearCacheSelective keeps in a cache
+// directory. The SDR layers and tree_c are always removed.
+type CacheKeepSet struct {
+	// PoSt keeps p_aux, t_aux and tree_r_last, which are needed to generate
+	// PoSts.
+	PoSt bool
+	// TreeD keeps tree_d, from which unsealing can read the unsealed data
+	// instead of decoding the sealed sector.
+	TreeD bool
+	// SyntheticProofs keeps the synthetic PoRep vanilla proofs.
+	SyntheticProofs bool
+	// Force removes files ClearCacheSelective does not recognize, instead of
+	// refusing to clear the cache directory.
+	Force bool
+}
+
+// ClearedCache reports what ClearCacheSelective removed.
+type ClearedCache struct {
+	// Removed are the names of the removed files, relative to the cache
+	// directory.
+	Removed []string
+	// FreedBytes is the total size of the removed files.
+	FreedBytes uint64
+}
+
+// ErrUnrecognizedCacheFile is returned by ClearCacheSelective when the cache
+// directory contains files it does not recognize, unless forced to remove them.
+var ErrUnrecognizedCacheFile = errors.New("unrecognized file in cache directory")
+
+type cacheFileKind int
+
+const (
+	cacheFileUnrecognized cacheFileKind = iota
+	cacheFileSealing
+	cacheFilePoSt
+	cacheFileTreeD
+	cacheFileSyntheticProofs
+)
+
+// cacheFile classifies a file of the cache directory of a sector of the given
+// size by its name.
+func cacheFile(sectorSize abi.SectorSize, name string) cacheFileKind {
+	switch name {
+	case "p_aux", "t_aux":
+		return cacheFilePoSt
+	case "sc-02-data-tree-d.dat":
+		return cacheFileTreeD
+	case "syn-porep-vanilla-proofs.dat":
+		return cacheFileSyntheticProofs
+	}
+
+	for layer := 1; layer <= sdrLayers(sectorSize); layer++ {
+		if name == filepath.Base(sdrLayerPath("", layer)) {
+			return cacheFileSealing
+		}
+	}
+
+	isTree := func(tree string) bool {
+		if name == fmt.Sprintf("sc-02-data-%s.dat", tree) {
+			return true
+		}
+		for i := 0; i < sectorTrees(sectorSize); i++ {
+			if name == fmt.Sprintf("sc-02-data-%s-%d.dat", tree, i) {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case isTree("tree-c"):
+		return cacheFileSealing
+	case isTree("tree-r-last"):
+		return cacheFilePoSt
+	}
+
+	return cacheFileUnrecognized
+}
+
+// ClearCacheSelective removes the files of cacheDirPath which are not needed
+// anymore once the sector is committed, keeping those selected by keep. Unlike
+// ClearCache, it refuses to remove anything if the directory contains files it
+// does not recognize, unless keep.Force is set.
+func ClearCacheSelective(sectorSize uint64, cacheDirPath string, keep CacheKeepSet) (ClearedCache, error) {
+	entries, err := ioutil.ReadDir(cacheDirPath)
+	if err != nil {
+		return ClearedCache{}, xerrors.Errorf("failed to read cache directory: %w", err)
+	}
+
+	var remove, unrecognized []os.FileInfo
+	for _, entry := range entries {
+		switch cacheFile(abi.SectorSize(sectorSize), entry.Name()) {
+		case cacheFileSealing:
+			remove = append(remove, entry)
+		case cacheFilePoSt:
+			if !keep.PoSt {
+				remove = append(remove, entry)
+			}
+		case cacheFileTreeD:
+			if !keep.TreeD {
+				remove = append(remove, entry)
+			}
+		case cacheFileSyntheticProofs:
+			if !keep.SyntheticProofs {
+				remove = append(remove, entry)
+			}
+		default:
+			unrecognized = append(unrecognized, entry)
+		}
+	}
+
+	if len(unrecognized) > 0 {
+		if !keep.Force {
+			names := make([]string, len(unrecognized))
+			for i, entry := range unrecognized {
+				names[i] = entry.Name()
+			}
+			return ClearedCache{}, xerrors.Errorf("refusing to clear %s: %w: %s", cacheDirPath, ErrUnrecognizedCacheFile, strings.Join(names, ", "))
+		}
+		remove = append(remove, unrecognized...)
+	}
+
+	var cleared ClearedCache
+	for _, entry := range remove {
+		size, err := diskUsage(filepath.Join(cacheDirPath, entry.Name()), entry)
+		if err != nil {
+			return cleared, err
+		}
+
+		if err := os.RemoveAll(filepath.Join(cacheDirPath, entry.Name())); err != nil {
+			return cleared, xerrors.Errorf("failed to remove cache file: %w", err)
+		}
+
+		cleared.Removed = append(cleared.Removed, entry.Name())
+		cleared.FreedBytes += size
+	}
+
+	return cleared, nil
+}
+
+// diskUsage returns the total size of the file at path, or of the files below
+// it if it is a directory.
+func diskUsage(path string, info os.FileInfo) (uint64, error) {
+	if !info.IsDir() {
+		return uint64(info.Size()), nil
+	}
+
+	var size uint64
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += uint64(info.Size())
+		}
+		return nil
+	})
+	return size, err
+}
+
+// CacheReport is the result of CheckSectorCache.
+type CacheReport struct {
+	SectorNumber abi.SectorNumber
+	// Missing are the paths of the files needed for proving which do not
+	// exist.
+	Missing []string
+	// WrongSize are the paths of the files whose size does not match the
+	// sector size.
+	WrongSize []string
+	// CommRLastMismatch is true if comm_r_last in p_aux is not the root of
+	// tree_r_last.
+	CommRLastMismatch bool
+	// CommRMismatch is true if the Poseidon hash of comm_c and comm_r_last in
+	// p_aux is not the comm_r of the sealed CID.
+	CommRMismatch bool
+	// Provable is true if p_aux was checked against the sealed CID and no
+	// problem was found.
+	Provable bool
+}
+
+// CheckSectorCache checks that cacheDirPath and sealedPath hold the files
+// needed to generate PoSts of type proofType for the sector: that p_aux, t_aux
+// and every tree_r_last file exist, that their sizes and the size of the
+// replica match the sector size, that comm_c and comm_r_last in p_aux hash to
+// the comm_r of sealedCID, and, for sectors with a single tree_r_last, that
+// its root is comm_r_last. The roots of several tree_r_last files are not
+// checked against comm_r_last, as they are hashed together with Poseidon into
+// it. The replica is only stat'ed, and nothing is modified.
+//
+// Problems with the files are reported in the CacheReport; an error is only
+// returned if the files cannot be checked at all.
+func CheckSectorCache(proofType abi.RegisteredPoStProof, cacheDirPath, sealedPath string, sectorNum abi.SectorNumber, sealedCID cid.Cid) (CacheReport, error) {
+	metadata, err := PoStProofMetadata(proofType)
+	if err != nil {
+		return CacheReport{}, err
+	}
+	sectorSize := metadata.SectorSize
+
+	commR, err := to32ByteCommR(sealedCID)
+	if err != nil {
+		return CacheReport{}, err
+	}
+
+	report := CacheReport{SectorNumber: sectorNum}
+	stat := func(path string) (os.FileInfo, error) {
+		info, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			report.Missing = append(report.Missing, path)
+			return nil, nil
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("failed to check sector %d: %w", sectorNum, err)
+		}
+		return info, nil
+	}
+
+	if info, err := stat(sealedPath); err != nil {
+		return CacheReport{}, err
+	} else if info != nil && info.Size() != int64(sectorSize) {
+		report.WrongSize = append(report.WrongSize, sealedPath)
+	}
+
+	pAuxPath := filepath.Join(cacheDirPath, "p_aux")
+	if info, err := stat(pAuxPath); err != nil {
+		return CacheReport{}, err
+	} else if info != nil && info.Size() != 2*32 {
+		report.WrongSize = append(report.WrongSize, pAuxPath)
+	}
+
+	tAuxPath := filepath.Join(cacheDirPath, "t_aux")
+	if info, err := stat(tAuxPath); err != nil {
+		return CacheReport{}, err
+	} else if info != nil && info.Size() == 0 {
+		report.WrongSize = append(report.WrongSize, tAuxPath)
+	}
+
+	treePaths := []string{filepath.Join(cacheDirPath, "sc-02-data-tree-r-last.dat")}
+	if trees := sectorTrees(sectorSize); trees > 1 {
+		treePaths = make([]string, trees)
+		for i := range treePaths {
+			treePaths[i] = filepath.Join(cacheDirPath, fmt.Sprintf("sc-02-data-tree-r-last-%d.dat", i))
+		}
+	}
+	leaves := uint64(sectorSize) / 32 / uint64(len(treePaths))
+	for _, path := range treePaths {
+		info, err := stat(path)
+		if err != nil {
+			return CacheReport{}, err
+		}
+		if info != nil && !isTreeRLastSize(uint64(info.Size()), leaves) {
+			report.WrongSize = append(report.WrongSize, path)
+		}
+	}
+
+	if len(report.Missing) != 0 || len(report.WrongSize) != 0 {
+		return report, nil
+	}
+
+	pAux, err := ioutil.ReadFile(pAuxPath)
+	if err != nil {
+		return CacheReport{}, xerrors.Errorf("failed to check sector %d: %w", sectorNum, err)
+	}
+
+	// p_aux holding something else than field elements cannot match either
+	pAuxCommR, err := computeCommR(pAux[:32], pAux[32:])
+	report.CommRMismatch = err != nil || pAuxCommR != commR.Inner
+
+	if len(treePaths) == 1 {
+		report.CommRLastMismatch, err = commRLastMismatch(pAux[32:], treePaths[0])
+		if err != nil {
+			return CacheReport{}, xerrors.Errorf("failed to check sector %d: %w", sectorNum, err)
+		}
+	}
+
+	report.Provable = !report.CommRMismatch && !report.CommRLastMismatch
+	return report, nil
+}
+
+// computeCommR returns comm_r, the Poseidon hash of comm_c and comm_r_last.
+func computeCommR(commC, commRLast []byte) ([32]byte, error) {
+	resp := generated.FilComputeCommR(to32ByteArray(commC), to32ByteArray(commRLast))
+	resp.Deref()
+
+	defer generated.FilDestroyComputeCommRResponse(resp)
+
+	if resp.StatusCode != generated.FCPResponseStatusFCPNoError {
+		return [32]byte{}, errors.New(generated.RawString(resp.ErrorMsg).Copy())
+	}
+
+	return resp.CommR, nil
+}
+
+// isTreeRLastSize returns true if size is the size of a tree_r_last file of
+// an octree with the given number of leaves. The proofs library only stores
+// the top rows of the tree, so the file holds all of its rows down to one of
+// them, the root being the last node.
+func isTreeRLastSize(size, leaves uint64) bool {
+	var nodes uint64
+	for row := uint64(1); row <= leaves; row *= 8 {
+		nodes += row
+		if size == nodes*32 {
+			return true
+		}
+	}
+	return false
+}
+
+// commRLastMismatch returns true if commRLast, the second half of p_aux, is not
+// the root at the end of the tree_r_last file.
+func commRLastMismatch(commRLast []byte, treePath string) (bool, error) {
+	tree, err := os.Open(treePath)
+	if err != nil {
+		return false, err
+	}
+	defer tree.Close()
+
+	info, err := tree.Stat()
+	if err != nil {
+		return false, err
+	}
+
+	root := make([]byte, 32)
+	if _, err := tree.ReadAt(root, info.Size()-32); err != nil {
+		return false, err
+	}
+
+	return !bytes.Equal(commRLast, root), nil
+}
+
 func FauxRep(proofType abi.RegisteredSealProof, cacheDirPath string, sealedSectorPath string) (cid.Cid, error) {
 	sp, err := toFilRegisteredSealProof(proofType)
 	if err != nil {
@@ -885,6 +3165,10 @@ func toFilPublicReplicaInfos(src []proof5.SectorInfo, typ string) ([]generated.F
 }
 
 func toFilPrivateReplicaInfo(src PrivateSectorInfo) (generated.FilPrivateReplicaInfo, func(), error) {
+	if err := src.Validate(); err != nil {
+		return generated.FilPrivateReplicaInfo{}, func() {}, err
+	}
+
 	commR, err := to32ByteCommR(src.SealedCID)
 	if err != nil {
 		return generated.FilPrivateReplicaInfo{}, func() {}, err
@@ -912,6 +3196,10 @@ func toFilPrivateReplicaInfos(src []PrivateSectorInfo, typ string) ([]generated.
 	out := make([]generated.FilPrivateReplicaInfo, len(src))
 
 	for idx := range out {
+		if err := src[idx].Validate(); err != nil {
+			return nil, 0, func() {}, err
+		}
+
 		commR, err := to32ByteCommR(src[idx].SealedCID)
 		if err != nil {
 			return nil, 0, func() {}, err
@@ -1102,7 +3390,7 @@ func toFilRegisteredSealProof(p abi.RegisteredSealProof) (generated.FilRegistere
 	case abi.RegisteredSealProof_StackedDrg64GiBV1_1:
 		return generated.FilRegisteredSealProofStackedDrg64GiBV11, nil
 	default:
-		return 0, errors.Errorf("no mapping to C.FFIRegisteredSealProof value available for: %v", p)
+		return 0, errors.Errorf("no mapping to C.FFIRegisteredSealProof value available for seal proof type %d", p)
 	}
 }
 
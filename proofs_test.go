@@ -2,23 +2,42 @@ package ffi
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/iotest"
+	"time"
 
 	"github.com/filecoin-project/filecoin-ffi/generated"
+	"github.com/ipfs/go-cid"
 
 	"github.com/stretchr/testify/assert"
 
 	commcid "github.com/filecoin-project/go-fil-commcid"
 
 	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/specs-actors/actors/runtime/proof"
+	builtin5 "github.com/filecoin-project/specs-actors/v5/actors/builtin"
+	proof5 "github.com/filecoin-project/specs-actors/v5/actors/runtime/proof"
 
 	"github.com/stretchr/testify/require"
 )
 
+func TestMain(m *testing.M) {
+	RunUnsealChild()
+	os.Exit(m.Run())
+}
+
 func TestRegisteredSealProofFunctions(t *testing.T) {
 	WorkflowRegisteredSealProofFunctions(newTestingTeeHelper(t))
 }
@@ -31,6 +50,26 @@ func TestProofsLifecycle(t *testing.T) {
 	WorkflowProofsLifecycle(newTestingTeeHelper(t))
 }
 
+func TestProveSectorZero(t *testing.T) {
+	WorkflowProveSectorZero(newTestingTeeHelper(t))
+}
+
+func TestWindowPoStMissingReplica(t *testing.T) {
+	WorkflowWindowPoStMissingReplica(newTestingTeeHelper(t))
+}
+
+func TestWindowPoStParallelSealedCIDOrder(t *testing.T) {
+	WorkflowWindowPoStParallelSealedCIDOrder(newTestingTeeHelper(t))
+}
+
+func TestConcurrentSealScratchDirs(t *testing.T) {
+	WorkflowConcurrentSealScratchDirs(newTestingTeeHelper(t))
+}
+
+func TestStagedSectorBuilder(t *testing.T) {
+	WorkflowStagedSectorBuilder(newTestingTeeHelper(t))
+}
+
 func TestGetGPUDevicesDoesNotProduceAnError(t *testing.T) {
 	WorkflowGetGPUDevicesDoesNotProduceAnError(newTestingTeeHelper(t))
 }
@@ -43,11 +82,1213 @@ func TestGenerateWinningPoStSectorChallengeEdgeCase(t *testing.T) {
 	WorkflowGenerateWinningPoStSectorChallengeEdgeCase(newTestingTeeHelper(t))
 }
 
+func TestVerifyAggregateSealsWithContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	isValid, err := VerifyAggregateSealsWithContext(ctx, proof5.AggregateSealVerifyProofAndInfos{})
+	require.Equal(t, context.Canceled, err)
+	require.False(t, isValid)
+
+	// errors from the underlying verification are passed through
+	isValid, err = VerifyAggregateSealsWithContext(context.Background(), proof5.AggregateSealVerifyProofAndInfos{})
+	require.Error(t, err)
+	require.False(t, isValid)
+}
+
+func TestVerifyWinningPoStWithTimeout(t *testing.T) {
+	unblock := make(chan struct{})
+	defer close(unblock)
+
+	isValid, err := verifyWithTimeout(time.Millisecond, func() (bool, error) {
+		<-unblock
+		return true, nil
+	})
+	require.Equal(t, ErrVerificationTimeout, err)
+	require.False(t, isValid)
+
+	isValid, err = verifyWithTimeout(time.Minute, func() (bool, error) {
+		return true, nil
+	})
+	require.NoError(t, err)
+	require.True(t, isValid)
+
+	// the default timeout leaves the verification enough time to complete
+	isValid, err = VerifyWinningPoStWithTimeout(0, proof5.WinningPoStVerifyInfo{})
+	require.NotEqual(t, ErrVerificationTimeout, err)
+	require.False(t, isValid)
+}
+
+func TestSetUnsealConcurrency(t *testing.T) {
+	defer SetUnsealConcurrency(0)
+
+	require.Error(t, SetUnsealConcurrency(-1))
+	require.NoError(t, SetUnsealConcurrency(8))
+	assert.Equal(t, int64(8), atomic.LoadInt64(&unsealConcurrency))
+
+	// a rejected value leaves the setting alone
+	require.Error(t, SetUnsealConcurrency(-8))
+	assert.Equal(t, int64(8), atomic.LoadInt64(&unsealConcurrency))
+}
+
+func TestToFilRegisteredSealProof(t *testing.T) {
+	seen := map[generated.FilRegisteredSealProof]abi.RegisteredSealProof{}
+	for proofType := range abi.SealProofInfos {
+		sp, err := toFilRegisteredSealProof(proofType)
+		require.NoError(t, err, "seal proof type %d", proofType)
+
+		other, ok := seen[sp]
+		require.False(t, ok, "seal proof types %d and %d map to the same value", proofType, other)
+		seen[sp] = proofType
+	}
+
+	for _, unknown := range []abi.RegisteredSealProof{-1, abi.RegisteredSealProof(len(abi.SealProofInfos)), 1000} {
+		_, err := toFilRegisteredSealProof(unknown)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("seal proof type %d", unknown))
+	}
+}
+
+func TestMergeUnsealRanges(t *testing.T) {
+	for _, tc := range []struct {
+		ranges   []Range
+		expected []Range
+	}{
+		{nil, nil},
+		{[]Range{{Offset: 10, Length: 0}}, nil},
+		{[]Range{{Offset: 0, Length: 127}}, []Range{{Offset: 0, Length: 127}}},
+		// ranges are rounded out to whole chunks
+		{[]Range{{Offset: 100, Length: 500}}, []Range{{Offset: 0, Length: 635}}},
+		{[]Range{{Offset: 2031, Length: 1}}, []Range{{Offset: 1905, Length: 127}}},
+		// overlapping, adjacent and disjoint ranges, in any order
+		{
+			[]Range{{Offset: 1016, Length: 10}, {Offset: 0, Length: 200}, {Offset: 150, Length: 20}, {Offset: 254, Length: 127}},
+			[]Range{{Offset: 0, Length: 381}, {Offset: 1016, Length: 127}},
+		},
+		{
+			[]Range{{Offset: 0, Length: 2032}, {Offset: 500, Length: 1}},
+			[]Range{{Offset: 0, Length: 2032}},
+		},
+	} {
+		assert.Equal(t, tc.expected, mergeUnsealRanges(tc.ranges, 2032))
+	}
+}
+
+func TestUnsealRangesSinglePass(t *testing.T) {
+	unseal := unsealRangeToWriter
+	defer func() { unsealRangeToWriter = unseal }()
+
+	// the fake plaintext of a sector tells the offset of each byte, and is
+	// written in pieces which do not line up with the ranges
+	plaintext := func(offset uint64) byte { return byte(offset % 251) }
+
+	var calls []Range
+	unsealRangeToWriter = func(proofType abi.RegisteredSealProof, cacheDirPath string, sealed io.ReaderAt, w io.Writer, sectorNum abi.SectorNumber, minerID abi.ActorID, ticket abi.SealRandomness, unsealedCID cid.Cid, offset, length uint64) error {
+		calls = append(calls, Range{Offset: offset, Length: length})
+		for start := offset; start < offset+length; start += 100 {
+			end := start + 100
+			if end > offset+length {
+				end = offset + length
+			}
+
+			buf := make([]byte, 0, end-start)
+			for o := start; o < end; o++ {
+				buf = append(buf, plaintext(o))
+			}
+			if _, err := w.Write(buf); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	ranges := []Range{{Offset: 1016, Length: 10}, {Offset: 0, Length: 200}, {Offset: 150, Length: 20}, {Offset: 254, Length: 127}, {Offset: 5, Length: 0}}
+	readers, err := UnsealRanges(abi.RegisteredSealProof_StackedDrg2KiBV1, "", bytes.NewReader(make([]byte, 2048)), 1, 1000, abi.SealRandomness{}, cid.Undef, ranges)
+	require.NoError(t, err)
+
+	// a single call over the span of the merged ranges
+	assert.Equal(t, []Range{{Offset: 0, Length: 1143}}, calls)
+
+	require.Len(t, readers, len(ranges))
+	for idx, r := range ranges {
+		actual, err := ioutil.ReadAll(readers[idx])
+		require.NoError(t, err)
+
+		expected := make([]byte, 0, r.Length)
+		for o := r.Offset; o < r.Offset+r.Length; o++ {
+			expected = append(expected, plaintext(o))
+		}
+		assert.Equal(t, expected, actual, "range %d", idx)
+	}
+}
+
+func TestVerifyAggregateSealsTypedErrors(t *testing.T) {
+	_, err := VerifyAggregateSeals(proof5.AggregateSealVerifyProofAndInfos{})
+	require.True(t, errors.Is(err, ErrBadSectorCount))
+
+	var verr *ProofVerificationError
+	require.True(t, errors.As(err, &verr))
+	assert.Equal(t, ErrBadSectorCount, verr.Kind)
+
+	commR, err := commcid.ReplicaCommitmentV1ToCID(make([]byte, 32))
+	require.NoError(t, err)
+
+	commD, err := commcid.DataCommitmentV1ToCID(make([]byte, 32))
+	require.NoError(t, err)
+
+	aggregate := proof5.AggregateSealVerifyProofAndInfos{
+		Miner:          abi.ActorID(1000),
+		SealProof:      abi.RegisteredSealProof_StackedDrg2KiBV1_1,
+		AggregateProof: abi.RegisteredAggregationProof_SnarkPackV1,
+		Proof:          []byte{1, 2, 3},
+		Infos: []proof5.AggregateSealVerifyInfo{
+			{Number: 1, SealedCID: commR, UnsealedCID: commD},
+			{Number: 2, SealedCID: commR, UnsealedCID: commD},
+			{Number: 3, SealedCID: commR, UnsealedCID: commD},
+			{Number: 4, SealedCID: commR, UnsealedCID: commD},
+		},
+	}
+
+	t.Run("bad aggregate size", func(t *testing.T) {
+		for _, n := range []int{1, MinAggregatedProofs - 1, MaxAggregatedProofs + 1} {
+			bad := aggregate
+			bad.Infos = make([]proof5.AggregateSealVerifyInfo, n)
+			for i := range bad.Infos {
+				bad.Infos[i] = aggregate.Infos[0]
+			}
+
+			_, err := VerifyAggregateSeals(bad)
+			require.True(t, errors.Is(err, ErrAggregateSize))
+			require.True(t, errors.Is(err, ErrBadSectorCount))
+		}
+	})
+
+	t.Run("unsupported proof type", func(t *testing.T) {
+		unsupported := aggregate
+		unsupported.SealProof = abi.RegisteredSealProof(-1)
+
+		_, err := VerifyAggregateSeals(unsupported)
+		require.True(t, errors.Is(err, ErrUnsupportedProofType))
+	})
+
+	t.Run("malformed commitment", func(t *testing.T) {
+		malformed := aggregate
+		malformed.Infos = append([]proof5.AggregateSealVerifyInfo{}, aggregate.Infos...)
+		malformed.Infos[2].UnsealedCID = commR
+
+		_, err := VerifyAggregateSeals(malformed)
+		require.True(t, errors.Is(err, ErrProofInvalid))
+		require.True(t, errors.As(err, &verr))
+		assert.Contains(t, verr.Msg, "seal verify info 2")
+	})
+
+	t.Run("verifier failure", func(t *testing.T) {
+		isValid, err := VerifyAggregateSeals(aggregate)
+		require.False(t, isValid)
+		require.True(t, errors.Is(err, ErrVerifierFailure))
+
+		// the message reported by the FFI is kept
+		require.True(t, errors.As(err, &verr))
+		assert.NotEmpty(t, verr.Msg)
+	})
+}
+
+func TestConcurrencyLimiter(t *testing.T) {
+	l := newConcurrencyLimiter(2)
+
+	var lk sync.Mutex
+	active, maxActive := 0, 0
+
+	entered := make(chan struct{})
+	proceed := make(chan struct{})
+
+	const workers = 20
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			l.acquire()
+			defer l.release()
+
+			lk.Lock()
+			active++
+			if active > maxActive {
+				maxActive = active
+			}
+			lk.Unlock()
+
+			// hold the slot until told to proceed
+			entered <- struct{}{}
+			<-proceed
+
+			lk.Lock()
+			active--
+			lk.Unlock()
+		}()
+	}
+
+	// a worker is only let go once another one has entered, so two of them
+	// always hold a slot at once while the others wait for one
+	<-entered
+	for i := 1; i < workers; i++ {
+		<-entered
+		proceed <- struct{}{}
+	}
+	proceed <- struct{}{}
+	wg.Wait()
+
+	assert.Equal(t, 2, maxActive)
+
+	// raising the limit wakes up waiting callers
+	l.acquire()
+	l.acquire()
+
+	acquired := make(chan struct{})
+	go func() {
+		l.acquire()
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("acquired past the limit")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	l.setLimit(0)
+	<-acquired
+}
+
+func TestNewFFIVerificationError(t *testing.T) {
+	err := newFFIVerificationError("Rust panic: no unwind information")
+	assert.True(t, errors.Is(err, ErrFFIPanic))
+	assert.Contains(t, err.Error(), "no unwind information")
+
+	err = newFFIVerificationError("failed to read parameter file")
+	assert.True(t, errors.Is(err, ErrVerifierFailure))
+	assert.False(t, errors.Is(err, ErrFFIPanic))
+	assert.False(t, errors.Is(err, ErrProofInvalid))
+}
+
+func TestValidateSealedCID(t *testing.T) {
+	spt := abi.RegisteredSealProof_StackedDrg2KiBV1_1
+
+	var commR [32]byte
+	_, err := io.ReadFull(rand.Reader, commR[:])
+	require.NoError(t, err)
+
+	sealedCID, err := commcid.ReplicaCommitmentV1ToCID(commR[:])
+	require.NoError(t, err)
+	require.NoError(t, ValidateSealedCID(sealedCID, spt))
+	require.NoError(t, ValidateSealedCID(sealedCID, abi.RegisteredSealProof_StackedDrg32GiBV1))
+
+	unsealedCID, err := commcid.DataCommitmentV1ToCID(commR[:])
+	require.NoError(t, err)
+	assert.True(t, errors.Is(ValidateSealedCID(unsealedCID, spt), commcid.ErrIncorrectCodec))
+
+	// a sealed CID hashed with sha2-256 (0x12)
+	sha256CID, err := cid.Prefix{Version: 1, Codec: cid.FilCommitmentSealed, MhType: 0x12, MhLength: -1}.Sum(commR[:])
+	require.NoError(t, err)
+	assert.True(t, errors.Is(ValidateSealedCID(sha256CID, spt), commcid.ErrIncorrectHash))
+
+	// a raw block hashed with sha2-256
+	rawCID, err := cid.Prefix{Version: 1, Codec: cid.Raw, MhType: 0x12, MhLength: -1}.Sum(commR[:])
+	require.NoError(t, err)
+	assert.True(t, errors.Is(ValidateSealedCID(rawCID, spt), commcid.ErrIncorrectCodec))
+
+	assert.Error(t, ValidateSealedCID(cid.Undef, spt))
+
+	assert.True(t, errors.Is(ValidateSealedCID(sealedCID, abi.RegisteredSealProof(1234)), ErrUnsupportedProofType))
+}
+
+func TestSealPreCommitPhase1FromReader(t *testing.T) {
+	spt := abi.RegisteredSealProof_StackedDrg2KiBV1
+	minerID := abi.ActorID(42)
+	sectorNum := abi.SectorNumber(42)
+	ticket := abi.SealRandomness{5, 4, 2}
+
+	sectorDir, err := ioutil.TempDir("", "sector")
+	require.NoError(t, err)
+	defer os.RemoveAll(sectorDir)
+
+	data := make([]byte, abi.PaddedPieceSize(2048).Unpadded())
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+
+	pieceFile, err := ioutil.TempFile(sectorDir, "piece")
+	require.NoError(t, err)
+	defer pieceFile.Close()
+	_, err = pieceFile.Write(data)
+	require.NoError(t, err)
+	_, err = pieceFile.Seek(0, 0)
+	require.NoError(t, err)
+
+	stagedSectorFile, err := ioutil.TempFile(sectorDir, "staged")
+	require.NoError(t, err)
+	defer stagedSectorFile.Close()
+
+	_, pieceCID, err := WriteWithoutAlignment(spt, pieceFile, abi.UnpaddedPieceSize(len(data)), stagedSectorFile)
+	require.NoError(t, err)
+	pieces := []abi.PieceInfo{{Size: abi.PaddedPieceSize(2048), PieceCID: pieceCID}}
+
+	seal := func(name string, pc1 func(cacheDirPath, sealedSectorPath string) ([]byte, error)) (cid.Cid, cid.Cid, error) {
+		cacheDirPath := filepath.Join(sectorDir, name+"-cache")
+		require.NoError(t, os.Mkdir(cacheDirPath, 0755))
+		sealedSectorPath := filepath.Join(sectorDir, name+"-sealed")
+		require.NoError(t, ioutil.WriteFile(sealedSectorPath, nil, 0644))
+
+		phase1Output, err := pc1(cacheDirPath, sealedSectorPath)
+		if err != nil {
+			return cid.Undef, cid.Undef, err
+		}
+		return SealPreCommitPhase2(phase1Output, cacheDirPath, sealedSectorPath)
+	}
+
+	wantSealedCID, wantUnsealedCID, err := seal("path", func(cacheDirPath, sealedSectorPath string) ([]byte, error) {
+		return SealPreCommitPhase1(spt, cacheDirPath, stagedSectorFile.Name(), sealedSectorPath, sectorNum, minerID, ticket, pieces)
+	})
+	require.NoError(t, err)
+
+	fromReader := func(r io.Reader) func(cacheDirPath, sealedSectorPath string) ([]byte, error) {
+		return func(cacheDirPath, sealedSectorPath string) ([]byte, error) {
+			return SealPreCommitPhase1FromReader(spt, cacheDirPath, r, sealedSectorPath, sectorNum, minerID, ticket, pieces)
+		}
+	}
+
+	sealedCID, unsealedCID, err := seal("reader", fromReader(iotest.OneByteReader(bytes.NewReader(data))))
+	require.NoError(t, err)
+	assert.Equal(t, wantSealedCID, sealedCID)
+	assert.Equal(t, wantUnsealedCID, unsealedCID)
+
+	_, _, err = seal("short", fromReader(bytes.NewReader(data[:len(data)-1])))
+	assert.Error(t, err)
+
+	_, _, err = seal("long", fromReader(io.MultiReader(bytes.NewReader(data), bytes.NewReader([]byte{0}))))
+	assert.Error(t, err)
+
+	// the staged sector files were cleaned up
+	leftovers, err := filepath.Glob(filepath.Join(sectorDir, "staged-sector-*"))
+	require.NoError(t, err)
+	assert.Empty(t, leftovers)
+}
+
+func TestWriteFailedFirst(t *testing.T) {
+	pr, pw, err := os.Pipe()
+	require.NoError(t, err)
+	defer pw.Close()
+	require.NoError(t, pr.Close())
+
+	_, closedErr := pw.Write([]byte{0})
+	require.Error(t, closedErr)
+
+	writeErr := errors.New("write failed")
+	assert.True(t, writeFailedFirst(closedErr, writeErr))
+	assert.True(t, writeFailedFirst(io.ErrClosedPipe, writeErr))
+
+	// the read failing first is the cause of the write failing
+	assert.False(t, writeFailedFirst(errors.New("expected 2032 bytes, got 2031"), writeErr))
+	assert.False(t, writeFailedFirst(closedErr, nil))
+}
+
+func TestSealPreCommitPhase1Resume(t *testing.T) {
+	spt := abi.RegisteredSealProof_StackedDrg2KiBV1
+	minerID := abi.ActorID(42)
+	sectorNum := abi.SectorNumber(42)
+	ticket := abi.SealRandomness{5, 4, 2}
+
+	sectorDir, err := ioutil.TempDir("", "sector")
+	require.NoError(t, err)
+	defer os.RemoveAll(sectorDir)
+
+	pieceFile, err := ioutil.TempFile(sectorDir, "piece")
+	require.NoError(t, err)
+	defer pieceFile.Close()
+	_, err = io.CopyN(pieceFile, rand.Reader, int64(abi.PaddedPieceSize(2048).Unpadded()))
+	require.NoError(t, err)
+	_, err = pieceFile.Seek(0, 0)
+	require.NoError(t, err)
+
+	stagedSectorFile, err := ioutil.TempFile(sectorDir, "staged")
+	require.NoError(t, err)
+	defer stagedSectorFile.Close()
+
+	_, pieceCID, err := WriteWithoutAlignment(spt, pieceFile, abi.PaddedPieceSize(2048).Unpadded(), stagedSectorFile)
+	require.NoError(t, err)
+	pieces := []abi.PieceInfo{{Size: abi.PaddedPieceSize(2048), PieceCID: pieceCID}}
+
+	cacheDirPath := filepath.Join(sectorDir, "cache")
+	require.NoError(t, os.Mkdir(cacheDirPath, 0755))
+	sealedSectorPath := filepath.Join(sectorDir, "sealed")
+	require.NoError(t, ioutil.WriteFile(sealedSectorPath, nil, 0644))
+
+	var lk sync.Mutex
+	var progress [][2]int
+	returned := false
+	onLayer := func(layer, totalLayers int) {
+		lk.Lock()
+		defer lk.Unlock()
+		assert.False(t, returned, "progress reported after return")
+		progress = append(progress, [2]int{layer, totalLayers})
+	}
+
+	phase1Output, skipped, err := SealPreCommitPhase1Resume(spt, cacheDirPath, stagedSectorFile.Name(), sealedSectorPath, sectorNum, minerID, ticket, pieces, WithLayerProgress(onLayer))
+	lk.Lock()
+	returned = true
+	lk.Unlock()
+	require.NoError(t, err)
+	assert.Equal(t, 0, skipped)
+	require.NotEmpty(t, progress)
+	assert.Equal(t, [2]int{2, 2}, progress[len(progress)-1])
+
+	resume := func() int {
+		resumedOutput, skipped, err := SealPreCommitPhase1Resume(spt, cacheDirPath, stagedSectorFile.Name(), sealedSectorPath, sectorNum, minerID, ticket, pieces)
+		require.NoError(t, err)
+		assert.Equal(t, phase1Output, resumedOutput)
+		return skipped
+	}
+
+	assert.Equal(t, 2, resume())
+
+	// a truncated layer is recomputed
+	require.NoError(t, os.Truncate(sdrLayerPath(cacheDirPath, 2), 1000))
+	assert.Equal(t, 1, resume())
+
+	// layers after a missing one are recomputed
+	require.NoError(t, os.Remove(sdrLayerPath(cacheDirPath, 1)))
+	assert.Equal(t, 0, resume())
+
+	type treeProgress struct {
+		stage   SealPreCommitPhase2Stage
+		percent int
+	}
+	var stages []treeProgress
+	_, _, err = SealPreCommitPhase2(phase1Output, cacheDirPath, sealedSectorPath, WithTreeProgress(func(stage SealPreCommitPhase2Stage, percent int) {
+		stages = append(stages, treeProgress{stage, percent})
+	}))
+	require.NoError(t, err)
+	require.NotEmpty(t, stages)
+	assert.Equal(t, treeProgress{SealPreCommitPhase2CommR, 100}, stages[len(stages)-1])
+	for i := 1; i < len(stages); i++ {
+		prev, cur := stages[i-1], stages[i]
+		assert.True(t, cur.stage > prev.stage || (cur.stage == prev.stage && cur.percent > prev.percent), "progress %v after %v", cur, prev)
+	}
+
+	// without a callback the sealed sector is not even looked at before
+	// calling into the proofs library
+	_, _, err = SealPreCommitPhase2(phase1Output, cacheDirPath, filepath.Join(sectorDir, "missing"), WithTreeProgress(nil))
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "failed to stat sealed sector")
+}
+
+func TestPrepareSDRLayers(t *testing.T) {
+	spt := abi.RegisteredSealProof_StackedDrg2KiBV1
+
+	cacheDirPath, err := ioutil.TempDir("", "cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDirPath)
+
+	writeLayer := func(layer, size int) {
+		require.NoError(t, ioutil.WriteFile(sdrLayerPath(cacheDirPath, layer), make([]byte, size), 0644))
+	}
+	layerExists := func(layer int) bool {
+		_, err := os.Stat(sdrLayerPath(cacheDirPath, layer))
+		return err == nil
+	}
+
+	complete, err := prepareSDRLayers(spt, cacheDirPath)
+	require.NoError(t, err)
+	assert.Equal(t, 0, complete)
+
+	writeLayer(1, 2048)
+	writeLayer(2, 2048)
+	complete, err = prepareSDRLayers(spt, cacheDirPath)
+	require.NoError(t, err)
+	assert.Equal(t, 2, complete)
+	assert.True(t, layerExists(1))
+	assert.True(t, layerExists(2))
+
+	writeLayer(2, 1024)
+	complete, err = prepareSDRLayers(spt, cacheDirPath)
+	require.NoError(t, err)
+	assert.Equal(t, 1, complete)
+	assert.True(t, layerExists(1))
+	assert.False(t, layerExists(2))
+
+	writeLayer(1, 4096)
+	writeLayer(2, 2048)
+	complete, err = prepareSDRLayers(spt, cacheDirPath)
+	require.NoError(t, err)
+	assert.Equal(t, 0, complete)
+	assert.False(t, layerExists(1))
+	assert.False(t, layerExists(2))
+
+	_, err = prepareSDRLayers(abi.RegisteredSealProof(1234), cacheDirPath)
+	assert.True(t, errors.Is(err, ErrUnsupportedProofType))
+}
+
+func TestWatchSDRLayers(t *testing.T) {
+	interval := layerProgressInterval
+	layerProgressInterval = time.Millisecond
+	defer func() {
+		layerProgressInterval = interval
+	}()
+
+	cacheDirPath, err := ioutil.TempDir("", "cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDirPath)
+
+	progress := make(chan int, 10)
+	stop := watchSDRLayers(cacheDirPath, 2048, func(layer, totalLayers int) {
+		assert.Equal(t, 2, totalLayers)
+		progress <- layer
+	})
+
+	require.NoError(t, ioutil.WriteFile(sdrLayerPath(cacheDirPath, 1), make([]byte, 2048), 0644))
+	assert.Equal(t, 1, <-progress)
+
+	// a partially written layer is not reported
+	require.NoError(t, ioutil.WriteFile(sdrLayerPath(cacheDirPath, 2), make([]byte, 1024), 0644))
+	time.Sleep(10 * time.Millisecond)
+	assert.Empty(t, progress)
+
+	// layers completed just before stopping are still reported
+	require.NoError(t, ioutil.WriteFile(sdrLayerPath(cacheDirPath, 2), make([]byte, 2048), 0644))
+	stop()
+	stop()
+	close(progress)
+
+	var reported []int
+	for layer := range progress {
+		reported = append(reported, layer)
+	}
+	assert.Equal(t, []int{2}, reported)
+}
+
+func TestSectorTrees(t *testing.T) {
+	for _, tc := range []struct {
+		sectorSize abi.SectorSize
+		trees      int
+	}{
+		{2 << 10, 1},
+		{8 << 20, 1},
+		{512 << 20, 1},
+		{32 << 30, 8},
+		{64 << 30, 16},
+	} {
+		assert.Equal(t, tc.trees, sectorTrees(tc.sectorSize), "sector size %d", tc.sectorSize)
+	}
+}
+
+func TestWatchPreCommitPhase2Trees(t *testing.T) {
+	interval := layerProgressInterval
+	layerProgressInterval = time.Millisecond
+	defer func() {
+		layerProgressInterval = interval
+	}()
+
+	cacheDirPath, err := ioutil.TempDir("", "cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDirPath)
+
+	touch := func(name string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(cacheDirPath, name), nil, 0644))
+	}
+
+	type treeProgress struct {
+		stage   SealPreCommitPhase2Stage
+		percent int
+	}
+	// 4 of the 8 sub-trees of a 32GiB sector are built
+	for i := 0; i < 5; i++ {
+		touch(fmt.Sprintf("sc-02-data-tree-c-%d.dat", i))
+	}
+
+	progress := make(chan treeProgress, 10)
+	stop := watchPreCommitPhase2Trees(cacheDirPath, 32<<30, func(stage SealPreCommitPhase2Stage, percent int) {
+		progress <- treeProgress{stage, percent}
+	})
+	assert.Equal(t, treeProgress{SealPreCommitPhase2TreeC, 50}, <-progress)
+
+	touch("sc-02-data-tree-r-last-0.dat")
+	assert.Equal(t, treeProgress{SealPreCommitPhase2TreeC, 100}, <-progress)
+	assert.Equal(t, treeProgress{SealPreCommitPhase2TreeRLast, 0}, <-progress)
+
+	// stages completed just before stopping are still reported
+	touch("p_aux")
+	stop()
+	close(progress)
+
+	var reported []treeProgress
+	for p := range progress {
+		reported = append(reported, p)
+	}
+	assert.Equal(t, []treeProgress{{SealPreCommitPhase2TreeRLast, 100}, {SealPreCommitPhase2CommR, 100}}, reported)
+
+	assert.Equal(t, "tree_r_last", SealPreCommitPhase2TreeRLast.String())
+}
+
+type mapSectorPathProvider map[abi.SectorNumber]string
+
+func (m mapSectorPathProvider) CacheDirPath(sectorNum abi.SectorNumber) (string, error) {
+	path, ok := m[sectorNum]
+	if !ok {
+		return "", fmt.Errorf("sector %d not found", sectorNum)
+	}
+	return filepath.Join(path, "cache"), nil
+}
+
+func (m mapSectorPathProvider) SealedSectorPath(sectorNum abi.SectorNumber) (string, error) {
+	path, ok := m[sectorNum]
+	if !ok {
+		return "", fmt.Errorf("sector %d not found", sectorNum)
+	}
+	return filepath.Join(path, "sealed"), nil
+}
+
+func TestPublicToPrivateSectorInfo(t *testing.T) {
+	info := func(n abi.SectorNumber, sealed string) PublicSectorInfo {
+		c, err := commcid.ReplicaCommitmentV1ToCID(bytes.Repeat([]byte(sealed), 32))
+		require.NoError(t, err)
+		return PublicSectorInfo{PoStProofType: abi.RegisteredPoStProof_StackedDrgWindow2KiBV1, SealedCID: c, SectorNum: n}
+	}
+	pub := NewSortedPublicSectorInfo(info(1, "b"), info(2, "a"), info(3, "c"))
+
+	private, err := PublicToPrivateSectorInfo(pub, mapSectorPathProvider{1: "/one", 2: "/two", 3: "/three"})
+	require.NoError(t, err)
+	assert.Equal(t, SortBySealedCID, private.Key())
+
+	values := private.Values()
+	require.Len(t, values, 3)
+	for i, v := range values {
+		p := pub.Values()[i]
+		assert.Equal(t, p.SectorNum, v.SectorNumber)
+		assert.True(t, p.SealedCID.Equals(v.SealedCID))
+		assert.Equal(t, p.PoStProofType, v.PoStProofType)
+	}
+	assert.Equal(t, "/two/cache", values[0].CacheDirPath)
+	assert.Equal(t, "/two/sealed", values[0].SealedSectorPath)
+
+	// every failing path is reported
+	_, err = PublicToPrivateSectorInfo(pub, mapSectorPathProvider{2: "/two"})
+	var pathErr *SectorPathError
+	require.True(t, errors.As(err, &pathErr))
+	assert.Len(t, pathErr.Errors, 4)
+	assert.Contains(t, err.Error(), "cache directory of sector 1")
+	assert.Contains(t, err.Error(), "sealed sector path of sector 3")
+}
+
+func TestPoStProofMetadata(t *testing.T) {
+	for proofType, info := range abi.PoStProofInfos {
+		metadata, err := PoStProofMetadata(proofType)
+		require.NoError(t, err, proofType)
+		assert.Equal(t, info.SectorSize, metadata.SectorSize, proofType)
+
+		if policy, ok := builtin5.PoStProofPolicies[proofType]; ok {
+			assert.Equal(t, policy.WindowPoStPartitionSectors, metadata.MaxPartitionSectors, proofType)
+		} else {
+			assert.Equal(t, uint64(1), metadata.MaxPartitionSectors, proofType)
+		}
+	}
+
+	metadata, err := PoStProofMetadata(abi.RegisteredPoStProof_StackedDrgWindow32GiBV1)
+	require.NoError(t, err)
+	assert.Equal(t, "StackedDrgWindow32GiBV1", metadata.Name)
+
+	_, err = PoStProofMetadata(abi.RegisteredPoStProof(-1))
+	assert.Error(t, err)
+}
+
+func TestClearCacheSelective(t *testing.T) {
+	cacheDirPath, err := ioutil.TempDir("", "cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDirPath)
+
+	files := []string{
+		"sc-02-data-layer-1.dat",
+		"sc-02-data-layer-2.dat",
+		"sc-02-data-tree-c.dat",
+		"sc-02-data-tree-d.dat",
+		"sc-02-data-tree-r-last.dat",
+		"p_aux",
+		"t_aux",
+	}
+	for _, name := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(cacheDirPath, name), make([]byte, 10), 0644))
+	}
+	require.NoError(t, ioutil.WriteFile(filepath.Join(cacheDirPath, "notes.txt"), make([]byte, 3), 0644))
+
+	_, err = ClearCacheSelective(2048, cacheDirPath, CacheKeepSet{PoSt: true})
+	require.True(t, errors.Is(err, ErrUnrecognizedCacheFile))
+	assert.Contains(t, err.Error(), "notes.txt")
+
+	remaining, err := ioutil.ReadDir(cacheDirPath)
+	require.NoError(t, err)
+	assert.Len(t, remaining, len(files)+1, "nothing is removed when refusing")
+
+	cleared, err := ClearCacheSelective(2048, cacheDirPath, CacheKeepSet{PoSt: true, Force: true})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"sc-02-data-layer-1.dat",
+		"sc-02-data-layer-2.dat",
+		"sc-02-data-tree-c.dat",
+		"sc-02-data-tree-d.dat",
+		"notes.txt",
+	}, cleared.Removed)
+	assert.Equal(t, uint64(43), cleared.FreedBytes)
+
+	for _, name := range []string{"sc-02-data-tree-r-last.dat", "p_aux", "t_aux"} {
+		_, err := os.Stat(filepath.Join(cacheDirPath, name))
+		assert.NoError(t, err, name)
+	}
+
+	cleared, err = ClearCacheSelective(2048, cacheDirPath, CacheKeepSet{})
+	require.NoError(t, err)
+	assert.Len(t, cleared.Removed, 3)
+	assert.Equal(t, uint64(30), cleared.FreedBytes)
+}
+
+func TestCheckSectorCache(t *testing.T) {
+	cacheDirPath, err := ioutil.TempDir("", "cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDirPath)
+
+	write := func(path string, b []byte) {
+		require.NoError(t, ioutil.WriteFile(path, b, 0644))
+	}
+
+	// a 2KiB sector has a single tree_r_last of 64 leaves, stored in full
+	commRLast := bytes.Repeat([]byte{7}, 32)
+	treePath := filepath.Join(cacheDirPath, "sc-02-data-tree-r-last.dat")
+	write(treePath, append(make([]byte, (64+8)*32), commRLast...))
+	pAuxPath := filepath.Join(cacheDirPath, "p_aux")
+	write(pAuxPath, append(make([]byte, 32), commRLast...))
+	sealedPath := filepath.Join(cacheDirPath, "sealed")
+	write(sealedPath, make([]byte, 2048))
+
+	commR, err := computeCommR(make([]byte, 32), commRLast)
+	require.NoError(t, err)
+	sealedCID, err := commcid.ReplicaCommitmentV1ToCID(commR[:])
+	require.NoError(t, err)
+
+	report, err := CheckSectorCache(abi.RegisteredPoStProof_StackedDrgWindow2KiBV1, cacheDirPath, sealedPath, 1, sealedCID)
+	require.NoError(t, err)
+	assert.False(t, report.Provable)
+	assert.Equal(t, []string{filepath.Join(cacheDirPath, "t_aux")}, report.Missing)
+
+	write(filepath.Join(cacheDirPath, "t_aux"), []byte{1})
+	report, err = CheckSectorCache(abi.RegisteredPoStProof_StackedDrgWindow2KiBV1, cacheDirPath, sealedPath, 1, sealedCID)
+	require.NoError(t, err)
+	assert.True(t, report.Provable, "%+v", report)
+
+	// p_aux of another sector
+	otherSealedCID, err := commcid.ReplicaCommitmentV1ToCID(make([]byte, 32))
+	require.NoError(t, err)
+	report, err = CheckSectorCache(abi.RegisteredPoStProof_StackedDrgWindow2KiBV1, cacheDirPath, sealedPath, 1, otherSealedCID)
+	require.NoError(t, err)
+	assert.True(t, report.CommRMismatch)
+	assert.False(t, report.CommRLastMismatch)
+	assert.False(t, report.Provable)
+
+	write(pAuxPath, make([]byte, 64))
+	report, err = CheckSectorCache(abi.RegisteredPoStProof_StackedDrgWindow2KiBV1, cacheDirPath, sealedPath, 1, sealedCID)
+	require.NoError(t, err)
+	assert.True(t, report.CommRLastMismatch)
+	assert.True(t, report.CommRMismatch)
+	assert.False(t, report.Provable)
+
+	// p_aux holding values out of the field
+	write(pAuxPath, bytes.Repeat([]byte{0xff}, 64))
+	report, err = CheckSectorCache(abi.RegisteredPoStProof_StackedDrgWindow2KiBV1, cacheDirPath, sealedPath, 1, sealedCID)
+	require.NoError(t, err)
+	assert.True(t, report.CommRMismatch)
+	assert.False(t, report.Provable)
+
+	// a truncated tree and replica
+	write(treePath, make([]byte, 2000))
+	write(sealedPath, make([]byte, 1024))
+	report, err = CheckSectorCache(abi.RegisteredPoStProof_StackedDrgWindow2KiBV1, cacheDirPath, sealedPath, 1, sealedCID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{sealedPath, treePath}, report.WrongSize)
+	assert.False(t, report.CommRLastMismatch)
+	assert.False(t, report.Provable)
+
+	// a 32GiB sector expects 8 tree_r_last files
+	report, err = CheckSectorCache(abi.RegisteredPoStProof_StackedDrgWindow32GiBV1, cacheDirPath, sealedPath, 1, sealedCID)
+	require.NoError(t, err)
+	assert.Len(t, report.Missing, 8)
+
+	_, err = CheckSectorCache(abi.RegisteredPoStProof(-1), cacheDirPath, sealedPath, 1, sealedCID)
+	assert.Error(t, err)
+}
+
+func TestCheckSealCommitPhase1Output(t *testing.T) {
+	minerID := abi.ActorID(42)
+	sectorNum := abi.SectorNumber(7)
+	ticket := [32]byte{5, 4, 2}
+	commD := [32]byte{1, 2, 3}
+
+	proverID, err := toProverID(minerID)
+	require.NoError(t, err)
+
+	output := func(proofName string, replicaID [32]byte) []byte {
+		b, err := json.Marshal(struct {
+			RegisteredProof string      `json:"registered_proof"`
+			VanillaProofs   interface{} `json:"vanilla_proofs"`
+			CommR           [32]byte    `json:"comm_r"`
+			CommD           [32]byte    `json:"comm_d"`
+			ReplicaID       [32]byte    `json:"replica_id"`
+			Seed            [32]byte    `json:"seed"`
+			Ticket          [32]byte    `json:"ticket"`
+		}{
+			RegisteredProof: proofName,
+			VanillaProofs:   map[string]interface{}{"comm_r": make([]byte, 8192), "comm_r_last": 1},
+			CommD:           commD,
+			ReplicaID:       replicaID,
+			Ticket:          ticket,
+		})
+		require.NoError(t, err)
+		return b
+	}
+	check := func(b []byte, sectorNum abi.SectorNumber) error {
+		_, err := checkSealCommitPhase1Output(bytes.NewReader(b), int64(len(b)), sectorNum, minerID)
+		return err
+	}
+
+	id := replicaID(proverID.Inner, sectorNum, ticket, commD, porepID(abi.RegisteredSealProof_StackedDrg2KiBV1_1))
+	valid := output("StackedDrg2KiBV1_1", id)
+	require.NoError(t, check(valid, sectorNum))
+
+	info, err := checkSealCommitPhase1Output(bytes.NewReader(valid), int64(len(valid)), sectorNum, minerID)
+	require.NoError(t, err)
+	assert.Equal(t, abi.RegisteredSealProof_StackedDrg2KiBV1_1, info.proofType)
+	assert.Equal(t, commD, info.CommD)
+	assert.Equal(t, ticket, info.Ticket)
+
+	// the replica ID is a field element
+	assert.Zero(t, id[31]&0xc0)
+
+	assert.True(t, errors.Is(check(valid, sectorNum+1), ErrSealCommitPhase1OutputMismatch))
+	assert.True(t, errors.Is(check(output("StackedDrg2KiBV1", id), sectorNum), ErrSealCommitPhase1OutputMismatch))
+	assert.True(t, errors.Is(check(output("StackedDrg1KiBV1", id), sectorNum), ErrUnsupportedProofType))
+
+	assert.Error(t, check(valid[:len(valid)-10], sectorNum))
+	assert.Error(t, check(valid[:100], sectorNum))
+	assert.Error(t, check(nil, sectorNum))
+	assert.Error(t, check([]byte(`{"vanilla_proofs":{}}`), sectorNum))
+}
+
+func TestValidatePoStRandomness(t *testing.T) {
+	randomness := make(abi.PoStRandomness, PoStRandomnessBytes)
+	_, err := rand.Read(randomness)
+	require.NoError(t, err)
+	randomness[0] |= 1
+
+	require.NoError(t, ValidatePoStRandomness(randomness))
+
+	for _, invalid := range []abi.PoStRandomness{
+		nil,
+		randomness[:PoStRandomnessBytes-1],
+		append(append(abi.PoStRandomness{}, randomness...), 0),
+		make(abi.PoStRandomness, PoStRandomnessBytes),
+	} {
+		assert.True(t, errors.Is(ValidatePoStRandomness(invalid), ErrInvalidPoStRandomness), "randomness %x", invalid)
+	}
+
+	require.NoError(t, ValidatePoStRandomnessRound(randomness, 100, 100))
+	require.NoError(t, ValidatePoStRandomnessRound(randomness, 101, 100))
+	assert.True(t, errors.Is(ValidatePoStRandomnessRound(randomness, 99, 100), ErrInvalidPoStRandomness))
+	assert.True(t, errors.Is(ValidatePoStRandomnessRound(nil, 101, 100), ErrInvalidPoStRandomness))
+}
+
+func TestGenerateWindowPoStTolerant(t *testing.T) {
+	var infos []PrivateSectorInfo
+	for i := 1; i <= 5; i++ {
+		infos = append(infos, PrivateSectorInfo{SectorInfo: proof.SectorInfo{SectorNumber: abi.SectorNumber(i)}})
+	}
+	sectors := NewSortedPrivateSectorInfo(infos...)
+	validSector := func(PrivateSectorInfo) error { return nil }
+
+	// generate fails with every unreadable sector it is given, one at a time
+	fakeGenerate := func(unreadable ...abi.SectorNumber) (func(SortedPrivateSectorInfo) ([]proof.PoStProof, []abi.SectorNumber, error), *[]int) {
+		var calls []int
+		return func(sectors SortedPrivateSectorInfo) ([]proof.PoStProof, []abi.SectorNumber, error) {
+			calls = append(calls, sectors.Len())
+			for _, sectorNum := range unreadable {
+				if sectors.Contains(sectorNum) {
+					return nil, []abi.SectorNumber{sectorNum}, errors.New("faulty sector")
+				}
+			}
+			return []proof.PoStProof{{ProofBytes: []byte{byte(sectors.Len())}}}, nil, nil
+		}, &calls
+	}
+
+	generate, calls := fakeGenerate()
+	proofs, faultySectors, err := generateWindowPoStTolerant(sectors, 0, validSector, generate)
+	require.NoError(t, err)
+	assert.Empty(t, faultySectors)
+	assert.Equal(t, []proof.PoStProof{{ProofBytes: []byte{5}}}, proofs)
+	assert.Equal(t, []int{5}, *calls)
+
+	generate, calls = fakeGenerate(4, 2)
+	_, faultySectors, err = generateWindowPoStTolerant(sectors, 0, validSector, generate)
+	require.Error(t, err)
+	assert.Equal(t, []abi.SectorNumber{4}, faultySectors)
+	assert.Equal(t, []int{5}, *calls)
+
+	generate, calls = fakeGenerate(4, 2)
+	proofs, faultySectors, err = generateWindowPoStTolerant(sectors, 2, validSector, generate)
+	require.NoError(t, err)
+	assert.Equal(t, []abi.SectorNumber{4, 2}, faultySectors)
+	assert.Equal(t, []proof.PoStProof{{ProofBytes: []byte{3}}}, proofs)
+	assert.Equal(t, []int{5, 4, 3}, *calls)
+
+	generate, _ = fakeGenerate(4, 2, 1)
+	_, faultySectors, err = generateWindowPoStTolerant(sectors, 2, validSector, generate)
+	require.Error(t, err)
+	assert.Equal(t, []abi.SectorNumber{4, 2, 1}, faultySectors)
+
+	// errors which do not name faulty sectors are not retried
+	_, _, err = generateWindowPoStTolerant(sectors, 2, validSector, func(SortedPrivateSectorInfo) ([]proof.PoStProof, []abi.SectorNumber, error) {
+		return nil, nil, errors.New("failed")
+	})
+	require.Error(t, err)
+
+	// a proof is never generated over no sectors at all
+	generate, calls = fakeGenerate(1, 2, 3, 4, 5)
+	_, _, err = generateWindowPoStTolerant(NewSortedPrivateSectorInfo(infos[0]), 1, validSector, generate)
+	require.Error(t, err)
+	assert.Equal(t, []int{1}, *calls)
+}
+
+func TestGenerateWindowPoStTolerantUnreadableSectors(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	sealedSector := filepath.Join(cacheDir, "sealed")
+	require.NoError(t, ioutil.WriteFile(sealedSector, make([]byte, 2048), 0644))
+
+	var infos []PrivateSectorInfo
+	for i := 1; i <= 3; i++ {
+		infos = append(infos, PrivateSectorInfo{
+			SectorInfo:       proof.SectorInfo{SectorNumber: abi.SectorNumber(i)},
+			CacheDirPath:     cacheDir,
+			PoStProofType:    abi.RegisteredPoStProof_StackedDrgWindow2KiBV1,
+			SealedSectorPath: sealedSector,
+		})
+	}
+	// the replica of sector 2 is missing
+	infos[1].SealedSectorPath = filepath.Join(cacheDir, "missing")
+	sectors := NewSortedPrivateSectorInfo(infos...)
+
+	var calls []SortedPrivateSectorInfo
+	generate := func(sectors SortedPrivateSectorInfo) ([]proof.PoStProof, []abi.SectorNumber, error) {
+		calls = append(calls, sectors)
+		return []proof.PoStProof{{ProofBytes: []byte{byte(sectors.Len())}}}, nil, nil
+	}
+
+	proofs, faultySectors, err := generateWindowPoStTolerant(sectors, 1, PrivateSectorInfo.Validate, generate)
+	require.NoError(t, err)
+	assert.Equal(t, []abi.SectorNumber{2}, faultySectors)
+	assert.Equal(t, []proof.PoStProof{{ProofBytes: []byte{2}}}, proofs)
+	require.Len(t, calls, 1)
+	assert.False(t, calls[0].Contains(2))
+
+	// without tolerance the missing replica fails the proof before generating
+	calls = nil
+	_, faultySectors, err = generateWindowPoStTolerant(sectors, 0, PrivateSectorInfo.Validate, generate)
+	require.Error(t, err)
+	assert.Equal(t, []abi.SectorNumber{2}, faultySectors)
+	assert.Empty(t, calls)
+}
+
+func TestGenerateWindowPoStParallelArguments(t *testing.T) {
+	sectors := NewSortedPrivateSectorInfo(PrivateSectorInfo{
+		SectorInfo:    proof.SectorInfo{SectorNumber: 1},
+		PoStProofType: abi.RegisteredPoStProof_StackedDrgWindow2KiBV1,
+	})
+
+	_, _, err := GenerateWindowPoStParallel(context.Background(), 1000, sectors, make([]byte, 32), 0)
+	require.Error(t, err)
+
+	_, _, err = GenerateWindowPoStParallel(context.Background(), 1000, NewSortedPrivateSectorInfo(), make([]byte, 32), 2)
+	require.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err = GenerateWindowPoStParallel(ctx, 1000, sectors, make([]byte, 32), 2)
+	require.Equal(t, context.Canceled, err)
+}
+
+func TestForEachParallel(t *testing.T) {
+	var lk sync.Mutex
+	seen := make(map[int]int)
+	require.NoError(t, forEachParallel(context.Background(), 3, 100, func(i int) {
+		lk.Lock()
+		defer lk.Unlock()
+		seen[i]++
+	}))
+
+	require.Len(t, seen, 100)
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, 1, seen[i])
+	}
+
+	// no more work is handed out once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := forEachParallel(ctx, 1, 100, func(i int) {
+		calls++
+		if i == 9 {
+			cancel()
+		}
+	})
+	require.Equal(t, context.Canceled, err)
+	assert.Equal(t, 10, calls)
+}
+
+func TestPooledAllocationManager(t *testing.T) {
+	small := NewPooledAllocationManager(32)
+	large := NewPooledAllocationManager(64)
+	assert.Equal(t, 32, small.BufSize())
+
+	a, b := small.Get(), small.Get()
+	require.Len(t, a, 32)
+	require.Len(t, b, 32)
+	require.Len(t, large.Get(), 64)
+
+	// outstanding buffers are distinct
+	a[0], b[0] = 1, 2
+	assert.Equal(t, byte(1), a[0])
+
+	small.Free()
+	large.Free()
+
+	// freed buffers are recycled at their own size only
+	for i := 0; i < 10; i++ {
+		assert.Len(t, small.Get(), 32)
+		assert.Len(t, large.Get(), 64)
+	}
+	small.Free()
+	large.Free()
+
+	// freeing twice is harmless
+	small.Free()
+
+	var _ AllocationManager = small
+
+	assert.Panics(t, func() {
+		NewPooledAllocationManager(0)
+	})
+}
+
+func BenchmarkPooledAllocationManager(b *testing.B) {
+	const bufSize = 192 << 10
+
+	b.Run("pooled", func(b *testing.B) {
+		m := NewPooledAllocationManager(bufSize)
+		for i := 0; i < b.N; i++ {
+			buf := m.Get()
+			buf[0] = byte(i)
+			m.Free()
+		}
+	})
+
+	b.Run("make", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			buf := make([]byte, bufSize)
+			buf[0] = byte(i)
+		}
+	})
+}
+
+func TestSortedPublicSectorInfoWithout(t *testing.T) {
+	var infos []PublicSectorInfo
+	for i := 0; i < 20; i++ {
+		var commR [32]byte
+		_, err := io.ReadFull(rand.Reader, commR[:])
+		require.NoError(t, err)
+
+		sealedCID, err := commcid.ReplicaCommitmentV1ToCID(commR[:])
+		require.NoError(t, err)
+
+		infos = append(infos, PublicSectorInfo{SectorNum: abi.SectorNumber(i), SealedCID: sealedCID})
+	}
+
+	sorted := NewSortedPublicSectorInfo(infos...)
+	faults := []abi.SectorNumber{17, 3, 12, 3, 100}
+
+	remaining := sorted.Without(faults)
+	require.Len(t, remaining.Values(), 17)
+
+	var expected []PublicSectorInfo
+	for _, info := range sorted.Values() {
+		if info.SectorNum != 3 && info.SectorNum != 12 && info.SectorNum != 17 {
+			expected = append(expected, info)
+		}
+	}
+	assert.Equal(t, expected, remaining.Values())
+
+	// the order of the faults is left alone
+	assert.Equal(t, []abi.SectorNumber{17, 3, 12, 3, 100}, faults)
+
+	// the original set is left alone
+	assert.Len(t, sorted.Values(), 20)
+
+	unchanged := sorted.Without(nil)
+	assert.Equal(t, sorted.Values(), unchanged.Values())
+}
+
+func TestSortedPublicSectorInfoUnion(t *testing.T) {
+	var infos []PublicSectorInfo
+	for i := 0; i < 10; i++ {
+		var commR [32]byte
+		_, err := io.ReadFull(rand.Reader, commR[:])
+		require.NoError(t, err)
+
+		sealedCID, err := commcid.ReplicaCommitmentV1ToCID(commR[:])
+		require.NoError(t, err)
+
+		infos = append(infos, PublicSectorInfo{SectorNum: abi.SectorNumber(i), SealedCID: sealedCID})
+	}
+
+	// sectors 4 to 6 are in both sets, with other sector numbers in other
+	s := NewSortedPublicSectorInfo(append([]PublicSectorInfo{}, infos[:7]...)...)
+	var otherInfos []PublicSectorInfo
+	for _, info := range infos[4:] {
+		if info.SectorNum <= 6 {
+			info.SectorNum += 100
+		}
+		otherInfos = append(otherInfos, info)
+	}
+	other := NewSortedPublicSectorInfo(otherInfos...)
+
+	union := s.Union(other)
+	expected := NewSortedPublicSectorInfo(append([]PublicSectorInfo{}, infos...)...)
+	assert.Equal(t, expected.Values(), union.Values())
+
+	// the union of a set with itself or an empty set is the set
+	for _, u := range []SortedPublicSectorInfo{
+		s.Union(s),
+		s.Union(SortedPublicSectorInfo{}),
+		SortedPublicSectorInfo{}.Union(s),
+	} {
+		assert.Equal(t, s.Values(), u.Values())
+	}
+
+	// the original sets are left alone
+	assert.Len(t, s.Values(), 7)
+	assert.Len(t, other.Values(), 6)
+}
+
 func TestJsonMarshalSymmetry(t *testing.T) {
 	for i := 0; i < 100; i++ {
-		xs := make([]publicSectorInfo, 10)
+		xs := make([]PublicSectorInfo, 10)
 		for j := 0; j < 10; j++ {
-			var x publicSectorInfo
+			var x PublicSectorInfo
 			var commR [32]byte
 			_, err := io.ReadFull(rand.Reader, commR[:])
 			require.NoError(t, err)
@@ -60,7 +1301,7 @@ func TestJsonMarshalSymmetry(t *testing.T) {
 			x.SectorNum = abi.SectorNumber(n.Uint64())
 			xs[j] = x
 		}
-		toSerialize := newSortedPublicSectorInfo(xs...)
+		toSerialize := NewSortedPublicSectorInfo(xs...)
 
 		serialized, err := toSerialize.MarshalJSON()
 		require.NoError(t, err)
@@ -73,6 +1314,646 @@ func TestJsonMarshalSymmetry(t *testing.T) {
 	}
 }
 
+func TestCBORMarshalSymmetry(t *testing.T) {
+	randomCommR := func() cid.Cid {
+		var commR [32]byte
+		_, err := io.ReadFull(rand.Reader, commR[:])
+		require.NoError(t, err)
+
+		c, err := commcid.ReplicaCommitmentV1ToCID(commR[:])
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("public", func(t *testing.T) {
+		xs := make([]PublicSectorInfo, 10)
+		for j := range xs {
+			xs[j] = PublicSectorInfo{
+				PoStProofType: abi.RegisteredPoStProof_StackedDrgWindow2KiBV1,
+				SealedCID:     randomCommR(),
+				SectorNum:     abi.SectorNumber(j),
+			}
+		}
+		toSerialize := NewSortedPublicSectorInfo(xs...)
+
+		var buf bytes.Buffer
+		require.NoError(t, toSerialize.MarshalCBOR(&buf))
+
+		var fromSerialized SortedPublicSectorInfo
+		require.NoError(t, fromSerialized.UnmarshalCBOR(&buf))
+		require.Equal(t, toSerialize, fromSerialized)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		xs := make([]PrivateSectorInfo, 10)
+		for j := range xs {
+			xs[j] = PrivateSectorInfo{
+				SectorInfo: proof.SectorInfo{
+					SealProof:    abi.RegisteredSealProof_StackedDrg2KiBV1,
+					SectorNumber: abi.SectorNumber(10 - j),
+					SealedCID:    randomCommR(),
+				},
+				CacheDirPath:     fmt.Sprintf("/cache/%d", j),
+				PoStProofType:    abi.RegisteredPoStProof_StackedDrgWindow2KiBV1,
+				SealedSectorPath: fmt.Sprintf("/sealed/%d", j),
+			}
+		}
+		toSerialize := NewSortedPrivateSectorInfo(xs...)
+
+		var buf bytes.Buffer
+		require.NoError(t, toSerialize.MarshalCBOR(&buf))
+
+		var fromSerialized SortedPrivateSectorInfo
+		require.NoError(t, fromSerialized.UnmarshalCBOR(&buf))
+		require.Equal(t, toSerialize, fromSerialized)
+	})
+
+	t.Run("unsorted input is sorted", func(t *testing.T) {
+		unsorted := SortedPrivateSectorInfo{f: []PrivateSectorInfo{
+			{SectorInfo: proof.SectorInfo{SectorNumber: 3, SealedCID: randomCommR()}},
+			{SectorInfo: proof.SectorInfo{SectorNumber: 1, SealedCID: randomCommR()}},
+			{SectorInfo: proof.SectorInfo{SectorNumber: 2, SealedCID: randomCommR()}},
+		}}
+
+		var buf bytes.Buffer
+		require.NoError(t, unsorted.MarshalCBOR(&buf))
+
+		var fromSerialized SortedPrivateSectorInfo
+		require.NoError(t, fromSerialized.UnmarshalCBOR(&buf))
+		require.Equal(t, NewSortedPrivateSectorInfo(unsorted.f...), fromSerialized)
+	})
+}
+
+func TestNewSortedPrivateSectorInfoRemovesDuplicates(t *testing.T) {
+	numbers := []abi.SectorNumber{7, 3, 7, 1, 3, 3, 9}
+
+	var infos []PrivateSectorInfo
+	for idx, n := range numbers {
+		infos = append(infos, PrivateSectorInfo{
+			SectorInfo:   proof.SectorInfo{SectorNumber: n},
+			CacheDirPath: fmt.Sprintf("/cache/%d", idx),
+		})
+	}
+
+	distinct := make(map[abi.SectorNumber]struct{})
+	for _, n := range numbers {
+		distinct[n] = struct{}{}
+	}
+
+	sorted := NewSortedPrivateSectorInfo(infos...)
+	values := sorted.Values()
+	require.Len(t, values, len(distinct))
+
+	for idx := range values {
+		if idx > 0 {
+			require.Less(t, uint64(values[idx-1].SectorNumber), uint64(values[idx].SectorNumber))
+		}
+	}
+
+	// the first occurrence of a sector number is the one that is kept
+	assert.Equal(t, "/cache/1", values[1].CacheDirPath)
+	assert.Equal(t, "/cache/0", values[2].CacheDirPath)
+}
+
+func TestNewSortedPrivateSectorInfoLarge(t *testing.T) {
+	const n = 10000
+
+	infos := make([]PrivateSectorInfo, 0, n)
+	for i := n; i > 0; i-- {
+		infos = append(infos, PrivateSectorInfo{SectorInfo: proof.SectorInfo{SectorNumber: abi.SectorNumber(i)}})
+	}
+
+	sorted := NewSortedPrivateSectorInfo(infos...)
+
+	values := sorted.Values()
+	require.Len(t, values, n)
+	for idx := range values {
+		require.Equal(t, abi.SectorNumber(idx+1), values[idx].SectorNumber)
+	}
+}
+
+func TestSortedPrivateSectorInfoUnmarshalJSONSorts(t *testing.T) {
+	numbers := []abi.SectorNumber{9, 2, 5, 2, 1}
+
+	infos := make([]PrivateSectorInfo, len(numbers))
+	for idx, n := range numbers {
+		infos[idx] = PrivateSectorInfo{
+			SectorInfo:   proof.SectorInfo{SectorNumber: n},
+			CacheDirPath: fmt.Sprintf("/cache/%d", idx),
+		}
+	}
+
+	// encode the infos in their original order, as a hand-edited file would
+	serialized, err := json.Marshal(infos)
+	require.NoError(t, err)
+
+	var fromSerialized SortedPrivateSectorInfo
+	require.NoError(t, fromSerialized.UnmarshalJSON(serialized))
+
+	assert.Equal(t, NewSortedPrivateSectorInfo(infos...), fromSerialized)
+
+	values := fromSerialized.Values()
+	require.Len(t, values, 4)
+	for idx, n := range []abi.SectorNumber{1, 2, 5, 9} {
+		assert.Equal(t, n, values[idx].SectorNumber)
+	}
+
+	// sorted input round-trips unchanged
+	reserialized, err := fromSerialized.MarshalJSON()
+	require.NoError(t, err)
+
+	var roundTripped SortedPrivateSectorInfo
+	require.NoError(t, json.Unmarshal(reserialized, &roundTripped))
+	assert.Equal(t, fromSerialized, roundTripped)
+
+	assert.Error(t, roundTripped.UnmarshalJSON([]byte("{")))
+}
+
+func TestSortedPrivateSectorInfoAccessors(t *testing.T) {
+	var infos []PrivateSectorInfo
+	for _, n := range []abi.SectorNumber{9, 2, 14, 5} {
+		infos = append(infos, PrivateSectorInfo{SectorInfo: proof.SectorInfo{SectorNumber: n}})
+	}
+
+	sorted := NewSortedPrivateSectorInfo(infos...)
+	require.Equal(t, 4, sorted.Len())
+
+	for _, n := range []abi.SectorNumber{2, 5, 9, 14} {
+		assert.True(t, sorted.Contains(n))
+	}
+	for _, n := range []abi.SectorNumber{0, 1, 3, 10, 15} {
+		assert.False(t, sorted.Contains(n))
+	}
+
+	first, ok := sorted.At(0)
+	require.True(t, ok)
+	assert.Equal(t, abi.SectorNumber(2), first.SectorNumber)
+
+	last, ok := sorted.At(3)
+	require.True(t, ok)
+	assert.Equal(t, abi.SectorNumber(14), last.SectorNumber)
+
+	_, ok = sorted.At(4)
+	assert.False(t, ok)
+
+	_, ok = sorted.At(-1)
+	assert.False(t, ok)
+
+	var empty SortedPrivateSectorInfo
+	assert.Equal(t, 0, empty.Len())
+	assert.False(t, empty.Contains(0))
+	_, ok = empty.At(0)
+	assert.False(t, ok)
+}
+
+func TestSplitSortedPrivateSectorInfo(t *testing.T) {
+	var infos []PrivateSectorInfo
+	for _, n := range []abi.SectorNumber{9, 2, 14, 5} {
+		infos = append(infos, PrivateSectorInfo{SectorInfo: proof.SectorInfo{SectorNumber: n}})
+	}
+	sorted := NewSortedPrivateSectorInfo(infos...)
+
+	split, err := SplitSortedPrivateSectorInfo(context.Background(), sorted, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, split.Len())
+	assert.True(t, split.Contains(5))
+	assert.True(t, split.Contains(9))
+
+	split, err = SplitSortedPrivateSectorInfo(context.Background(), sorted, 4, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 0, split.Len())
+
+	for _, r := range [][2]int{{-1, 2}, {3, 2}, {0, 5}, {5, 5}} {
+		_, err := SplitSortedPrivateSectorInfo(context.Background(), sorted, r[0], r[1])
+		assert.True(t, errors.Is(err, ErrInvalidRange), "range %v", r)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = SplitSortedPrivateSectorInfo(ctx, sorted, 0, 1)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestPartitionSectors(t *testing.T) {
+	var infos []PrivateSectorInfo
+	for n := abi.SectorNumber(10); n > 0; n-- {
+		infos = append(infos, PrivateSectorInfo{SectorInfo: proof.SectorInfo{SectorNumber: n}})
+	}
+	sorted := NewSortedPrivateSectorInfo(infos...)
+
+	for _, tc := range []struct {
+		maxPerPartition int
+		sizes           []int
+	}{
+		{1, []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+		{3, []int{3, 3, 3, 1}},
+		{5, []int{5, 5}},
+		{10, []int{10}},
+		{2349, []int{10}},
+	} {
+		partitions, err := PartitionSectors(sorted, tc.maxPerPartition)
+		require.NoError(t, err)
+		require.Len(t, partitions, len(tc.sizes), "max %d", tc.maxPerPartition)
+
+		// the partitions hold all the sectors, in order
+		next := abi.SectorNumber(1)
+		for idx, partition := range partitions {
+			require.Equal(t, tc.sizes[idx], partition.Len())
+			for _, info := range partition.Values() {
+				require.Equal(t, next, info.SectorNumber)
+				next++
+			}
+		}
+		require.Equal(t, abi.SectorNumber(11), next)
+	}
+
+	// sectors are partitioned in sector number order whatever their key
+	var sealed []PrivateSectorInfo
+	for _, info := range infos {
+		c, err := commcid.ReplicaCommitmentV1ToCID(bytes.Repeat([]byte{byte(info.SectorNumber)}, 32))
+		require.NoError(t, err)
+		info.SealedCID = c
+		sealed = append(sealed, info)
+	}
+	// sector 10 sorts before sector 2 by sealed CID
+	sealed[0].SealedCID, sealed[8].SealedCID = sealed[8].SealedCID, sealed[0].SealedCID
+	byCID := NewSortedPrivateSectorInfoBy(SortBySealedCID, sealed...)
+	require.Equal(t, abi.SectorNumber(10), byCID.Values()[1].SectorNumber)
+
+	partitions, err := PartitionSectors(byCID, 3)
+	require.NoError(t, err)
+	next := abi.SectorNumber(1)
+	for _, partition := range partitions {
+		assert.Equal(t, SortBySectorNumber, partition.Key())
+		for _, info := range partition.Values() {
+			require.Equal(t, next, info.SectorNumber)
+			next++
+		}
+	}
+	assert.Equal(t, SortBySealedCID, byCID.Key(), "PartitionSectors changed its argument")
+
+	// partitions are copies
+	partitions, err = PartitionSectors(sorted, 4)
+	require.NoError(t, err)
+	partitions[0].Values()[0].CacheDirPath = "/changed"
+	first, _ := sorted.At(0)
+	assert.Equal(t, "", first.CacheDirPath)
+
+	partitions, err = PartitionSectors(SortedPrivateSectorInfo{}, 4)
+	require.NoError(t, err)
+	assert.Empty(t, partitions)
+
+	for _, maxPerPartition := range []int{0, -1} {
+		_, err := PartitionSectors(sorted, maxPerPartition)
+		assert.Error(t, err)
+	}
+}
+
+func TestSortedPrivateSectorInfoMerge(t *testing.T) {
+	info := func(n abi.SectorNumber, path string) PrivateSectorInfo {
+		return PrivateSectorInfo{
+			SectorInfo:   proof.SectorInfo{SectorNumber: n},
+			CacheDirPath: path,
+		}
+	}
+
+	a := NewSortedPrivateSectorInfo(info(5, "a"), info(1, "a"), info(3, "a"))
+	b := NewSortedPrivateSectorInfo(info(4, "b"), info(3, "b"), info(0, "b"))
+
+	merged := a.Merge(b)
+	values := merged.Values()
+
+	var numbers []abi.SectorNumber
+	for _, v := range values {
+		numbers = append(numbers, v.SectorNumber)
+	}
+	require.Equal(t, []abi.SectorNumber{0, 1, 3, 4, 5}, numbers)
+
+	// sector 3 is in both, the receiver wins
+	assert.Equal(t, "a", values[2].CacheDirPath)
+
+	// the inputs are left untouched
+	assert.Len(t, a.Values(), 3)
+	assert.Len(t, b.Values(), 3)
+}
+
+func TestNewSortedPrivateSectorInfoBy(t *testing.T) {
+	info := func(n abi.SectorNumber, sealed string) PrivateSectorInfo {
+		c, err := commcid.ReplicaCommitmentV1ToCID(bytes.Repeat([]byte(sealed), 32))
+		require.NoError(t, err)
+		return PrivateSectorInfo{SectorInfo: proof.SectorInfo{SectorNumber: n, SealedCID: c}}
+	}
+	numbers := func(s SortedPrivateSectorInfo) []abi.SectorNumber {
+		var out []abi.SectorNumber
+		for _, v := range s.Values() {
+			out = append(out, v.SectorNumber)
+		}
+		return out
+	}
+
+	byNumber := NewSortedPrivateSectorInfo(info(1, "c"), info(2, "a"), info(3, "b"))
+	assert.Equal(t, SortBySectorNumber, byNumber.Key())
+	assert.Equal(t, []abi.SectorNumber{1, 2, 3}, numbers(byNumber))
+
+	byCID := NewSortedPrivateSectorInfoBy(SortBySealedCID, info(1, "c"), info(2, "a"), info(3, "b"), info(1, "d"))
+	assert.Equal(t, SortBySealedCID, byCID.Key())
+	assert.Equal(t, []abi.SectorNumber{2, 3, 1}, numbers(byCID))
+
+	// merging keeps the order of the receiver
+	merged := byCID.Merge(NewSortedPrivateSectorInfo(info(4, "0")))
+	assert.Equal(t, SortBySealedCID, merged.Key())
+	assert.Equal(t, []abi.SectorNumber{4, 2, 3, 1}, numbers(merged))
+
+	assert.True(t, byCID.Contains(1))
+	assert.False(t, byCID.Contains(4))
+
+	difference := merged.Difference(byNumber)
+	assert.Equal(t, SortBySealedCID, difference.Key())
+	assert.Equal(t, []abi.SectorNumber{4}, numbers(difference))
+
+	// decoding keeps the order of the target
+	encoded, err := json.Marshal(byNumber)
+	require.NoError(t, err)
+	decoded := NewSortedPrivateSectorInfoBy(SortBySealedCID)
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, []abi.SectorNumber{2, 3, 1}, numbers(decoded))
+}
+
+func TestNewSortedPrivateSectorInfoWithHint(t *testing.T) {
+	infos := []PrivateSectorInfo{
+		{SectorInfo: proof.SectorInfo{SectorNumber: 3}},
+		{SectorInfo: proof.SectorInfo{SectorNumber: 1}},
+		{SectorInfo: proof.SectorInfo{SectorNumber: 3}},
+	}
+
+	expected := NewSortedPrivateSectorInfo(infos...)
+	sorted := NewSortedPrivateSectorInfoWithHint(100, infos...)
+	values := sorted.Values()
+	assert.Equal(t, expected.Values(), values)
+	assert.Equal(t, 100, cap(values))
+
+	// a hint smaller than the number of sectors is ignored
+	sorted = NewSortedPrivateSectorInfoWithHint(1, infos...)
+	assert.Equal(t, 2, sorted.Len())
+
+	sorted = NewSortedPrivateSectorInfoWithHint(-1)
+	assert.Equal(t, 0, sorted.Len())
+}
+
+func TestSortedPrivateSectorInfoDifference(t *testing.T) {
+	sectors := func(numbers ...abi.SectorNumber) SortedPrivateSectorInfo {
+		var infos []PrivateSectorInfo
+		for _, n := range numbers {
+			infos = append(infos, PrivateSectorInfo{SectorInfo: proof.SectorInfo{SectorNumber: n}})
+		}
+		return NewSortedPrivateSectorInfo(infos...)
+	}
+	numbers := func(s SortedPrivateSectorInfo) []abi.SectorNumber {
+		out := []abi.SectorNumber{}
+		for _, v := range s.Values() {
+			out = append(out, v.SectorNumber)
+		}
+		return out
+	}
+
+	for _, tc := range []struct {
+		s, other []abi.SectorNumber
+		expected []abi.SectorNumber
+	}{
+		{[]abi.SectorNumber{1, 3, 5, 7, 9}, []abi.SectorNumber{2, 3, 4, 9, 10}, []abi.SectorNumber{1, 5, 7}},
+		{[]abi.SectorNumber{1, 3, 5}, []abi.SectorNumber{2, 4, 6}, []abi.SectorNumber{1, 3, 5}},
+		{[]abi.SectorNumber{1, 3, 5}, []abi.SectorNumber{5, 3, 1}, []abi.SectorNumber{}},
+		{[]abi.SectorNumber{1, 2, 3}, []abi.SectorNumber{0, 2, 100}, []abi.SectorNumber{1, 3}},
+		{[]abi.SectorNumber{10, 20}, nil, []abi.SectorNumber{10, 20}},
+		{nil, []abi.SectorNumber{10, 20}, []abi.SectorNumber{}},
+	} {
+		s, other := sectors(tc.s...), sectors(tc.other...)
+		assert.Equal(t, tc.expected, numbers(s.Difference(other)), "%v - %v", tc.s, tc.other)
+
+		// the inputs are left untouched
+		assert.Len(t, s.Values(), len(tc.s))
+		assert.Len(t, other.Values(), len(tc.other))
+	}
+
+	// the sectors of s are kept as they are
+	s := NewSortedPrivateSectorInfo(PrivateSectorInfo{SectorInfo: proof.SectorInfo{SectorNumber: 1}, CacheDirPath: "a"})
+	difference := s.Difference(sectors(2))
+	values := difference.Values()
+	require.Len(t, values, 1)
+	assert.Equal(t, "a", values[0].CacheDirPath)
+}
+
+func TestSortedPrivateSectorInfoFilter(t *testing.T) {
+	var infos []PrivateSectorInfo
+	for i := 1; i <= 10; i++ {
+		proofType := abi.RegisteredPoStProof_StackedDrgWindow32GiBV1
+		if i%3 == 0 {
+			proofType = abi.RegisteredPoStProof_StackedDrgWindow64GiBV1
+		}
+
+		infos = append(infos, PrivateSectorInfo{
+			SectorInfo:    proof.SectorInfo{SectorNumber: abi.SectorNumber(11 - i)},
+			PoStProofType: proofType,
+		})
+	}
+
+	sorted := NewSortedPrivateSectorInfo(infos...)
+
+	numbers := func(s SortedPrivateSectorInfo) []abi.SectorNumber {
+		var out []abi.SectorNumber
+		for _, v := range s.Values() {
+			out = append(out, v.SectorNumber)
+		}
+		return out
+	}
+
+	assert.Equal(t, []abi.SectorNumber{2, 5, 8}, numbers(sorted.Filter(abi.RegisteredPoStProof_StackedDrgWindow64GiBV1)))
+	assert.Equal(t, []abi.SectorNumber{1, 3, 4, 6, 7, 9, 10}, numbers(sorted.Filter(abi.RegisteredPoStProof_StackedDrgWindow32GiBV1)))
+	assert.Empty(t, numbers(sorted.Filter(abi.RegisteredPoStProof_StackedDrgWindow2KiBV1)))
+
+	even := sorted.FilterFunc(func(info PrivateSectorInfo) bool {
+		return info.SectorNumber%2 == 0
+	})
+	assert.Equal(t, []abi.SectorNumber{2, 4, 6, 8, 10}, numbers(even))
+
+	// the original is left untouched
+	assert.Len(t, sorted.Values(), 10)
+}
+
+func BenchmarkSortedPrivateSectorInfoMerge(b *testing.B) {
+	var left, right []PrivateSectorInfo
+	for i := 0; i < 1000; i++ {
+		left = append(left, PrivateSectorInfo{SectorInfo: proof.SectorInfo{SectorNumber: abi.SectorNumber(2 * i)}})
+		right = append(right, PrivateSectorInfo{SectorInfo: proof.SectorInfo{SectorNumber: abi.SectorNumber(3 * i)}})
+	}
+
+	sortedLeft := NewSortedPrivateSectorInfo(left...)
+	sortedRight := NewSortedPrivateSectorInfo(right...)
+
+	b.Run("Merge", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sortedLeft.Merge(sortedRight)
+		}
+	})
+
+	b.Run("NewSortedPrivateSectorInfo", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NewSortedPrivateSectorInfo(append(append([]PrivateSectorInfo{}, left...), right...)...)
+		}
+	})
+}
+
+func BenchmarkNewSortedPrivateSectorInfo(b *testing.B) {
+	for _, n := range []int{10, 1000, 10000} {
+		// sectors in descending order, with every tenth one duplicated
+		var infos []PrivateSectorInfo
+		for i := n; i > 0; i-- {
+			info := PrivateSectorInfo{SectorInfo: proof.SectorInfo{SectorNumber: abi.SectorNumber(i)}}
+			infos = append(infos, info)
+			if i%10 == 0 {
+				infos = append(infos, info)
+			}
+		}
+
+		b.Run(fmt.Sprintf("%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				NewSortedPrivateSectorInfo(infos...)
+			}
+		})
+	}
+}
+
+func TestPrivateSectorInfoEqual(t *testing.T) {
+	commR, err := commcid.ReplicaCommitmentV1ToCID(make([]byte, 32))
+	require.NoError(t, err)
+
+	info := PrivateSectorInfo{
+		SectorInfo: proof.SectorInfo{
+			SealProof:    abi.RegisteredSealProof_StackedDrg2KiBV1,
+			SectorNumber: 42,
+			SealedCID:    commR,
+		},
+		CacheDirPath:     "/cache",
+		PoStProofType:    abi.RegisteredPoStProof_StackedDrgWindow2KiBV1,
+		SealedSectorPath: "/sealed",
+	}
+
+	// a CID decoded from its bytes is equal to the original
+	decodedCommR, err := cid.Cast(commR.Bytes())
+	require.NoError(t, err)
+	same := info
+	same.SealedCID = decodedCommR
+	assert.True(t, info.Equal(same))
+	assert.True(t, same.Equal(info))
+
+	assert.True(t, PrivateSectorInfo{}.Equal(PrivateSectorInfo{}))
+
+	otherCommR, err := commcid.ReplicaCommitmentV1ToCID(append(make([]byte, 31), 1))
+	require.NoError(t, err)
+
+	for name, change := range map[string]func(*PrivateSectorInfo){
+		"SealProof":        func(p *PrivateSectorInfo) { p.SealProof = abi.RegisteredSealProof_StackedDrg2KiBV1_1 },
+		"SectorNumber":     func(p *PrivateSectorInfo) { p.SectorNumber++ },
+		"SealedCID":        func(p *PrivateSectorInfo) { p.SealedCID = otherCommR },
+		"UndefSealedCID":   func(p *PrivateSectorInfo) { p.SealedCID = cid.Undef },
+		"CacheDirPath":     func(p *PrivateSectorInfo) { p.CacheDirPath = "/other" },
+		"PoStProofType":    func(p *PrivateSectorInfo) { p.PoStProofType = abi.RegisteredPoStProof_StackedDrgWinning2KiBV1 },
+		"SealedSectorPath": func(p *PrivateSectorInfo) { p.SealedSectorPath = "/other" },
+	} {
+		other := info
+		change(&other)
+		assert.False(t, info.Equal(other), name)
+		assert.False(t, other.Equal(info), name)
+	}
+}
+
+func TestPrivateSectorInfoValidate(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	sealedSector, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer os.Remove(sealedSector.Name())
+
+	_, err = sealedSector.Write(make([]byte, 2048))
+	require.NoError(t, err)
+	require.NoError(t, sealedSector.Close())
+
+	valid := PrivateSectorInfo{
+		SectorInfo:       proof.SectorInfo{SectorNumber: 42},
+		CacheDirPath:     cacheDir,
+		PoStProofType:    abi.RegisteredPoStProof_StackedDrgWindow2KiBV1,
+		SealedSectorPath: sealedSector.Name(),
+	}
+	require.NoError(t, valid.Validate())
+
+	// sector 0 is usually the first sector of a miner
+	zeroSector := valid
+	zeroSector.SectorNumber = 0
+	require.NoError(t, zeroSector.Validate())
+
+	missingCache := valid
+	missingCache.CacheDirPath = filepath.Join(cacheDir, "missing")
+	require.Error(t, missingCache.Validate())
+
+	fileAsCache := valid
+	fileAsCache.CacheDirPath = sealedSector.Name()
+	require.Error(t, fileAsCache.Validate())
+
+	missingSealed := valid
+	missingSealed.SealedSectorPath = filepath.Join(cacheDir, "missing")
+	require.Error(t, missingSealed.Validate())
+
+	wrongSize := valid
+	wrongSize.PoStProofType = abi.RegisteredPoStProof_StackedDrgWindow8MiBV1
+	require.Error(t, wrongSize.Validate())
+}
+
+func TestSortedPrivateSectorInfoValidatePaths(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(cacheDir)
+
+	sealedSector, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer os.Remove(sealedSector.Name())
+
+	_, err = sealedSector.Write(make([]byte, 2048))
+	require.NoError(t, err)
+	require.NoError(t, sealedSector.Close())
+
+	sector := func(n abi.SectorNumber) PrivateSectorInfo {
+		return PrivateSectorInfo{
+			SectorInfo:       proof.SectorInfo{SectorNumber: n},
+			CacheDirPath:     cacheDir,
+			PoStProofType:    abi.RegisteredPoStProof_StackedDrgWindow2KiBV1,
+			SealedSectorPath: sealedSector.Name(),
+		}
+	}
+
+	valid := NewSortedPrivateSectorInfo(sector(1), sector(2), sector(3))
+	require.Empty(t, valid.ValidatePaths())
+
+	missingCache := sector(2)
+	missingCache.CacheDirPath = filepath.Join(cacheDir, "missing")
+	missingSealed := sector(3)
+	missingSealed.SealedSectorPath = filepath.Join(cacheDir, "missing")
+
+	invalid := NewSortedPrivateSectorInfo(sector(1), missingCache, missingSealed)
+	errs := invalid.ValidatePaths()
+	require.Len(t, errs, 3)
+
+	for idx, info := range invalid.Values() {
+		if info.SectorNumber == 1 {
+			assert.NoError(t, errs[idx])
+		} else {
+			assert.Error(t, errs[idx])
+		}
+	}
+}
+
 func TestDoesNotExhaustFileDescriptors(t *testing.T) {
 	m := 500         // loops
 	n := uint64(508) // quantity of piece bytes
@@ -0,0 +1,39 @@
+//+build cgo
+
+package ffi
+
+import (
+	"github.com/filecoin-project/go-state-types/abi"
+	proof5 "github.com/filecoin-project/specs-actors/v5/actors/runtime/proof"
+)
+
+// Prover generates and verifies PoSt proofs. Code depending on a Prover rather
+// than on the free functions of this package can be tested with a mock that
+// does not need real sector data.
+type Prover interface {
+	GenerateWinningPoSt(minerID abi.ActorID, privateSectorInfo SortedPrivateSectorInfo, randomness abi.PoStRandomness) ([]proof5.PoStProof, error)
+	GenerateWindowPoSt(minerID abi.ActorID, privateSectorInfo SortedPrivateSectorInfo, randomness abi.PoStRandomness, opts ...WindowPoStOption) ([]proof5.PoStProof, []abi.SectorNumber, error)
+	VerifyWinningPoSt(info proof5.WinningPoStVerifyInfo) (bool, error)
+}
+
+// NewFFIProver returns the Prover backed by the proofs library, whose methods
+// call the free functions of the same name.
+func NewFFIProver() Prover {
+	return ffiProver{}
+}
+
+type ffiProver struct{}
+
+var _ Prover = ffiProver{}
+
+func (ffiProver) GenerateWinningPoSt(minerID abi.ActorID, privateSectorInfo SortedPrivateSectorInfo, randomness abi.PoStRandomness) ([]proof5.PoStProof, error) {
+	return GenerateWinningPoSt(minerID, privateSectorInfo, randomness)
+}
+
+func (ffiProver) GenerateWindowPoSt(minerID abi.ActorID, privateSectorInfo SortedPrivateSectorInfo, randomness abi.PoStRandomness, opts ...WindowPoStOption) ([]proof5.PoStProof, []abi.SectorNumber, error) {
+	return GenerateWindowPoSt(minerID, privateSectorInfo, randomness, opts...)
+}
+
+func (ffiProver) VerifyWinningPoSt(info proof5.WinningPoStVerifyInfo) (bool, error) {
+	return VerifyWinningPoSt(info)
+}
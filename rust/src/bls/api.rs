@@ -1,17 +1,19 @@
+use std::collections::{HashMap, HashSet};
 use std::slice::from_raw_parts;
 
 use bls_signatures::{
     aggregate as aggregate_sig, hash as hash_sig, verify as verify_sig,
     verify_messages as verify_messages_sig, Error, PrivateKey, PublicKey, Serialize, Signature,
 };
-use blstrs::{G2Affine, G2Projective};
+use blstrs::{G1Affine, G1Projective, G2Affine, G2Projective, Scalar};
 use group::prime::PrimeCurveAffine;
-use group::GroupEncoding;
+use group::{Group, GroupEncoding};
 
 use rand::rngs::OsRng;
-use rand::SeedableRng;
+use rand::{RngCore, SeedableRng};
 use rand_chacha::ChaChaRng;
 use rayon::prelude::*;
+use zeroize::Zeroizing;
 
 use crate::bls::types;
 use crate::proofs::types::fil_32ByteArray;
@@ -20,6 +22,24 @@ pub const SIGNATURE_BYTES: usize = 96;
 pub const PRIVATE_KEY_BYTES: usize = 32;
 pub const PUBLIC_KEY_BYTES: usize = 48;
 pub const DIGEST_BYTES: usize = 96;
+pub const UNCOMPRESSED_PUBLIC_KEY_BYTES: usize = 96;
+pub const UNCOMPRESSED_SIGNATURE_BYTES: usize = 192;
+pub const SCALAR_BYTES: usize = 32;
+pub const FIELD_ELEMENT_BYTES: usize = 48;
+pub const PUBLIC_KEY_G2_BYTES: usize = 96;
+pub const SIGNATURE_G1_BYTES: usize = 48;
+
+/// Domain separation tag of the proof-of-possession scheme, as defined by the
+/// BLS signature draft for `BLS12381G2_XMD:SHA-256_SSWU_RO_`.
+const POP_DST: &[u8] = b"BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_";
+
+/// Domain separation tag of the min-sig variant, with public keys in G2 and
+/// signatures in G1, as defined by the BLS signature draft for
+/// `BLS12381G1_XMD:SHA-256_SSWU_RO_`.
+const MIN_SIG_DST: &[u8] = b"BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_";
+
+/// Maximum length of a domain separation tag, as defined by the hash-to-curve spec.
+const MAX_DST_BYTES: usize = 255;
 
 #[repr(C)]
 pub struct fil_BLSSignature {
@@ -41,6 +61,26 @@ pub struct fil_BLSDigest {
     pub inner: [u8; DIGEST_BYTES],
 }
 
+#[repr(C)]
+pub struct fil_BLSUncompressedPublicKey {
+    pub inner: [u8; UNCOMPRESSED_PUBLIC_KEY_BYTES],
+}
+
+#[repr(C)]
+pub struct fil_BLSUncompressedSignature {
+    pub inner: [u8; UNCOMPRESSED_SIGNATURE_BYTES],
+}
+
+#[repr(C)]
+pub struct fil_BLSPublicKeyG2 {
+    pub inner: [u8; PUBLIC_KEY_G2_BYTES],
+}
+
+#[repr(C)]
+pub struct fil_BLSSignatureG1 {
+    pub inner: [u8; SIGNATURE_G1_BYTES],
+}
+
 /// Unwraps or returns the passed in value.
 macro_rules! try_ffi {
     ($res:expr, $val:expr) => {{
@@ -51,6 +91,51 @@ macro_rules! try_ffi {
     }};
 }
 
+/// A deserialized private key, which is wiped when dropped.
+struct WipedPrivateKey(PrivateKey);
+
+impl std::ops::Deref for WipedPrivateKey {
+    type Target = PrivateKey;
+
+    fn deref(&self) -> &PrivateKey {
+        &self.0
+    }
+}
+
+impl Drop for WipedPrivateKey {
+    fn drop(&mut self) {
+        // volatile writes, so that wiping a key about to go away isn't optimized away
+        let bytes = &mut self.0 as *mut PrivateKey as *mut u8;
+        for offset in 0..std::mem::size_of::<PrivateKey>() {
+            unsafe { std::ptr::write_volatile(bytes.add(offset), 0) };
+        }
+    }
+}
+
+/// Deserializes a private key, rejecting the all-zero key that is left behind
+/// when a key has been zeroed.
+fn private_key_from_bytes(raw_private_key: &[u8]) -> Result<WipedPrivateKey, Error> {
+    if raw_private_key.iter().all(|byte| *byte == 0) {
+        return Err(Error::InvalidPrivateKey);
+    }
+
+    PrivateKey::from_bytes(raw_private_key).map(WipedPrivateKey)
+}
+
+/// Deserializes a private key for the min-sig variant. The key is validated
+/// like `private_key_from_bytes` does, but blst reads it big endian. The big
+/// endian copy is zeroed once blst has read it, and the returned key zeroes
+/// itself when dropped.
+fn min_sig_secret_key(raw_private_key: &[u8]) -> Option<blst::min_sig::SecretKey> {
+    private_key_from_bytes(raw_private_key).ok()?;
+
+    let mut big_endian = Zeroizing::new([0u8; PRIVATE_KEY_BYTES]);
+    big_endian.copy_from_slice(raw_private_key);
+    big_endian.reverse();
+
+    blst::min_sig::SecretKey::from_bytes(&big_endian[..]).ok()
+}
+
 /// Compute the digest of a message
 ///
 /// # Arguments
@@ -79,6 +164,55 @@ pub unsafe extern "C" fn fil_hash(
     Box::into_raw(Box::new(response))
 }
 
+/// Hashes a message to the curve using the given domain separation tag.
+///
+/// Returns `None` if the domain separation tag is empty or longer than `MAX_DST_BYTES`.
+fn hash_with_dst(message: &[u8], dst: &[u8]) -> Option<G2Projective> {
+    if dst.is_empty() || dst.len() > MAX_DST_BYTES {
+        return None;
+    }
+
+    Some(G2Projective::hash_to_curve(message, dst, &[]))
+}
+
+/// Compute the digest of a message using a custom domain separation tag
+///
+/// # Arguments
+///
+/// * `message_ptr` - pointer to a message byte array
+/// * `message_len` - length of the byte array
+/// * `dst_ptr`     - pointer to a domain separation tag byte array
+/// * `dst_len`     - length of the domain separation tag (1 to 255 bytes)
+///
+/// Returns `NULL` when the domain separation tag is empty or too long.
+#[no_mangle]
+pub unsafe extern "C" fn fil_hash_with_dst(
+    message_ptr: *const u8,
+    message_len: libc::size_t,
+    dst_ptr: *const u8,
+    dst_len: libc::size_t,
+) -> *mut types::fil_HashResponse {
+    // prep request
+    let message = from_raw_parts(message_ptr, message_len);
+    let dst = from_raw_parts(dst_ptr, dst_len);
+
+    // call method
+    let digest = match hash_with_dst(message, dst) {
+        Some(digest) => digest,
+        None => return std::ptr::null_mut(),
+    };
+
+    // prep response
+    let mut raw_digest: [u8; DIGEST_BYTES] = [0; DIGEST_BYTES];
+    raw_digest.copy_from_slice(digest.to_bytes().as_ref());
+
+    let response = types::fil_HashResponse {
+        digest: fil_BLSDigest { inner: raw_digest },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
 /// Aggregate signatures together into a new signature
 ///
 /// # Arguments
@@ -117,6 +251,126 @@ pub unsafe extern "C" fn fil_aggregate(
     Box::into_raw(Box::new(response))
 }
 
+/// Compute the linear combination of signatures with the given scalars, which
+/// is how signature shares are interpolated into a threshold signature
+///
+/// # Arguments
+///
+/// * `flattened_signatures_ptr` - pointer to a byte array containing signatures
+/// * `flattened_signatures_len` - length of the byte array (multiple of SIGNATURE_BYTES)
+/// * `flattened_scalars_ptr`    - pointer to a byte array containing little endian scalars,
+///                                one per signature
+/// * `flattened_scalars_len`    - length of the byte array (multiple of SCALAR_BYTES)
+///
+/// Returns `NULL` on error. Result must be freed using `destroy_aggregate_response`.
+#[no_mangle]
+pub unsafe extern "C" fn fil_signature_linear_combination(
+    flattened_signatures_ptr: *const u8,
+    flattened_signatures_len: libc::size_t,
+    flattened_scalars_ptr: *const u8,
+    flattened_scalars_len: libc::size_t,
+) -> *mut types::fil_AggregateResponse {
+    if flattened_signatures_len == 0
+        || flattened_signatures_len % SIGNATURE_BYTES != 0
+        || flattened_scalars_len % SCALAR_BYTES != 0
+        || flattened_signatures_len / SIGNATURE_BYTES != flattened_scalars_len / SCALAR_BYTES
+    {
+        return std::ptr::null_mut();
+    }
+
+    let signatures = try_ffi!(
+        from_raw_parts(flattened_signatures_ptr, flattened_signatures_len)
+            .par_chunks(SIGNATURE_BYTES)
+            .map(|item| { Signature::from_bytes(item) })
+            .collect::<Result<Vec<_>, _>>(),
+        std::ptr::null_mut()
+    );
+
+    let mut scalars = Vec::with_capacity(signatures.len());
+    for item in from_raw_parts(flattened_scalars_ptr, flattened_scalars_len).chunks(SCALAR_BYTES) {
+        let mut raw_scalar = [0u8; SCALAR_BYTES];
+        raw_scalar.copy_from_slice(item);
+
+        let scalar: Option<Scalar> = Scalar::from_bytes_le(&raw_scalar).into();
+        match scalar {
+            Some(scalar) => scalars.push(scalar),
+            None => return std::ptr::null_mut(),
+        }
+    }
+
+    let combined: G2Projective = signatures
+        .par_iter()
+        .zip(scalars.par_iter())
+        .map(|(signature, scalar)| G2Projective::from(*signature) * scalar)
+        .reduce(G2Projective::identity, |acc, item| acc + item);
+
+    let mut raw_signature: [u8; SIGNATURE_BYTES] = [0; SIGNATURE_BYTES];
+    Signature::from(combined)
+        .write_bytes(&mut raw_signature.as_mut())
+        .expect("preallocated");
+
+    let response = types::fil_AggregateResponse {
+        signature: fil_BLSSignature {
+            inner: raw_signature,
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Aggregate public keys together into a new public key
+///
+/// # Arguments
+///
+/// * `flattened_public_keys_ptr` - pointer to a byte array containing public keys
+/// * `flattened_public_keys_len` - length of the byte array (multiple of PUBLIC_KEY_BYTES)
+///
+/// Returns `NULL` on error, including when no public keys are given or when any
+/// of them fails to deserialize. Result must be freed using
+/// `destroy_aggregate_public_keys_response`.
+#[no_mangle]
+pub unsafe extern "C" fn fil_aggregate_public_keys(
+    flattened_public_keys_ptr: *const u8,
+    flattened_public_keys_len: libc::size_t,
+) -> *mut types::fil_AggregatePublicKeysResponse {
+    // prep request
+    let raw_public_keys = from_raw_parts(flattened_public_keys_ptr, flattened_public_keys_len);
+
+    if raw_public_keys.is_empty() || raw_public_keys.len() % PUBLIC_KEY_BYTES != 0 {
+        return std::ptr::null_mut();
+    }
+
+    let public_keys: Vec<_> = try_ffi!(
+        raw_public_keys
+            .par_chunks(PUBLIC_KEY_BYTES)
+            .map(|item| { PublicKey::from_bytes(item) })
+            .collect::<Result<Vec<_>, _>>(),
+        std::ptr::null_mut()
+    );
+
+    let mut raw_public_key: [u8; PUBLIC_KEY_BYTES] = [0; PUBLIC_KEY_BYTES];
+    aggregate_public_keys(public_keys)
+        .write_bytes(&mut raw_public_key.as_mut())
+        .expect("preallocated");
+
+    let response = types::fil_AggregatePublicKeysResponse {
+        public_key: fil_BLSPublicKey {
+            inner: raw_public_key,
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Sums the given public keys into a single public key.
+fn aggregate_public_keys(public_keys: Vec<PublicKey>) -> PublicKey {
+    public_keys
+        .into_par_iter()
+        .map(G1Projective::from)
+        .reduce(G1Projective::identity, |acc, item| acc + item)
+        .into()
+}
+
 /// Verify that a signature is the aggregated signature of hashes - pubkeys
 ///
 /// # Arguments
@@ -177,6 +431,178 @@ pub unsafe extern "C" fn fil_verify(
     verify_sig(&signature, digests.as_slice(), public_keys.as_slice()) as libc::c_int
 }
 
+/// Verify that a signature is the aggregated signature of a single digest signed
+/// by all of the given public keys
+///
+/// # Arguments
+///
+/// * `signature_ptr`             - pointer to a signature byte array (SIGNATURE_BYTES long)
+/// * `digest_ptr`                - pointer to a digest byte array (DIGEST_BYTES long)
+/// * `flattened_public_keys_ptr` - pointer to a byte array containing public keys
+/// * `flattened_public_keys_len` - length of the byte array (multiple of PUBLIC_KEY_BYTES)
+///
+/// Returns `0` when no public keys are given or when the signature is the
+/// identity point.
+#[no_mangle]
+pub unsafe extern "C" fn fil_fast_aggregate_verify(
+    signature_ptr: *const u8,
+    digest_ptr: *const u8,
+    flattened_public_keys_ptr: *const u8,
+    flattened_public_keys_len: libc::size_t,
+) -> libc::c_int {
+    // prep request
+    let raw_signature = from_raw_parts(signature_ptr, SIGNATURE_BYTES);
+    let signature = try_ffi!(Signature::from_bytes(raw_signature), 0);
+
+    if bool::from(G2Projective::from(signature).is_identity()) {
+        return 0;
+    }
+
+    let mut raw_digest = [0u8; DIGEST_BYTES];
+    raw_digest.copy_from_slice(from_raw_parts(digest_ptr, DIGEST_BYTES));
+    let digest: G2Projective = try_ffi!(
+        Option::<G2Affine>::from(G2Affine::from_compressed(&raw_digest))
+            .map(Into::into)
+            .ok_or(Error::CurveDecode),
+        0
+    );
+
+    let raw_public_keys = from_raw_parts(flattened_public_keys_ptr, flattened_public_keys_len);
+
+    if raw_public_keys.is_empty() || raw_public_keys.len() % PUBLIC_KEY_BYTES != 0 {
+        return 0;
+    }
+
+    let public_keys: Vec<_> = try_ffi!(
+        raw_public_keys
+            .par_chunks(PUBLIC_KEY_BYTES)
+            .map(|item| { PublicKey::from_bytes(item) })
+            .collect::<Result<Vec<_>, _>>(),
+        0
+    );
+
+    verify_sig(&signature, &[digest], &[aggregate_public_keys(public_keys)]) as libc::c_int
+}
+
+/// Verify a batch of independent signatures, where the i-th signature must be
+/// a signature of the i-th digest by the i-th public key.
+///
+/// Every signature and public key is scaled by a random non-zero 64-bit scalar
+/// before being combined, so the whole batch is checked with a single
+/// multi-pairing instead of one pairing check per signature.
+///
+/// # Arguments
+///
+/// * `flattened_digests_ptr`     - pointer to a byte array containing digests
+/// * `flattened_digests_len`     - length of the byte array (multiple of DIGEST_BYTES)
+/// * `flattened_public_keys_ptr` - pointer to a byte array containing public keys
+/// * `flattened_public_keys_len` - length of the byte array (multiple of PUBLIC_KEY_BYTES)
+/// * `flattened_signatures_ptr`  - pointer to a byte array containing signatures
+/// * `flattened_signatures_len`  - length of the byte array (multiple of SIGNATURE_BYTES)
+///
+/// Returns `1` if all signatures are valid (including the empty batch), `0` otherwise.
+#[no_mangle]
+pub unsafe extern "C" fn fil_verify_batch(
+    flattened_digests_ptr: *const u8,
+    flattened_digests_len: libc::size_t,
+    flattened_public_keys_ptr: *const u8,
+    flattened_public_keys_len: libc::size_t,
+    flattened_signatures_ptr: *const u8,
+    flattened_signatures_len: libc::size_t,
+) -> libc::c_int {
+    // prep request
+    let raw_digests = from_raw_parts(flattened_digests_ptr, flattened_digests_len);
+    let raw_public_keys = from_raw_parts(flattened_public_keys_ptr, flattened_public_keys_len);
+    let raw_signatures = from_raw_parts(flattened_signatures_ptr, flattened_signatures_len);
+
+    if raw_digests.len() % DIGEST_BYTES != 0
+        || raw_public_keys.len() % PUBLIC_KEY_BYTES != 0
+        || raw_signatures.len() % SIGNATURE_BYTES != 0
+    {
+        return 0;
+    }
+
+    let count = raw_digests.len() / DIGEST_BYTES;
+    if count != raw_public_keys.len() / PUBLIC_KEY_BYTES
+        || count != raw_signatures.len() / SIGNATURE_BYTES
+    {
+        return 0;
+    }
+
+    if count == 0 {
+        return 1;
+    }
+
+    let digests: Vec<_> = try_ffi!(
+        raw_digests
+            .par_chunks(DIGEST_BYTES)
+            .map(|item: &[u8]| {
+                let mut digest = [0u8; DIGEST_BYTES];
+                digest.as_mut().copy_from_slice(item);
+
+                let affine: Option<G2Affine> = Option::from(G2Affine::from_compressed(&digest));
+                affine.map(Into::into).ok_or(Error::CurveDecode)
+            })
+            .collect::<Result<Vec<G2Projective>, Error>>(),
+        0
+    );
+
+    let public_keys: Vec<_> = try_ffi!(
+        raw_public_keys
+            .par_chunks(PUBLIC_KEY_BYTES)
+            .map(|item| { PublicKey::from_bytes(item) })
+            .collect::<Result<Vec<_>, _>>(),
+        0
+    );
+
+    let signatures: Vec<_> = try_ffi!(
+        raw_signatures
+            .par_chunks(SIGNATURE_BYTES)
+            .map(|item| { Signature::from_bytes(item) })
+            .collect::<Result<Vec<_>, _>>(),
+        0
+    );
+
+    let scalars: Vec<Scalar> = (0..count).map(|_| random_batch_scalar()).collect();
+
+    let signature: G2Projective = signatures
+        .par_iter()
+        .zip(scalars.par_iter())
+        .map(|(signature, scalar)| G2Projective::from(*signature) * scalar)
+        .reduce(G2Projective::identity, |acc, item| acc + item);
+
+    // Entries signing the same digest are folded into a single public key,
+    // as the aggregate verification requires the digests to be distinct.
+    let mut combined: HashMap<&[u8], (G2Projective, G1Projective)> = HashMap::new();
+    for ((raw_digest, digest), (public_key, scalar)) in raw_digests
+        .chunks(DIGEST_BYTES)
+        .zip(digests.iter())
+        .zip(public_keys.iter().zip(scalars.iter()))
+    {
+        let entry = combined
+            .entry(raw_digest)
+            .or_insert_with(|| (*digest, G1Projective::identity()));
+        entry.1 += G1Projective::from(*public_key) * scalar;
+    }
+
+    let (digests, public_keys): (Vec<G2Projective>, Vec<PublicKey>) = combined
+        .into_iter()
+        .map(|(_, (digest, public_key))| (digest, PublicKey::from(public_key)))
+        .unzip();
+
+    verify_sig(&signature.into(), &digests, &public_keys) as libc::c_int
+}
+
+/// Returns a random non-zero scalar used to weight an entry of a batch verification.
+fn random_batch_scalar() -> Scalar {
+    loop {
+        let value = OsRng.next_u64();
+        if value != 0 {
+            return Scalar::from(value);
+        }
+    }
+}
+
 /// Verify that a signature is the aggregated signature of the hashed messages
 ///
 /// # Arguments
@@ -230,19 +656,100 @@ pub unsafe extern "C" fn fil_hash_verify(
     verify_messages_sig(&signature, &messages, &public_keys) as libc::c_int
 }
 
-/// Generate a new private key
+/// Verify a batch of independent signatures, where the i-th signature must be
+/// a signature of the i-th message by the i-th public key, and report the
+/// result of every entry
+///
+/// # Arguments
+///
+/// * `flattened_messages_ptr`    - pointer to a byte array containing the concatenated messages
+/// * `flattened_messages_len`    - length of the byte array
+/// * `message_sizes_ptr`         - pointer to an array containing the lengths of the messages
+/// * `message_sizes_len`         - length of the message sizes array
+/// * `flattened_public_keys_ptr` - pointer to a byte array containing public keys
+/// * `flattened_public_keys_len` - length of the byte array (multiple of PUBLIC_KEY_BYTES)
+/// * `flattened_signatures_ptr`  - pointer to a byte array containing signatures
+/// * `flattened_signatures_len`  - length of the byte array (multiple of SIGNATURE_BYTES)
+///
+/// Entries with a public key or signature that fails to deserialize are
+/// reported as invalid. Returns `NULL` when the number of messages, public keys
+/// and signatures differs. Result must be freed using
+/// `destroy_hash_verify_batch_response`.
 #[no_mangle]
-pub unsafe extern "C" fn fil_private_key_generate() -> *mut types::fil_PrivateKeyGenerateResponse {
-    let mut raw_private_key: [u8; PRIVATE_KEY_BYTES] = [0; PRIVATE_KEY_BYTES];
-    PrivateKey::generate(&mut OsRng)
-        .write_bytes(&mut raw_private_key.as_mut())
-        .expect("preallocated");
-
-    let response = types::fil_PrivateKeyGenerateResponse {
-        private_key: fil_BLSPrivateKey {
-            inner: raw_private_key,
-        },
-    };
+pub unsafe extern "C" fn fil_hash_verify_batch(
+    flattened_messages_ptr: *const u8,
+    flattened_messages_len: libc::size_t,
+    message_sizes_ptr: *const libc::size_t,
+    message_sizes_len: libc::size_t,
+    flattened_public_keys_ptr: *const u8,
+    flattened_public_keys_len: libc::size_t,
+    flattened_signatures_ptr: *const u8,
+    flattened_signatures_len: libc::size_t,
+) -> *mut types::fil_HashVerifyBatchResponse {
+    // prep request
+    let flattened = from_raw_parts(flattened_messages_ptr, flattened_messages_len);
+    let chunk_sizes = from_raw_parts(message_sizes_ptr, message_sizes_len);
+    let raw_public_keys = from_raw_parts(flattened_public_keys_ptr, flattened_public_keys_len);
+    let raw_signatures = from_raw_parts(flattened_signatures_ptr, flattened_signatures_len);
+
+    if raw_public_keys.len() % PUBLIC_KEY_BYTES != 0
+        || raw_signatures.len() % SIGNATURE_BYTES != 0
+        || raw_public_keys.len() / PUBLIC_KEY_BYTES != message_sizes_len
+        || raw_signatures.len() / SIGNATURE_BYTES != message_sizes_len
+        || chunk_sizes.iter().sum::<usize>() != flattened.len()
+    {
+        return std::ptr::null_mut();
+    }
+
+    // split the flattened message array into slices of individual messages to
+    // be hashed
+    let mut messages: Vec<&[u8]> = Vec::with_capacity(message_sizes_len);
+    let mut offset = 0;
+    for chunk_size in chunk_sizes.iter() {
+        messages.push(&flattened[offset..offset + *chunk_size]);
+        offset += *chunk_size
+    }
+
+    let results: Vec<u8> = messages
+        .par_iter()
+        .zip(raw_public_keys.par_chunks(PUBLIC_KEY_BYTES))
+        .zip(raw_signatures.par_chunks(SIGNATURE_BYTES))
+        .map(|((message, raw_public_key), raw_signature)| {
+            let public_key = match PublicKey::from_bytes(raw_public_key) {
+                Ok(public_key) => public_key,
+                Err(_) => return 0,
+            };
+            let signature = match Signature::from_bytes(raw_signature) {
+                Ok(signature) => signature,
+                Err(_) => return 0,
+            };
+
+            verify_messages_sig(&signature, &[*message], &[public_key]) as u8
+        })
+        .collect();
+
+    let results = results.into_boxed_slice();
+    let response = types::fil_HashVerifyBatchResponse {
+        results_len: results.len(),
+        results_ptr: Box::into_raw(results) as *const u8,
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Generate a new private key
+#[no_mangle]
+pub unsafe extern "C" fn fil_private_key_generate() -> *mut types::fil_PrivateKeyGenerateResponse {
+    let mut raw_private_key: [u8; PRIVATE_KEY_BYTES] = [0; PRIVATE_KEY_BYTES];
+    PrivateKey::generate(&mut OsRng)
+        .write_bytes(&mut raw_private_key.as_mut())
+        .expect("preallocated");
+
+    let response = types::fil_PrivateKeyGenerateResponse {
+        private_key: fil_BLSPrivateKey {
+            inner: raw_private_key,
+        },
+    };
 
     Box::into_raw(Box::new(response))
 }
@@ -284,7 +791,7 @@ pub unsafe extern "C" fn fil_private_key_generate_with_seed(
 /// * `message_ptr` - pointer to a message byte array
 /// * `message_len` - length of the byte array
 ///
-/// Returns `NULL` when passed invalid arguments.
+/// Returns `NULL` when passed invalid arguments, including the all-zero private key.
 #[no_mangle]
 pub unsafe extern "C" fn fil_private_key_sign(
     raw_private_key_ptr: *const u8,
@@ -294,7 +801,7 @@ pub unsafe extern "C" fn fil_private_key_sign(
     // prep request
     let private_key_slice = from_raw_parts(raw_private_key_ptr, PRIVATE_KEY_BYTES);
     let private_key = try_ffi!(
-        PrivateKey::from_bytes(private_key_slice),
+        private_key_from_bytes(private_key_slice),
         std::ptr::null_mut()
     );
     let message = from_raw_parts(message_ptr, message_len);
@@ -313,20 +820,158 @@ pub unsafe extern "C" fn fil_private_key_sign(
     Box::into_raw(Box::new(response))
 }
 
+/// Sign a message with a private key using a custom domain separation tag and
+/// return the signature
+///
+/// # Arguments
+///
+/// * `raw_private_key_ptr` - pointer to a private key byte array
+/// * `message_ptr`         - pointer to a message byte array
+/// * `message_len`         - length of the byte array
+/// * `dst_ptr`             - pointer to a domain separation tag byte array
+/// * `dst_len`             - length of the domain separation tag (1 to 255 bytes)
+///
+/// Returns `NULL` when passed invalid arguments, including the all-zero private key.
+#[no_mangle]
+pub unsafe extern "C" fn fil_private_key_sign_with_dst(
+    raw_private_key_ptr: *const u8,
+    message_ptr: *const u8,
+    message_len: libc::size_t,
+    dst_ptr: *const u8,
+    dst_len: libc::size_t,
+) -> *mut types::fil_PrivateKeySignResponse {
+    // prep request
+    let private_key_slice = from_raw_parts(raw_private_key_ptr, PRIVATE_KEY_BYTES);
+    let private_key = try_ffi!(
+        private_key_from_bytes(private_key_slice),
+        std::ptr::null_mut()
+    );
+    let message = from_raw_parts(message_ptr, message_len);
+    let dst = from_raw_parts(dst_ptr, dst_len);
+
+    let hash = match hash_with_dst(message, dst) {
+        Some(hash) => hash,
+        None => return std::ptr::null_mut(),
+    };
+    let signature: Signature = (hash * Scalar::from(*private_key)).into();
+
+    let mut raw_signature: [u8; SIGNATURE_BYTES] = [0; SIGNATURE_BYTES];
+    signature
+        .write_bytes(&mut raw_signature.as_mut())
+        .expect("preallocated");
+
+    let response = types::fil_PrivateKeySignResponse {
+        signature: fil_BLSSignature {
+            inner: raw_signature,
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Sign a digest, as produced by `fil_hash` or `fil_map_to_g2`, with a private key
+/// and return the signature
+///
+/// # Arguments
+///
+/// * `raw_private_key_ptr` - pointer to a private key byte array
+/// * `digest_ptr`          - pointer to a digest byte array (DIGEST_BYTES long)
+///
+/// Returns `NULL` when passed invalid arguments, including the all-zero private key
+/// and digests which are not points of the prime order subgroup.
+#[no_mangle]
+pub unsafe extern "C" fn fil_private_key_sign_digest(
+    raw_private_key_ptr: *const u8,
+    digest_ptr: *const u8,
+) -> *mut types::fil_PrivateKeySignResponse {
+    // prep request
+    let private_key_slice = from_raw_parts(raw_private_key_ptr, PRIVATE_KEY_BYTES);
+    let private_key = try_ffi!(
+        private_key_from_bytes(private_key_slice),
+        std::ptr::null_mut()
+    );
+
+    let mut raw_digest = [0u8; DIGEST_BYTES];
+    raw_digest.copy_from_slice(from_raw_parts(digest_ptr, DIGEST_BYTES));
+
+    let digest: Option<G2Affine> = G2Affine::from_compressed(&raw_digest).into();
+    let digest = match digest {
+        Some(digest) => G2Projective::from(digest),
+        None => return std::ptr::null_mut(),
+    };
+    let signature: Signature = (digest * Scalar::from(*private_key)).into();
+
+    let mut raw_signature: [u8; SIGNATURE_BYTES] = [0; SIGNATURE_BYTES];
+    signature
+        .write_bytes(&mut raw_signature.as_mut())
+        .expect("preallocated");
+
+    let response = types::fil_PrivateKeySignResponse {
+        signature: fil_BLSSignature {
+            inner: raw_signature,
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Map two elements of Fp2 to a point of G2, which is the final step of hashing
+/// a message to the curve once the message has been expanded and hashed to the
+/// field. This allows the expansion of the message to be streamed by the caller.
+///
+/// # Arguments
+///
+/// * `field_elements_ptr` - pointer to the big endian encodings of the elements `u0`
+///                          and `u1` of Fp2, reduced modulo p, each given as its `c0`
+///                          then `c1` coefficient (4 * FIELD_ELEMENT_BYTES long)
+#[no_mangle]
+pub unsafe extern "C" fn fil_map_to_g2(
+    field_elements_ptr: *const u8,
+) -> *mut types::fil_HashResponse {
+    let raw_elements = from_raw_parts(field_elements_ptr, 4 * FIELD_ELEMENT_BYTES);
+
+    let mut elements = [blst::blst_fp::default(); 4];
+    for (element, raw_element) in elements
+        .iter_mut()
+        .zip(raw_elements.chunks(FIELD_ELEMENT_BYTES))
+    {
+        blst::blst_fp_from_bendian(element, raw_element.as_ptr());
+    }
+
+    let u0 = blst::blst_fp2 {
+        fp: [elements[0], elements[1]],
+    };
+    let u1 = blst::blst_fp2 {
+        fp: [elements[2], elements[3]],
+    };
+
+    let mut point = blst::blst_p2::default();
+    blst::blst_map_to_g2(&mut point, &u0, &u1);
+
+    let mut raw_digest: [u8; DIGEST_BYTES] = [0; DIGEST_BYTES];
+    blst::blst_p2_compress(raw_digest.as_mut_ptr(), &point);
+
+    let response = types::fil_HashResponse {
+        digest: fil_BLSDigest { inner: raw_digest },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
 /// Generate the public key for a private key
 ///
 /// # Arguments
 ///
 /// * `raw_private_key_ptr` - pointer to a private key byte array
 ///
-/// Returns `NULL` when passed invalid arguments.
+/// Returns `NULL` when passed invalid arguments, including the all-zero private key.
 #[no_mangle]
 pub unsafe extern "C" fn fil_private_key_public_key(
     raw_private_key_ptr: *const u8,
 ) -> *mut types::fil_PrivateKeyPublicKeyResponse {
     let private_key_slice = from_raw_parts(raw_private_key_ptr, PRIVATE_KEY_BYTES);
     let private_key = try_ffi!(
-        PrivateKey::from_bytes(private_key_slice),
+        private_key_from_bytes(private_key_slice),
         std::ptr::null_mut()
     );
 
@@ -345,6 +990,366 @@ pub unsafe extern "C" fn fil_private_key_public_key(
     Box::into_raw(Box::new(response))
 }
 
+/// Import a private key into a signer held by the FFI, so that it can be used to
+/// sign without being passed in again
+///
+/// # Arguments
+///
+/// * `raw_private_key_ptr` - pointer to a private key byte array
+///
+/// Returns `NULL` when passed an invalid private key. The signer must be freed
+/// with `fil_destroy_bls_signer`, which wipes the private key.
+#[no_mangle]
+pub unsafe extern "C" fn fil_bls_signer_new(
+    raw_private_key_ptr: *const u8,
+) -> *mut types::fil_BLSSigner {
+    let private_key_slice = from_raw_parts(raw_private_key_ptr, PRIVATE_KEY_BYTES);
+    try_ffi!(
+        private_key_from_bytes(private_key_slice),
+        std::ptr::null_mut()
+    );
+
+    // copy the key straight into its heap allocation rather than moving it there
+    let mut signer = Box::new(types::fil_BLSSigner {
+        private_key: fil_BLSPrivateKey {
+            inner: [0; PRIVATE_KEY_BYTES],
+        },
+    });
+    signer.private_key.inner.copy_from_slice(private_key_slice);
+
+    Box::into_raw(signer)
+}
+
+/// Sign a message with the private key of a signer and return the signature
+///
+/// # Arguments
+///
+/// * `signer_ptr`  - pointer to a signer created by `fil_bls_signer_new`
+/// * `message_ptr` - pointer to a message byte array
+/// * `message_len` - length of the byte array
+#[no_mangle]
+pub unsafe extern "C" fn fil_bls_signer_sign(
+    signer_ptr: *const types::fil_BLSSigner,
+    message_ptr: *const u8,
+    message_len: libc::size_t,
+) -> *mut types::fil_PrivateKeySignResponse {
+    fil_private_key_sign(
+        (*signer_ptr).private_key.inner.as_ptr(),
+        message_ptr,
+        message_len,
+    )
+}
+
+/// Generate the public key of the private key of a signer
+///
+/// # Arguments
+///
+/// * `signer_ptr` - pointer to a signer created by `fil_bls_signer_new`
+#[no_mangle]
+pub unsafe extern "C" fn fil_bls_signer_public_key(
+    signer_ptr: *const types::fil_BLSSigner,
+) -> *mut types::fil_PrivateKeyPublicKeyResponse {
+    fil_private_key_public_key((*signer_ptr).private_key.inner.as_ptr())
+}
+
+/// Generate the public keys for many private keys at once
+///
+/// # Arguments
+///
+/// * `flattened_private_keys_ptr` - pointer to a byte array containing private keys
+/// * `flattened_private_keys_len` - length of the byte array (multiple of PRIVATE_KEY_BYTES)
+///
+/// Returns `NULL` when the length is not a multiple of PRIVATE_KEY_BYTES. When any
+/// private key is invalid, including the all-zero private key, no public keys are
+/// returned and `invalid_index` is set to the index of the first invalid key.
+#[no_mangle]
+pub unsafe extern "C" fn fil_private_keys_public_keys(
+    flattened_private_keys_ptr: *const u8,
+    flattened_private_keys_len: libc::size_t,
+) -> *mut types::fil_PrivateKeysPublicKeysResponse {
+    if flattened_private_keys_len % PRIVATE_KEY_BYTES != 0 {
+        return std::ptr::null_mut();
+    }
+
+    let public_keys: Vec<Option<[u8; PUBLIC_KEY_BYTES]>> =
+        from_raw_parts(flattened_private_keys_ptr, flattened_private_keys_len)
+            .par_chunks(PRIVATE_KEY_BYTES)
+            .map(|item| {
+                let private_key = private_key_from_bytes(item).ok()?;
+
+                let mut raw_public_key: [u8; PUBLIC_KEY_BYTES] = [0; PUBLIC_KEY_BYTES];
+                private_key
+                    .public_key()
+                    .write_bytes(&mut raw_public_key.as_mut())
+                    .expect("preallocated");
+
+                Some(raw_public_key)
+            })
+            .collect();
+
+    let mut response = types::fil_PrivateKeysPublicKeysResponse::default();
+
+    if let Some(index) = public_keys.iter().position(Option::is_none) {
+        response.has_invalid_key = true;
+        response.invalid_index = index;
+    } else {
+        let flattened: Vec<u8> = public_keys
+            .iter()
+            .flatten()
+            .flat_map(|public_key| public_key.iter().copied())
+            .collect();
+        let flattened = flattened.into_boxed_slice();
+
+        response.public_keys_len = flattened.len();
+        response.public_keys_ptr = Box::into_raw(flattened) as *const u8;
+    }
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Generate a proof of possession for a private key, which is a signature of
+/// its public key under the proof-of-possession domain separation tag
+///
+/// # Arguments
+///
+/// * `raw_private_key_ptr` - pointer to a private key byte array
+///
+/// Returns `NULL` when passed invalid arguments, including the all-zero private key.
+#[no_mangle]
+pub unsafe extern "C" fn fil_pop_prove(
+    raw_private_key_ptr: *const u8,
+) -> *mut types::fil_PopProveResponse {
+    let private_key_slice = from_raw_parts(raw_private_key_ptr, PRIVATE_KEY_BYTES);
+    let private_key = try_ffi!(
+        private_key_from_bytes(private_key_slice),
+        std::ptr::null_mut()
+    );
+
+    let raw_public_key = private_key.public_key().as_bytes();
+    let hash = G2Projective::hash_to_curve(&raw_public_key, POP_DST, &[]);
+    let pop: Signature = (hash * Scalar::from(*private_key)).into();
+
+    let mut raw_signature: [u8; SIGNATURE_BYTES] = [0; SIGNATURE_BYTES];
+    pop.write_bytes(&mut raw_signature.as_mut())
+        .expect("preallocated");
+
+    let response = types::fil_PopProveResponse {
+        signature: fil_BLSSignature {
+            inner: raw_signature,
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Verify a proof of possession generated by `fil_pop_prove` for a public key
+///
+/// # Arguments
+///
+/// * `public_key_ptr` - pointer to a public key byte array (PUBLIC_KEY_BYTES long)
+/// * `signature_ptr`  - pointer to a proof of possession byte array (SIGNATURE_BYTES long)
+#[no_mangle]
+pub unsafe extern "C" fn fil_pop_verify(
+    public_key_ptr: *const u8,
+    signature_ptr: *const u8,
+) -> libc::c_int {
+    let raw_public_key = from_raw_parts(public_key_ptr, PUBLIC_KEY_BYTES);
+    let public_key = try_ffi!(PublicKey::from_bytes(raw_public_key), 0);
+
+    let raw_signature = from_raw_parts(signature_ptr, SIGNATURE_BYTES);
+    let signature = try_ffi!(Signature::from_bytes(raw_signature), 0);
+
+    let hash = G2Projective::hash_to_curve(raw_public_key, POP_DST, &[]);
+
+    verify_sig(&signature, &[hash], &[public_key]) as libc::c_int
+}
+
+/// Check that a public key is the compressed encoding of a point on the curve,
+/// that the point is in the prime order subgroup and that it is not the point
+/// at infinity
+///
+/// # Arguments
+///
+/// * `public_key_ptr` - pointer to a public key byte array (PUBLIC_KEY_BYTES long)
+#[no_mangle]
+pub unsafe extern "C" fn fil_validate_public_key(
+    public_key_ptr: *const u8,
+) -> types::fil_BLSPointValidation {
+    let mut raw_public_key = [0u8; PUBLIC_KEY_BYTES];
+    raw_public_key.copy_from_slice(from_raw_parts(public_key_ptr, PUBLIC_KEY_BYTES));
+
+    let point: Option<G1Affine> = G1Affine::from_compressed_unchecked(&raw_public_key).into();
+    let point = match point {
+        Some(point) => point,
+        None => return types::fil_BLSPointValidation::InvalidEncoding,
+    };
+
+    if bool::from(point.is_identity()) {
+        return types::fil_BLSPointValidation::Identity;
+    }
+
+    if !bool::from(point.is_torsion_free()) {
+        return types::fil_BLSPointValidation::NotInSubgroup;
+    }
+
+    types::fil_BLSPointValidation::Valid
+}
+
+/// Check that a signature is the compressed encoding of a point on the curve,
+/// that the point is in the prime order subgroup and that it is not the point
+/// at infinity
+///
+/// # Arguments
+///
+/// * `signature_ptr` - pointer to a signature byte array (SIGNATURE_BYTES long)
+#[no_mangle]
+pub unsafe extern "C" fn fil_validate_signature(
+    signature_ptr: *const u8,
+) -> types::fil_BLSPointValidation {
+    let mut raw_signature = [0u8; SIGNATURE_BYTES];
+    raw_signature.copy_from_slice(from_raw_parts(signature_ptr, SIGNATURE_BYTES));
+
+    let point: Option<G2Affine> = G2Affine::from_compressed_unchecked(&raw_signature).into();
+    let point = match point {
+        Some(point) => point,
+        None => return types::fil_BLSPointValidation::InvalidEncoding,
+    };
+
+    if bool::from(point.is_identity()) {
+        return types::fil_BLSPointValidation::Identity;
+    }
+
+    if !bool::from(point.is_torsion_free()) {
+        return types::fil_BLSPointValidation::NotInSubgroup;
+    }
+
+    types::fil_BLSPointValidation::Valid
+}
+
+/// Convert a compressed public key into its uncompressed encoding
+///
+/// # Arguments
+///
+/// * `public_key_ptr` - pointer to a public key byte array (PUBLIC_KEY_BYTES long)
+///
+/// Returns `NULL` when the public key is not a valid point in the subgroup.
+#[no_mangle]
+pub unsafe extern "C" fn fil_public_key_to_uncompressed(
+    public_key_ptr: *const u8,
+) -> *mut types::fil_PublicKeyToUncompressedResponse {
+    let mut raw_public_key = [0u8; PUBLIC_KEY_BYTES];
+    raw_public_key.copy_from_slice(from_raw_parts(public_key_ptr, PUBLIC_KEY_BYTES));
+
+    let point: Option<G1Affine> = G1Affine::from_compressed(&raw_public_key).into();
+    let point = match point {
+        Some(point) => point,
+        None => return std::ptr::null_mut(),
+    };
+
+    let response = types::fil_PublicKeyToUncompressedResponse {
+        public_key: fil_BLSUncompressedPublicKey {
+            inner: point.to_uncompressed(),
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Convert an uncompressed public key into its compressed encoding
+///
+/// # Arguments
+///
+/// * `uncompressed_public_key_ptr` - pointer to an uncompressed public key byte array
+///                                   (UNCOMPRESSED_PUBLIC_KEY_BYTES long)
+///
+/// Returns `NULL` when the public key is not a valid point in the subgroup.
+#[no_mangle]
+pub unsafe extern "C" fn fil_public_key_from_uncompressed(
+    uncompressed_public_key_ptr: *const u8,
+) -> *mut types::fil_PublicKeyFromUncompressedResponse {
+    let mut raw_public_key = [0u8; UNCOMPRESSED_PUBLIC_KEY_BYTES];
+    raw_public_key.copy_from_slice(from_raw_parts(
+        uncompressed_public_key_ptr,
+        UNCOMPRESSED_PUBLIC_KEY_BYTES,
+    ));
+
+    let point: Option<G1Affine> = G1Affine::from_uncompressed(&raw_public_key).into();
+    let point = match point {
+        Some(point) => point,
+        None => return std::ptr::null_mut(),
+    };
+
+    let response = types::fil_PublicKeyFromUncompressedResponse {
+        public_key: fil_BLSPublicKey {
+            inner: point.to_compressed(),
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Convert a compressed signature into its uncompressed encoding
+///
+/// # Arguments
+///
+/// * `signature_ptr` - pointer to a signature byte array (SIGNATURE_BYTES long)
+///
+/// Returns `NULL` when the signature is not a valid point in the subgroup.
+#[no_mangle]
+pub unsafe extern "C" fn fil_signature_to_uncompressed(
+    signature_ptr: *const u8,
+) -> *mut types::fil_SignatureToUncompressedResponse {
+    let mut raw_signature = [0u8; SIGNATURE_BYTES];
+    raw_signature.copy_from_slice(from_raw_parts(signature_ptr, SIGNATURE_BYTES));
+
+    let point: Option<G2Affine> = G2Affine::from_compressed(&raw_signature).into();
+    let point = match point {
+        Some(point) => point,
+        None => return std::ptr::null_mut(),
+    };
+
+    let response = types::fil_SignatureToUncompressedResponse {
+        signature: fil_BLSUncompressedSignature {
+            inner: point.to_uncompressed(),
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Convert an uncompressed signature into its compressed encoding
+///
+/// # Arguments
+///
+/// * `uncompressed_signature_ptr` - pointer to an uncompressed signature byte array
+///                                  (UNCOMPRESSED_SIGNATURE_BYTES long)
+///
+/// Returns `NULL` when the signature is not a valid point in the subgroup.
+#[no_mangle]
+pub unsafe extern "C" fn fil_signature_from_uncompressed(
+    uncompressed_signature_ptr: *const u8,
+) -> *mut types::fil_SignatureFromUncompressedResponse {
+    let mut raw_signature = [0u8; UNCOMPRESSED_SIGNATURE_BYTES];
+    raw_signature.copy_from_slice(from_raw_parts(
+        uncompressed_signature_ptr,
+        UNCOMPRESSED_SIGNATURE_BYTES,
+    ));
+
+    let point: Option<G2Affine> = G2Affine::from_uncompressed(&raw_signature).into();
+    let point = match point {
+        Some(point) => point,
+        None => return std::ptr::null_mut(),
+    };
+
+    let response = types::fil_SignatureFromUncompressedResponse {
+        signature: fil_BLSSignature {
+            inner: point.to_compressed(),
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
 /// Returns a zero signature, used as placeholder in Filecoin.
 ///
 /// The return value is a pointer to a compressed signature in bytes, of length `SIGNATURE_BYTES`
@@ -372,6 +1377,165 @@ pub unsafe extern "C" fn fil_drop_signature(sig: *mut u8) {
     let _: &[u8] = std::slice::from_raw_parts_mut(sig, SIGNATURE_BYTES);
 }
 
+/// Generate the public key in G2 of a private key, for the min-sig variant
+///
+/// # Arguments
+///
+/// * `raw_private_key_ptr` - pointer to a private key byte array
+///
+/// Returns `NULL` when passed an invalid private key.
+#[no_mangle]
+pub unsafe extern "C" fn fil_private_key_public_key_g2(
+    raw_private_key_ptr: *const u8,
+) -> *mut types::fil_PrivateKeyPublicKeyG2Response {
+    let private_key_slice = from_raw_parts(raw_private_key_ptr, PRIVATE_KEY_BYTES);
+    let secret_key = match min_sig_secret_key(private_key_slice) {
+        Some(secret_key) => secret_key,
+        None => return std::ptr::null_mut(),
+    };
+
+    let response = types::fil_PrivateKeyPublicKeyG2Response {
+        public_key: fil_BLSPublicKeyG2 {
+            inner: secret_key.sk_to_pk().compress(),
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Sign a message with a private key in the min-sig variant, producing a
+/// signature in G1
+///
+/// # Arguments
+///
+/// * `raw_private_key_ptr` - pointer to a private key byte array
+/// * `message_ptr`         - pointer to a message byte array
+/// * `message_len`         - length of the byte array
+///
+/// Returns `NULL` when passed an invalid private key.
+#[no_mangle]
+pub unsafe extern "C" fn fil_private_key_sign_g1(
+    raw_private_key_ptr: *const u8,
+    message_ptr: *const u8,
+    message_len: libc::size_t,
+) -> *mut types::fil_SignatureG1Response {
+    let private_key_slice = from_raw_parts(raw_private_key_ptr, PRIVATE_KEY_BYTES);
+    let secret_key = match min_sig_secret_key(private_key_slice) {
+        Some(secret_key) => secret_key,
+        None => return std::ptr::null_mut(),
+    };
+    let message = from_raw_parts(message_ptr, message_len);
+
+    let response = types::fil_SignatureG1Response {
+        signature: fil_BLSSignatureG1 {
+            inner: secret_key.sign(message, MIN_SIG_DST, &[]).compress(),
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Aggregate signatures of the min-sig variant together into a new signature
+///
+/// # Arguments
+///
+/// * `flattened_signatures_ptr` - pointer to a byte array containing signatures
+/// * `flattened_signatures_len` - length of the byte array (multiple of SIGNATURE_G1_BYTES)
+///
+/// Returns `NULL` on error, including when a signature is not in the prime order subgroup.
+#[no_mangle]
+pub unsafe extern "C" fn fil_aggregate_g1(
+    flattened_signatures_ptr: *const u8,
+    flattened_signatures_len: libc::size_t,
+) -> *mut types::fil_SignatureG1Response {
+    let raw_signatures = from_raw_parts(flattened_signatures_ptr, flattened_signatures_len);
+    if raw_signatures.is_empty() || raw_signatures.len() % SIGNATURE_G1_BYTES != 0 {
+        return std::ptr::null_mut();
+    }
+
+    let signatures = try_ffi!(
+        raw_signatures
+            .chunks(SIGNATURE_G1_BYTES)
+            .map(blst::min_sig::Signature::from_bytes)
+            .collect::<Result<Vec<_>, _>>(),
+        std::ptr::null_mut()
+    );
+    let signature_refs: Vec<_> = signatures.iter().collect();
+
+    let aggregated = try_ffi!(
+        blst::min_sig::AggregateSignature::aggregate(&signature_refs, true),
+        std::ptr::null_mut()
+    );
+
+    let response = types::fil_SignatureG1Response {
+        signature: fil_BLSSignatureG1 {
+            inner: aggregated.to_signature().compress(),
+        },
+    };
+
+    Box::into_raw(Box::new(response))
+}
+
+/// Verify that a signature of the min-sig variant is the aggregated signature
+/// of the hashed messages, which must be distinct
+///
+/// # Arguments
+///
+/// * `signature_ptr`             - pointer to a signature byte array (SIGNATURE_G1_BYTES long)
+/// * `flattened_messages_ptr`    - pointer to a byte array containing the messages
+/// * `flattened_messages_len`    - length of the byte array
+/// * `message_sizes_ptr`         - pointer to an array containing the lengths of the messages
+/// * `message_sizes_len`         - length of the array
+/// * `flattened_public_keys_ptr` - pointer to a byte array containing public keys
+/// * `flattened_public_keys_len` - length of the array (multiple of PUBLIC_KEY_G2_BYTES)
+#[no_mangle]
+pub unsafe extern "C" fn fil_hash_verify_g1(
+    signature_ptr: *const u8,
+    flattened_messages_ptr: *const u8,
+    flattened_messages_len: libc::size_t,
+    message_sizes_ptr: *const libc::size_t,
+    message_sizes_len: libc::size_t,
+    flattened_public_keys_ptr: *const u8,
+    flattened_public_keys_len: libc::size_t,
+) -> libc::c_int {
+    let raw_signature = from_raw_parts(signature_ptr, SIGNATURE_G1_BYTES);
+    let signature = try_ffi!(blst::min_sig::Signature::from_bytes(raw_signature), 0);
+
+    let flattened = from_raw_parts(flattened_messages_ptr, flattened_messages_len);
+    let chunk_sizes = from_raw_parts(message_sizes_ptr, message_sizes_len);
+
+    let mut messages: Vec<&[u8]> = Vec::with_capacity(message_sizes_len);
+    let mut offset = 0;
+    for chunk_size in chunk_sizes.iter() {
+        messages.push(&flattened[offset..offset + *chunk_size]);
+        offset += *chunk_size
+    }
+
+    // distinct messages are required, as by `fil_hash_verify`
+    let distinct: HashSet<_> = messages.iter().collect();
+    if messages.is_empty() || distinct.len() != messages.len() {
+        return 0;
+    }
+
+    let raw_public_keys = from_raw_parts(flattened_public_keys_ptr, flattened_public_keys_len);
+    if raw_public_keys.len() != messages.len() * PUBLIC_KEY_G2_BYTES {
+        return 0;
+    }
+
+    let public_keys = try_ffi!(
+        raw_public_keys
+            .chunks(PUBLIC_KEY_G2_BYTES)
+            .map(blst::min_sig::PublicKey::from_bytes)
+            .collect::<Result<Vec<_>, _>>(),
+        0
+    );
+    let public_key_refs: Vec<_> = public_keys.iter().collect();
+
+    let result = signature.aggregate_verify(true, &messages, MIN_SIG_DST, &public_key_refs, true);
+
+    (result == blst::BLST_ERROR::BLST_SUCCESS) as libc::c_int
+}
+
 #[cfg(test)]
 mod tests {
     use super::*;
@@ -440,6 +1604,54 @@ mod tests {
         }
     }
 
+    #[test]
+    fn batch_verification() {
+        unsafe {
+            let mut digests = Vec::new();
+            let mut public_keys = Vec::new();
+            let mut signatures = Vec::new();
+            for i in 0..3u8 {
+                let private_key = (*fil_private_key_generate()).private_key.inner;
+                let message = [i; 8];
+                digests.extend_from_slice(&(*fil_hash(&message[0], message.len())).digest.inner);
+                public_keys.extend_from_slice(
+                    &(*fil_private_key_public_key(&private_key[0]))
+                        .public_key
+                        .inner,
+                );
+                signatures.extend_from_slice(
+                    &(*fil_private_key_sign(&private_key[0], &message[0], message.len()))
+                        .signature
+                        .inner,
+                );
+            }
+
+            let verified = fil_verify_batch(
+                digests.as_ptr(),
+                digests.len(),
+                public_keys.as_ptr(),
+                public_keys.len(),
+                signatures.as_ptr(),
+                signatures.len(),
+            );
+            assert_eq!(1, verified);
+
+            // swap the first two signatures
+            let (first, rest) = signatures.split_at_mut(SIGNATURE_BYTES);
+            first.swap_with_slice(&mut rest[..SIGNATURE_BYTES]);
+
+            let not_verified = fil_verify_batch(
+                digests.as_ptr(),
+                digests.len(),
+                public_keys.as_ptr(),
+                public_keys.len(),
+                signatures.as_ptr(),
+                signatures.len(),
+            );
+            assert_eq!(0, not_verified);
+        }
+    }
+
     #[test]
     fn private_key_with_seed() {
         unsafe {
@@ -1,4 +1,18 @@
-use crate::bls::api::{fil_BLSDigest, fil_BLSPrivateKey, fil_BLSPublicKey, fil_BLSSignature};
+use crate::bls::api::{
+    fil_BLSDigest, fil_BLSPrivateKey, fil_BLSPublicKey, fil_BLSPublicKeyG2, fil_BLSSignature,
+    fil_BLSSignatureG1, fil_BLSUncompressedPublicKey, fil_BLSUncompressedSignature,
+};
+
+/// Outcome of validating a serialized curve point
+
+#[repr(C)]
+#[derive(Debug, Clone, Copy, PartialEq, Eq)]
+pub enum fil_BLSPointValidation {
+    Valid,
+    InvalidEncoding,
+    NotInSubgroup,
+    Identity,
+}
 
 /// HashResponse
 
@@ -24,6 +38,87 @@ pub unsafe extern "C" fn fil_destroy_aggregate_response(ptr: *mut fil_AggregateR
     let _ = Box::from_raw(ptr);
 }
 
+/// AggregatePublicKeysResponse
+
+#[repr(C)]
+pub struct fil_AggregatePublicKeysResponse {
+    pub public_key: fil_BLSPublicKey,
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_aggregate_public_keys_response(
+    ptr: *mut fil_AggregatePublicKeysResponse,
+) {
+    let _ = Box::from_raw(ptr);
+}
+
+/// HashVerifyBatchResponse
+
+#[repr(C)]
+pub struct fil_HashVerifyBatchResponse {
+    pub results_len: libc::size_t,
+    pub results_ptr: *const u8,
+}
+
+impl Drop for fil_HashVerifyBatchResponse {
+    fn drop(&mut self) {
+        let _ = unsafe {
+            Box::from_raw(std::slice::from_raw_parts_mut(
+                self.results_ptr as *mut u8,
+                self.results_len,
+            ))
+        };
+    }
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_hash_verify_batch_response(
+    ptr: *mut fil_HashVerifyBatchResponse,
+) {
+    let _ = Box::from_raw(ptr);
+}
+
+/// PrivateKeysPublicKeysResponse
+
+#[repr(C)]
+pub struct fil_PrivateKeysPublicKeysResponse {
+    pub public_keys_len: libc::size_t,
+    pub public_keys_ptr: *const u8,
+    pub has_invalid_key: bool,
+    pub invalid_index: libc::size_t,
+}
+
+impl Default for fil_PrivateKeysPublicKeysResponse {
+    fn default() -> Self {
+        fil_PrivateKeysPublicKeysResponse {
+            public_keys_len: 0,
+            public_keys_ptr: std::ptr::null(),
+            has_invalid_key: false,
+            invalid_index: 0,
+        }
+    }
+}
+
+impl Drop for fil_PrivateKeysPublicKeysResponse {
+    fn drop(&mut self) {
+        if !self.public_keys_ptr.is_null() {
+            let _ = unsafe {
+                Box::from_raw(std::slice::from_raw_parts_mut(
+                    self.public_keys_ptr as *mut u8,
+                    self.public_keys_len,
+                ))
+            };
+        }
+    }
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_private_keys_public_keys_response(
+    ptr: *mut fil_PrivateKeysPublicKeysResponse,
+) {
+    let _ = Box::from_raw(ptr);
+}
+
 /// PrivateKeyGenerateResponse
 
 #[repr(C)]
@@ -66,6 +161,93 @@ pub unsafe extern "C" fn fil_destroy_private_key_public_key_response(
     let _ = Box::from_raw(ptr);
 }
 
+/// BLSSigner
+
+/// A private key held by the FFI, as created by `fil_bls_signer_new`
+#[repr(C)]
+pub struct fil_BLSSigner {
+    pub private_key: fil_BLSPrivateKey,
+}
+
+/// Wipe the private key of a signer and free it
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_bls_signer(ptr: *mut fil_BLSSigner) {
+    let mut signer = Box::from_raw(ptr);
+
+    // volatile writes, so that wiping memory about to be freed isn't optimized away
+    for byte in signer.private_key.inner.iter_mut() {
+        std::ptr::write_volatile(byte, 0);
+    }
+}
+
+/// PopProveResponse
+
+#[repr(C)]
+pub struct fil_PopProveResponse {
+    pub signature: fil_BLSSignature,
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_pop_prove_response(ptr: *mut fil_PopProveResponse) {
+    let _ = Box::from_raw(ptr);
+}
+
+/// PublicKeyToUncompressedResponse
+
+#[repr(C)]
+pub struct fil_PublicKeyToUncompressedResponse {
+    pub public_key: fil_BLSUncompressedPublicKey,
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_public_key_to_uncompressed_response(
+    ptr: *mut fil_PublicKeyToUncompressedResponse,
+) {
+    let _ = Box::from_raw(ptr);
+}
+
+/// PublicKeyFromUncompressedResponse
+
+#[repr(C)]
+pub struct fil_PublicKeyFromUncompressedResponse {
+    pub public_key: fil_BLSPublicKey,
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_public_key_from_uncompressed_response(
+    ptr: *mut fil_PublicKeyFromUncompressedResponse,
+) {
+    let _ = Box::from_raw(ptr);
+}
+
+/// SignatureToUncompressedResponse
+
+#[repr(C)]
+pub struct fil_SignatureToUncompressedResponse {
+    pub signature: fil_BLSUncompressedSignature,
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_signature_to_uncompressed_response(
+    ptr: *mut fil_SignatureToUncompressedResponse,
+) {
+    let _ = Box::from_raw(ptr);
+}
+
+/// SignatureFromUncompressedResponse
+
+#[repr(C)]
+pub struct fil_SignatureFromUncompressedResponse {
+    pub signature: fil_BLSSignature,
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_signature_from_uncompressed_response(
+    ptr: *mut fil_SignatureFromUncompressedResponse,
+) {
+    let _ = Box::from_raw(ptr);
+}
+
 /// AggregateResponse
 
 #[repr(C)]
@@ -77,3 +259,29 @@ pub struct fil_ZeroSignatureResponse {
 pub unsafe extern "C" fn fil_destroy_zero_signature_response(ptr: *mut fil_ZeroSignatureResponse) {
     let _ = Box::from_raw(ptr);
 }
+
+/// PrivateKeyPublicKeyG2Response
+
+#[repr(C)]
+pub struct fil_PrivateKeyPublicKeyG2Response {
+    pub public_key: fil_BLSPublicKeyG2,
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_private_key_public_key_g2_response(
+    ptr: *mut fil_PrivateKeyPublicKeyG2Response,
+) {
+    let _ = Box::from_raw(ptr);
+}
+
+/// SignatureG1Response
+
+#[repr(C)]
+pub struct fil_SignatureG1Response {
+    pub signature: fil_BLSSignatureG1,
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_signature_g1_response(ptr: *mut fil_SignatureG1Response) {
+    let _ = Box::from_raw(ptr);
+}
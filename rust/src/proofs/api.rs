@@ -5,7 +5,8 @@ use filecoin_proofs_api::seal::{
     add_piece, aggregate_seal_commit_proofs, clear_cache, compute_comm_d, fauxrep, fauxrep2,
     generate_piece_commitment, get_seal_inputs, seal_commit_phase1, seal_commit_phase2,
     seal_pre_commit_phase1, seal_pre_commit_phase2, verify_aggregate_seal_commit_proofs,
-    verify_seal, write_and_preprocess, SealCommitPhase2Output, SealPreCommitPhase2Output,
+    verify_seal, write_and_preprocess, SealCommitPhase1Output, SealCommitPhase2Output,
+    SealPreCommitPhase2Output,
 };
 use filecoin_proofs_api::update::{
     empty_sector_update_decode_from, empty_sector_update_encode_into,
@@ -18,12 +19,26 @@ use filecoin_proofs_api::{
     RegisteredSealProof, SectorId, StorageProofsError, UnpaddedByteIndex, UnpaddedBytesAmount,
 };
 
+use filecoin_hashers::{poseidon::PoseidonHasher, Domain, HashFunction, Hasher};
+use filecoin_proofs::{
+    DefaultPieceHasher, PaddedBytesAmount, PoRepConfig, PoRepProofPartitions, BINARY_ARITY,
+};
+use merkletree::store::StoreConfig;
+use storage_proofs_core::{
+    cache_key::CacheKey,
+    merkle::MerkleTreeTrait,
+    util::{default_rows_to_discard, NODE_SIZE},
+};
+use storage_proofs_porep::stacked::StackedDrg;
+
 use blstrs::Scalar as Fr;
 use log::{error, info};
 use rayon::prelude::*;
 
+use std::fs::{self, File};
+use std::io::{BufReader, BufWriter};
 use std::mem;
-use std::path::PathBuf;
+use std::path::{Path, PathBuf};
 use std::slice::from_raw_parts;
 
 use super::helpers::{
@@ -258,6 +273,115 @@ pub unsafe extern "C" fn fil_seal_pre_commit_phase1(
     })
 }
 
+/// Runs the SDR labeling of SealPreCommitPhase1 for the given replica id,
+/// writing the layers to cache_dir_path without building tree_d.
+#[no_mangle]
+pub unsafe extern "C" fn fil_generate_sdr(
+    registered_proof: fil_RegisteredSealProof,
+    cache_dir_path: *const libc::c_char,
+    replica_id: fil_32ByteArray,
+) -> *mut fil_GenerateSDRResponse {
+    catch_panic_response(|| {
+        init_log();
+
+        info!("generate_sdr: start");
+
+        let mut response: fil_GenerateSDRResponse = Default::default();
+
+        let registered_proof: RegisteredSealProof = registered_proof.into();
+        let porep_config = registered_proof.as_v1_config();
+        let result = filecoin_proofs::with_shape!(
+            u64::from(porep_config.sector_size),
+            generate_sdr,
+            porep_config,
+            &c_str_to_pbuf(cache_dir_path),
+            &replica_id.inner,
+        );
+
+        match result {
+            Ok(_) => {
+                response.status_code = FCPResponseStatus::FCPNoError;
+            }
+            Err(err) => {
+                response.status_code = FCPResponseStatus::FCPUnclassifiedError;
+                response.error_msg = rust_str_to_c_str(format!("{:?}", err));
+            }
+        }
+
+        info!("generate_sdr: finish");
+
+        raw_ptr(response)
+    })
+}
+
+fn generate_sdr<Tree: 'static + MerkleTreeTrait>(
+    porep_config: PoRepConfig,
+    cache_path: &Path,
+    replica_id: &[u8; 32],
+) -> anyhow::Result<()> {
+    let public_params = filecoin_proofs::parameters::public_params::<Tree>(
+        PaddedBytesAmount::from(porep_config),
+        usize::from(PoRepProofPartitions::from(porep_config)),
+        porep_config.porep_id,
+        porep_config.api_version,
+    )?;
+
+    // the same store configuration as seal_pre_commit_phase1, so that the
+    // layers end up where seal_pre_commit_phase2 looks for them
+    let nodes = u64::from(porep_config.sector_size) as usize / NODE_SIZE;
+    let config = StoreConfig::new(
+        cache_path,
+        CacheKey::CommDTree.to_string(),
+        default_rows_to_discard(nodes, BINARY_ARITY),
+    );
+
+    let replica_id = <Tree::Hasher as Hasher>::Domain::try_from_bytes(replica_id)?;
+    StackedDrg::<Tree, DefaultPieceHasher>::replicate_phase1(&public_params, &replica_id, config)?;
+
+    Ok(())
+}
+
+/// Computes comm_r from the comm_c and comm_r_last stored in p_aux, as the
+/// Poseidon hash of the two, like seal_pre_commit_phase2 does.
+#[no_mangle]
+pub unsafe extern "C" fn fil_compute_comm_r(
+    comm_c: fil_32ByteArray,
+    comm_r_last: fil_32ByteArray,
+) -> *mut fil_ComputeCommRResponse {
+    catch_panic_response(|| {
+        init_log();
+
+        info!("compute_comm_r: start");
+
+        let mut response: fil_ComputeCommRResponse = Default::default();
+
+        match compute_comm_r(&comm_c.inner, &comm_r_last.inner) {
+            Ok(comm_r) => {
+                response.status_code = FCPResponseStatus::FCPNoError;
+                response.comm_r = comm_r;
+            }
+            Err(err) => {
+                response.status_code = FCPResponseStatus::FCPUnclassifiedError;
+                response.error_msg = rust_str_to_c_str(format!("{:?}", err));
+            }
+        }
+
+        info!("compute_comm_r: finish");
+
+        raw_ptr(response)
+    })
+}
+
+fn compute_comm_r(comm_c: &[u8; 32], comm_r_last: &[u8; 32]) -> anyhow::Result<[u8; 32]> {
+    let comm_c = <PoseidonHasher as Hasher>::Domain::try_from_bytes(comm_c)?;
+    let comm_r_last = <PoseidonHasher as Hasher>::Domain::try_from_bytes(comm_r_last)?;
+    let comm_r = <PoseidonHasher as Hasher>::Function::hash2(&comm_c, &comm_r_last);
+
+    let mut out = [0u8; 32];
+    out.copy_from_slice(&comm_r.into_bytes());
+    Ok(out)
+}
+
 /// TODO: document
 ///
 #[no_mangle]
@@ -370,6 +494,94 @@ pub unsafe extern "C" fn fil_seal_commit_phase1(
     })
 }
 
+/// Like fil_seal_commit_phase1, but writes the serialized output to
+/// output_path instead of returning it. The output is written to a temporary
+/// file next to output_path, which replaces output_path once it is complete.
+#[no_mangle]
+pub unsafe extern "C" fn fil_seal_commit_phase1_to_file(
+    registered_proof: fil_RegisteredSealProof,
+    comm_r: fil_32ByteArray,
+    comm_d: fil_32ByteArray,
+    cache_dir_path: *const libc::c_char,
+    replica_path: *const libc::c_char,
+    sector_id: u64,
+    prover_id: fil_32ByteArray,
+    ticket: fil_32ByteArray,
+    seed: fil_32ByteArray,
+    pieces_ptr: *const fil_PublicPieceInfo,
+    pieces_len: libc::size_t,
+    output_path: *const libc::c_char,
+) -> *mut fil_SealCommitPhase1ToFileResponse {
+    catch_panic_response(|| {
+        init_log();
+
+        info!("seal_commit_phase1_to_file: start");
+
+        let mut response = fil_SealCommitPhase1ToFileResponse::default();
+
+        let spcp2o = SealPreCommitPhase2Output {
+            registered_proof: registered_proof.into(),
+            comm_r: comm_r.inner,
+            comm_d: comm_d.inner,
+        };
+
+        let slice: &[fil_PublicPieceInfo] = std::slice::from_raw_parts(pieces_ptr, pieces_len);
+        let public_pieces: Vec<PieceInfo> =
+            slice.to_vec().iter().cloned().map(Into::into).collect();
+
+        let result = seal_commit_phase1(
+            c_str_to_pbuf(cache_dir_path),
+            c_str_to_pbuf(replica_path),
+            prover_id.inner,
+            SectorId::from(sector_id),
+            ticket.inner,
+            seed.inner,
+            spcp2o,
+            &public_pieces,
+        );
+
+        match result.and_then(|output| {
+            write_seal_commit_phase1_output(&output, &c_str_to_pbuf(output_path))
+        }) {
+            Ok(()) => {
+                response.status_code = FCPResponseStatus::FCPNoError;
+            }
+            Err(err) => {
+                response.status_code = FCPResponseStatus::FCPUnclassifiedError;
+                response.error_msg = rust_str_to_c_str(format!("{:?}", err));
+            }
+        }
+
+        info!("seal_commit_phase1_to_file: finish");
+
+        raw_ptr(response)
+    })
+}
+
+fn write_seal_commit_phase1_output(
+    output: &SealCommitPhase1Output,
+    path: &Path,
+) -> anyhow::Result<()> {
+    let mut tmp_path = path.as_os_str().to_owned();
+    tmp_path.push(".tmp");
+    let tmp_path = PathBuf::from(tmp_path);
+
+    let result = (|| -> anyhow::Result<()> {
+        let mut writer = BufWriter::new(File::create(&tmp_path)?);
+        serde_json::to_writer(&mut writer, output)?;
+        let file = writer.into_inner()?;
+        file.sync_all()?;
+        fs::rename(&tmp_path, path)?;
+        Ok(())
+    })();
+
+    if result.is_err() {
+        let _ = fs::remove_file(&tmp_path);
+    }
+
+    result
+}
+
 #[no_mangle]
 pub unsafe extern "C" fn fil_seal_commit_phase2(
     seal_commit_phase1_output_ptr: *const u8,
@@ -412,6 +624,126 @@ pub unsafe extern "C" fn fil_seal_commit_phase2(
     })
 }
 
+/// Runs fil_seal_commit_phase2 on each of the inputs in turn, all within a
+/// single call. Every input gets its own response, in input order, so that the
+/// failure of one does not discard the proofs of the others.
+///
+/// The pinned proofs library has no batched commit phase 2 prover: each input
+/// is proven by its own seal_commit_phase2 call, which sets up the GPU and its
+/// buffers again. Only the Groth parameters are shared, through the cache the
+/// library keeps across calls anyway.
+#[no_mangle]
+pub unsafe extern "C" fn fil_seal_commit_phase2_batch(
+    inputs_ptr: *const fil_SealCommitPhase2BatchInput,
+    inputs_len: libc::size_t,
+) -> *mut fil_SealCommitPhase2BatchResponse {
+    catch_panic_response(|| {
+        init_log();
+
+        info!("seal_commit_phase2_batch: start");
+
+        let mut response = fil_SealCommitPhase2BatchResponse::default();
+
+        let inputs: &[fil_SealCommitPhase2BatchInput] = from_raw_parts(inputs_ptr, inputs_len);
+        let results: Vec<fil_SealCommitPhase2Response> = inputs
+            .iter()
+            .map(|input| {
+                let mut result = fil_SealCommitPhase2Response::default();
+
+                let scp1o = serde_json::from_slice(from_raw_parts(
+                    input.seal_commit_phase1_output_ptr,
+                    input.seal_commit_phase1_output_len,
+                ))
+                .map_err(Into::into);
+
+                match scp1o.and_then(|o| {
+                    seal_commit_phase2(o, input.prover_id.inner, SectorId::from(input.sector_id))
+                }) {
+                    Ok(output) => {
+                        result.status_code = FCPResponseStatus::FCPNoError;
+                        result.proof_ptr = output.proof.as_ptr();
+                        result.proof_len = output.proof.len();
+                        mem::forget(output.proof);
+                    }
+                    Err(err) => {
+                        result.status_code = FCPResponseStatus::FCPUnclassifiedError;
+                        result.error_msg = rust_str_to_c_str(format!("{:?}", err));
+                    }
+                }
+
+                result
+            })
+            .collect();
+
+        response.status_code = FCPResponseStatus::FCPNoError;
+        response.results_ptr = results.as_ptr();
+        response.results_len = results.len();
+        mem::forget(results);
+
+        info!("seal_commit_phase2_batch: finish");
+
+        raw_ptr(response)
+    })
+}
+
+/// Like fil_seal_commit_phase2, but reads the output of phase 1 from the file
+/// written by fil_seal_commit_phase1_to_file.
+#[no_mangle]
+pub unsafe extern "C" fn fil_seal_commit_phase2_from_file(
+    seal_commit_phase1_output_path: *const libc::c_char,
+    sector_id: u64,
+    prover_id: fil_32ByteArray,
+) -> *mut fil_SealCommitPhase2Response {
+    catch_panic_response(|| {
+        init_log();
+
+        info!("seal_commit_phase2_from_file: start");
+
+        let mut response = fil_SealCommitPhase2Response::default();
+
+        let path = c_str_to_pbuf(seal_commit_phase1_output_path);
+        let scp1o = read_seal_commit_phase1_output(&path);
+
+        let result =
+            scp1o.and_then(|o| seal_commit_phase2(o, prover_id.inner, SectorId::from(sector_id)));
+
+        match result {
+            Ok(output) => {
+                response.status_code = FCPResponseStatus::FCPNoError;
+                response.proof_ptr = output.proof.as_ptr();
+                response.proof_len = output.proof.len();
+                mem::forget(output.proof);
+            }
+            Err(err) => {
+                response.status_code = FCPResponseStatus::FCPUnclassifiedError;
+                response.error_msg = rust_str_to_c_str(format!("{:?}", err));
+            }
+        }
+
+        info!("seal_commit_phase2_from_file: finish");
+
+        raw_ptr(response)
+    })
+}
+
+fn read_seal_commit_phase1_output(path: &Path) -> anyhow::Result<SealCommitPhase1Output> {
+    let file = File::open(path).map_err(|err| {
+        anyhow::anyhow!(
+            "failed to open seal commit phase 1 output file {:?}: {}",
+            path,
+            err
+        )
+    })?;
+
+    serde_json::from_reader(BufReader::new(file)).map_err(|err| {
+        anyhow::anyhow!(
+            "corrupted or truncated seal commit phase 1 output file {:?}: {}",
+            path,
+            err
+        )
+    })
+}
+
 #[no_mangle]
 pub unsafe extern "C" fn fil_aggregate_seal_proofs(
     registered_proof: fil_RegisteredSealProof,
@@ -565,7 +897,9 @@ pub unsafe extern "C" fn fil_verify_aggregate_seal_proof(
     })
 }
 
-/// TODO: document
+/// Unseals the given range of unpadded bytes of the sector, writing them to
+/// the unseal output. If num_threads is non-zero, the decoding is spread over a
+/// thread pool of that many threads instead of the global one.
 #[no_mangle]
 pub unsafe extern "C" fn fil_unseal_range(
     registered_proof: fil_RegisteredSealProof,
@@ -578,6 +912,7 @@ pub unsafe extern "C" fn fil_unseal_range(
     comm_d: fil_32ByteArray,
     unpadded_byte_index: u64,
     unpadded_bytes_amount: u64,
+    num_threads: libc::size_t,
 ) -> *mut fil_UnsealRangeResponse {
     catch_panic_response(|| {
         init_log();
@@ -590,18 +925,33 @@ pub unsafe extern "C" fn fil_unseal_range(
         let sealed_sector = std::fs::File::from_raw_fd(sealed_sector_fd_raw);
         let mut unseal_output = std::fs::File::from_raw_fd(unseal_output_fd_raw);
 
-        let result = filecoin_proofs_api::seal::get_unsealed_range_mapped(
-            registered_proof.into(),
-            c_str_to_pbuf(cache_dir_path),
-            sealed_sector.path().unwrap(),
-            &mut unseal_output,
-            prover_id.inner,
-            SectorId::from(sector_id),
-            comm_d.inner,
-            ticket.inner,
-            UnpaddedByteIndex(unpadded_byte_index),
-            UnpaddedBytesAmount(unpadded_bytes_amount),
-        );
+        let cache_path = c_str_to_pbuf(cache_dir_path);
+        let sealed_path = sealed_sector.path().unwrap();
+
+        let unseal = || {
+            filecoin_proofs_api::seal::get_unsealed_range_mapped(
+                registered_proof.into(),
+                cache_path,
+                sealed_path,
+                &mut unseal_output,
+                prover_id.inner,
+                SectorId::from(sector_id),
+                comm_d.inner,
+                ticket.inner,
+                UnpaddedByteIndex(unpadded_byte_index),
+                UnpaddedBytesAmount(unpadded_bytes_amount),
+            )
+        };
+
+        let result = if num_threads == 0 {
+            unseal()
+        } else {
+            rayon::ThreadPoolBuilder::new()
+                .num_threads(num_threads)
+                .build()
+                .map_err(Into::into)
+                .and_then(|pool| pool.install(unseal))
+        };
 
         // keep all file descriptors alive until unseal_range returns
         let _ = sealed_sector.into_raw_fd();
@@ -1991,6 +2341,20 @@ pub unsafe extern "C" fn fil_destroy_seal_commit_phase1_response(
     let _ = Box::from_raw(ptr);
 }
 
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_seal_commit_phase1_to_file_response(
+    ptr: *mut fil_SealCommitPhase1ToFileResponse,
+) {
+    let _ = Box::from_raw(ptr);
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_seal_commit_phase2_batch_response(
+    ptr: *mut fil_SealCommitPhase2BatchResponse,
+) {
+    let _ = Box::from_raw(ptr);
+}
+
 #[no_mangle]
 pub unsafe extern "C" fn fil_destroy_seal_commit_phase2_response(
     ptr: *mut fil_SealCommitPhase2Response,
@@ -2296,6 +2660,16 @@ pub unsafe extern "C" fn fil_destroy_generate_winning_post_sector_challenge(
     let _ = Box::from_raw(ptr);
 }
 
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_generate_sdr_response(ptr: *mut fil_GenerateSDRResponse) {
+    let _ = Box::from_raw(ptr);
+}
+
+#[no_mangle]
+pub unsafe extern "C" fn fil_destroy_compute_comm_r_response(ptr: *mut fil_ComputeCommRResponse) {
+    let _ = Box::from_raw(ptr);
+}
+
 #[no_mangle]
 pub unsafe extern "C" fn fil_destroy_clear_cache_response(ptr: *mut fil_ClearCacheResponse) {
     let _ = Box::from_raw(ptr);
@@ -3125,6 +3499,7 @@ pub mod tests {
                 wrap((*resp_b2).comm_d),
                 0,
                 2032,
+                0,
             );
 
             if (*resp_e).status_code != FCPResponseStatus::FCPNoError {
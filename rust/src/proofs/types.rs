@@ -785,6 +785,24 @@ impl Default for fil_SealCommitPhase1Response {
 
 code_and_message_impl!(fil_SealCommitPhase1Response);
 
+#[repr(C)]
+#[derive(DropStructMacro)]
+pub struct fil_SealCommitPhase1ToFileResponse {
+    pub status_code: FCPResponseStatus,
+    pub error_msg: *const libc::c_char,
+}
+
+impl Default for fil_SealCommitPhase1ToFileResponse {
+    fn default() -> fil_SealCommitPhase1ToFileResponse {
+        fil_SealCommitPhase1ToFileResponse {
+            status_code: FCPResponseStatus::FCPNoError,
+            error_msg: ptr::null(),
+        }
+    }
+}
+
+code_and_message_impl!(fil_SealCommitPhase1ToFileResponse);
+
 #[repr(C)]
 #[derive(DropStructMacro)]
 pub struct fil_SealCommitPhase2Response {
@@ -796,6 +814,37 @@ pub struct fil_SealCommitPhase2Response {
     pub commit_inputs_len: libc::size_t,
 }
 
+#[repr(C)]
+#[derive(Clone)]
+pub struct fil_SealCommitPhase2BatchInput {
+    pub seal_commit_phase1_output_ptr: *const u8,
+    pub seal_commit_phase1_output_len: libc::size_t,
+    pub sector_id: u64,
+    pub prover_id: fil_32ByteArray,
+}
+
+#[repr(C)]
+#[derive(DropStructMacro)]
+pub struct fil_SealCommitPhase2BatchResponse {
+    pub status_code: FCPResponseStatus,
+    pub error_msg: *const libc::c_char,
+    pub results_ptr: *const fil_SealCommitPhase2Response,
+    pub results_len: libc::size_t,
+}
+
+impl Default for fil_SealCommitPhase2BatchResponse {
+    fn default() -> fil_SealCommitPhase2BatchResponse {
+        fil_SealCommitPhase2BatchResponse {
+            status_code: FCPResponseStatus::FCPNoError,
+            error_msg: ptr::null(),
+            results_ptr: ptr::null(),
+            results_len: 0,
+        }
+    }
+}
+
+code_and_message_impl!(fil_SealCommitPhase2BatchResponse);
+
 impl From<&fil_SealCommitPhase2Response> for SealCommitPhase2Output {
     fn from(other: &fil_SealCommitPhase2Response) -> Self {
         let slice: &[u8] = unsafe { std::slice::from_raw_parts(other.proof_ptr, other.proof_len) };
@@ -1067,6 +1116,44 @@ impl Default for fil_StringResponse {
 
 code_and_message_impl!(fil_StringResponse);
 
+#[repr(C)]
+#[derive(DropStructMacro)]
+pub struct fil_GenerateSDRResponse {
+    pub error_msg: *const libc::c_char,
+    pub status_code: FCPResponseStatus,
+}
+
+impl Default for fil_GenerateSDRResponse {
+    fn default() -> fil_GenerateSDRResponse {
+        fil_GenerateSDRResponse {
+            error_msg: ptr::null(),
+            status_code: FCPResponseStatus::FCPNoError,
+        }
+    }
+}
+
+code_and_message_impl!(fil_GenerateSDRResponse);
+
+#[repr(C)]
+#[derive(DropStructMacro)]
+pub struct fil_ComputeCommRResponse {
+    pub error_msg: *const libc::c_char,
+    pub status_code: FCPResponseStatus,
+    pub comm_r: [u8; 32],
+}
+
+impl Default for fil_ComputeCommRResponse {
+    fn default() -> fil_ComputeCommRResponse {
+        fil_ComputeCommRResponse {
+            error_msg: ptr::null(),
+            status_code: FCPResponseStatus::FCPNoError,
+            comm_r: Default::default(),
+        }
+    }
+}
+
+code_and_message_impl!(fil_ComputeCommRResponse);
+
 #[repr(C)]
 #[derive(DropStructMacro)]
 pub struct fil_ClearCacheResponse {
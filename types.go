@@ -3,12 +3,22 @@ package ffi
 import (
 	"bytes"
 	"context"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
 	"sort"
+	"strings"
+	"sync"
 
 	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/specs-actors/actors/runtime/proof"
 	"github.com/ipfs/go-cid"
+	cbg "github.com/whyrusleeping/cbor-gen"
+	"golang.org/x/xerrors"
 )
 
 // BLS
@@ -25,7 +35,20 @@ const PublicKeyBytes = 48
 // DigestBytes is the length of a BLS message hash/digest
 const DigestBytes = 96
 
-// Signature is a compressed affine
+// UncompressedPublicKeyBytes is the length of an uncompressed BLS public key
+const UncompressedPublicKeyBytes = 96
+
+// UncompressedSignatureBytes is the length of an uncompressed BLS signature
+const UncompressedSignatureBytes = 192
+
+// PublicKeyG2Bytes is the length of a BLS public key of the min-sig variant
+const PublicKeyG2Bytes = 96
+
+// SignatureG1Bytes is the length of a BLS signature of the min-sig variant
+const SignatureG1Bytes = 48
+
+// Signature is a compressed affine point of G2, in the IETF standard (and
+// ZCash) serialization format, see MarshalIETF
 type Signature [SignatureBytes]byte
 
 // PrivateKey is a compressed affine
@@ -34,6 +57,14 @@ type PrivateKey [PrivateKeyBytes]byte
 // PublicKey is a compressed affine
 type PublicKey [PublicKeyBytes]byte
 
+// PublicKeyG2 is a compressed affine point of G2, the public key of the
+// min-sig variant
+type PublicKeyG2 [PublicKeyG2Bytes]byte
+
+// SignatureG1 is a compressed affine point of G1, the signature of the min-sig
+// variant
+type SignatureG1 [SignatureG1Bytes]byte
+
 // Message is a byte slice
 type Message []byte
 
@@ -43,21 +74,312 @@ type Digest [DigestBytes]byte
 // Used when generating a private key deterministically
 type PrivateKeyGenSeed [32]byte
 
+// VerificationRequest is a message, the public key of its signer and the
+// signature to check, as verified by BatchVerifySignatures
+type VerificationRequest struct {
+	Message   Message
+	PublicKey PublicKey
+	Signature Signature
+}
+
+// MessagePublicKeyPair is a message and the public key of its signer, as
+// verified by VerifyAggregateSignatureDistinct
+type MessagePublicKeyPair struct {
+	Message   Message
+	PublicKey PublicKey
+}
+
+// Hex returns the hex encoding of the signature
+func (s Signature) Hex() string {
+	return hex.EncodeToString(s[:])
+}
+
+// Bytes returns a copy of the signature bytes
+func (s Signature) Bytes() []byte {
+	return append([]byte{}, s[:]...)
+}
+
+// IsZero returns true if the signature is nil or made of zero bytes only, as
+// an uninitialized Signature is. Note that this is not the encoding of the
+// identity point returned by CreateZeroSignature.
+func (s *Signature) IsZero() bool {
+	return s == nil || isZeroBytes(s[:])
+}
+
+// IsIdentity returns true if the signature is the compressed encoding of the
+// identity point, such as the placeholder signature returned by
+// CreateZeroSignature.
+func (s *Signature) IsIdentity() bool {
+	return s != nil && isIdentityEncoding(s[:])
+}
+
+// Equal reports whether s and other are the same signature in constant time.
+// Two nil signatures are equal, and a nil signature is not equal to any other
+// signature.
+func (s *Signature) Equal(other *Signature) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return subtle.ConstantTimeCompare(s[:], other[:]) == 1
+}
+
+// ParseSignatureFromHex decodes a hex-encoded signature
+func ParseSignatureFromHex(h string) (Signature, error) {
+	var out Signature
+	if err := decodeFixedLengthHex("signature", h, out[:]); err != nil {
+		return Signature{}, err
+	}
+
+	return out, nil
+}
+
+// Hex returns the hex encoding of the private key
+func (p PrivateKey) Hex() string {
+	return hex.EncodeToString(p[:])
+}
+
+// Zero overwrites the private key with zeros. A zeroed private key is rejected
+// by every function that uses it.
+func (p *PrivateKey) Zero() {
+	for i := range p {
+		p[i] = 0
+	}
+
+	// make sure the writes above are not optimized away
+	runtime.KeepAlive(p)
+}
+
+// IsZero returns true if the private key has been zeroed or was never set. It
+// runs in constant time.
+func (p PrivateKey) IsZero() bool {
+	var zero PrivateKey
+	return subtle.ConstantTimeCompare(p[:], zero[:]) == 1
+}
+
+// Equal reports whether p and other are the same private key in constant time.
+// Use it rather than == when checking key identity.
+func (p PrivateKey) Equal(other PrivateKey) bool {
+	return subtle.ConstantTimeCompare(p[:], other[:]) == 1
+}
+
+// ParsePrivateKeyFromHex decodes a hex-encoded private key
+func ParsePrivateKeyFromHex(h string) (PrivateKey, error) {
+	var out PrivateKey
+	if err := decodeFixedLengthHex("private key", h, out[:]); err != nil {
+		return PrivateKey{}, err
+	}
+
+	return out, nil
+}
+
+// Hex returns the hex encoding of the public key
+func (p PublicKey) Hex() string {
+	return hex.EncodeToString(p[:])
+}
+
+// IsZero returns true if the public key is nil or made of zero bytes only, as
+// an uninitialized PublicKey is.
+func (p *PublicKey) IsZero() bool {
+	return p == nil || isZeroBytes(p[:])
+}
+
+// IsIdentity returns true if the public key is the compressed encoding of the
+// identity point.
+func (p *PublicKey) IsIdentity() bool {
+	return p != nil && isIdentityEncoding(p[:])
+}
+
+// Equal reports whether p and other are the same public key in constant time.
+// Two nil keys are equal, and a nil key is not equal to any other key.
+func (p *PublicKey) Equal(other *PublicKey) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+
+	return subtle.ConstantTimeCompare(p[:], other[:]) == 1
+}
+
+// ParsePublicKeyFromHex decodes a hex-encoded public key
+func ParsePublicKeyFromHex(h string) (PublicKey, error) {
+	var out PublicKey
+	if err := decodeFixedLengthHex("public key", h, out[:]); err != nil {
+		return PublicKey{}, err
+	}
+
+	return out, nil
+}
+
+// isZeroBytes returns true if b is made of zero bytes only, in constant time.
+func isZeroBytes(b []byte) bool {
+	var acc byte
+	for i := range b {
+		acc |= b[i]
+	}
+
+	return subtle.ConstantTimeByteEq(acc, 0) == 1
+}
+
+// Flag bits in the most significant byte of a compressed point encoding, as
+// specified by the IETF BLS signature draft and the ZCash serialization format
+// it builds on.
+const (
+	compressionFlag = 0x80
+	infinityFlag    = 0x40
+)
+
+// isIdentityEncoding returns true if b is the compressed encoding of the
+// identity point, which has only the compression and infinity flags set
+func isIdentityEncoding(b []byte) bool {
+	return len(b) > 0 && b[0] == compressionFlag|infinityFlag && isZeroBytes(b[1:])
+}
+
+// Hex returns the hex encoding of the digest
+func (d Digest) Hex() string {
+	return hex.EncodeToString(d[:])
+}
+
+// ParseDigestFromHex decodes a hex-encoded digest
+func ParseDigestFromHex(h string) (Digest, error) {
+	var out Digest
+	if err := decodeFixedLengthHex("digest", h, out[:]); err != nil {
+		return Digest{}, err
+	}
+
+	return out, nil
+}
+
+// decodeFixedLengthHex decodes h into out, which it must exactly fill
+func decodeFixedLengthHex(name string, h string, out []byte) error {
+	decoded, err := hex.DecodeString(h)
+	if err != nil {
+		return xerrors.Errorf("invalid %s hex: %w", name, err)
+	}
+
+	if len(decoded) != len(out) {
+		return xerrors.Errorf("invalid %s length: expected %d bytes, got %d", name, len(out), len(decoded))
+	}
+
+	copy(out, decoded)
+
+	// the decoded bytes may be key material
+	for i := range decoded {
+		decoded[i] = 0
+	}
+
+	return nil
+}
+
+// SignatureToHex returns the hex encoding of a signature
+func SignatureToHex(s Signature) string {
+	return s.Hex()
+}
+
+// SignatureFromHex decodes a hex-encoded signature, see ParseSignatureFromHex
+func SignatureFromHex(h string) (Signature, error) {
+	return ParseSignatureFromHex(h)
+}
+
+// PublicKeyToHex returns the hex encoding of a public key
+func PublicKeyToHex(p PublicKey) string {
+	return p.Hex()
+}
+
+// PublicKeyFromHex decodes a hex-encoded public key, see ParsePublicKeyFromHex
+func PublicKeyFromHex(h string) (PublicKey, error) {
+	return ParsePublicKeyFromHex(h)
+}
+
+// PrivateKeyToHex returns the hex encoding of a private key
+func PrivateKeyToHex(p PrivateKey) string {
+	return p.Hex()
+}
+
+// PrivateKeyFromHex decodes a hex-encoded private key, see ParsePrivateKeyFromHex
+func PrivateKeyFromHex(h string) (PrivateKey, error) {
+	return ParsePrivateKeyFromHex(h)
+}
+
+// MarshalText encodes the signature as hex
+func (s Signature) MarshalText() ([]byte, error) {
+	return []byte(s.Hex()), nil
+}
+
+// UnmarshalText decodes a hex-encoded signature. s is left unchanged on error.
+func (s *Signature) UnmarshalText(text []byte) error {
+	decoded, err := ParseSignatureFromHex(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = decoded
+	return nil
+}
+
+// MarshalText encodes the public key as hex
+func (p PublicKey) MarshalText() ([]byte, error) {
+	return []byte(p.Hex()), nil
+}
+
+// UnmarshalText decodes a hex-encoded public key. p is left unchanged on error.
+func (p *PublicKey) UnmarshalText(text []byte) error {
+	decoded, err := ParsePublicKeyFromHex(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = decoded
+	return nil
+}
+
+// MarshalText encodes the private key as hex
+func (p PrivateKey) MarshalText() ([]byte, error) {
+	return []byte(p.Hex()), nil
+}
+
+// UnmarshalText decodes a hex-encoded private key. p is left unchanged on
+// error, and no partially decoded key material is left behind.
+func (p *PrivateKey) UnmarshalText(text []byte) error {
+	decoded, err := ParsePrivateKeyFromHex(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = decoded
+	decoded.Zero()
+	return nil
+}
+
 // Proofs
 
-// SortedPublicSectorInfo is a slice of publicSectorInfo sorted
+// SortedPublicSectorInfo is a slice of PublicSectorInfo sorted
 // (lexicographically, ascending) by sealed (replica) CID.
 type SortedPublicSectorInfo struct {
-	f []publicSectorInfo
+	f []PublicSectorInfo
 }
 
-// SortedPrivateSectorInfo is a slice of PrivateSectorInfo sorted
-// (lexicographically, ascending) by sealed (replica) CID.
+// SortedPrivateSectorInfo is a slice of PrivateSectorInfo sorted by its
+// SortKey, ascending sector number unless created with
+// NewSortedPrivateSectorInfoBy.
 type SortedPrivateSectorInfo struct {
-	f []PrivateSectorInfo
+	f   []PrivateSectorInfo
+	key SortKey
 }
 
-func newSortedPublicSectorInfo(sectorInfo ...publicSectorInfo) SortedPublicSectorInfo {
+// SortKey is the order of the sectors of a SortedPrivateSectorInfo.
+type SortKey int
+
+const (
+	// SortBySectorNumber sorts sectors by ascending sector number. It is the
+	// default.
+	SortBySectorNumber SortKey = iota
+	// SortBySealedCID sorts sectors lexicographically by ascending sealed
+	// (replica) CID, as SortedPublicSectorInfo is.
+	SortBySealedCID
+)
+
+// NewSortedPublicSectorInfo returns a SortedPublicSectorInfo
+func NewSortedPublicSectorInfo(sectorInfo ...PublicSectorInfo) SortedPublicSectorInfo {
 	fn := func(i, j int) bool {
 		return bytes.Compare(sectorInfo[i].SealedCID.Bytes(), sectorInfo[j].SealedCID.Bytes()) == -1
 	}
@@ -69,11 +391,68 @@ func newSortedPublicSectorInfo(sectorInfo ...publicSectorInfo) SortedPublicSecto
 	}
 }
 
-// Values returns the sorted publicSectorInfo as a slice
-func (s *SortedPublicSectorInfo) Values() []publicSectorInfo {
+// Values returns the sorted PublicSectorInfo as a slice
+func (s *SortedPublicSectorInfo) Values() []PublicSectorInfo {
 	return s.f
 }
 
+// Without returns a new SortedPublicSectorInfo holding the sectors of s whose
+// numbers are not in faults, which need not be sorted. As s is sorted by sealed
+// CID rather than sector number, faults is sorted and each sector looked up in
+// it by binary search.
+func (s SortedPublicSectorInfo) Without(faults []abi.SectorNumber) SortedPublicSectorInfo {
+	sortedFaults := make([]abi.SectorNumber, len(faults))
+	copy(sortedFaults, faults)
+	sort.Slice(sortedFaults, func(i, j int) bool {
+		return sortedFaults[i] < sortedFaults[j]
+	})
+
+	remaining := make([]PublicSectorInfo, 0, len(s.f))
+	for _, info := range s.f {
+		i := sort.Search(len(sortedFaults), func(i int) bool {
+			return sortedFaults[i] >= info.SectorNum
+		})
+		if i < len(sortedFaults) && sortedFaults[i] == info.SectorNum {
+			continue
+		}
+		remaining = append(remaining, info)
+	}
+
+	// the order of s is preserved, so there's no need to sort again
+	return SortedPublicSectorInfo{
+		f: remaining,
+	}
+}
+
+// Union returns a new SortedPublicSectorInfo containing the sectors of both s
+// and other, merged in a single linear scan as both are sorted. When both
+// contain a sector with the same sealed CID, the one from s is kept.
+func (s SortedPublicSectorInfo) Union(other SortedPublicSectorInfo) SortedPublicSectorInfo {
+	union := make([]PublicSectorInfo, 0, len(s.f)+len(other.f))
+
+	i, j := 0, 0
+	for i < len(s.f) && j < len(other.f) {
+		switch bytes.Compare(s.f[i].SealedCID.Bytes(), other.f[j].SealedCID.Bytes()) {
+		case -1:
+			union = append(union, s.f[i])
+			i++
+		case 1:
+			union = append(union, other.f[j])
+			j++
+		default:
+			union = append(union, s.f[i])
+			i++
+			j++
+		}
+	}
+	union = append(union, s.f[i:]...)
+	union = append(union, other.f[j:]...)
+
+	return SortedPublicSectorInfo{
+		f: union,
+	}
+}
+
 // MarshalJSON JSON-encodes and serializes the SortedPublicSectorInfo.
 func (s SortedPublicSectorInfo) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.f)
@@ -81,52 +460,241 @@ func (s SortedPublicSectorInfo) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON parses the JSON-encoded byte slice and stores the result in the
 // value pointed to by s.f. Note that this method allows for construction of a
-// SortedPublicSectorInfo which violates its invariant (that its publicSectorInfo are sorted
+// SortedPublicSectorInfo which violates its invariant (that its PublicSectorInfo are sorted
 // in some defined way). Callers should take care to never provide a byte slice
 // which would violate this invariant.
 func (s *SortedPublicSectorInfo) UnmarshalJSON(b []byte) error {
 	return json.Unmarshal(b, &s.f)
 }
 
-// NewSortedPrivateSectorInfo returns a SortedPrivateSectorInfo
+// MarshalCBOR CBOR-encodes the SortedPublicSectorInfo as an array of its
+// PublicSectorInfo.
+func (s *SortedPublicSectorInfo) MarshalCBOR(w io.Writer) error {
+	if err := cbg.WriteMajorTypeHeader(w, cbg.MajArray, uint64(len(s.f))); err != nil {
+		return err
+	}
+
+	for i := range s.f {
+		if err := s.f[i].MarshalCBOR(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalCBOR decodes a CBOR array of PublicSectorInfo. Unlike UnmarshalJSON,
+// the decoded values are passed through NewSortedPublicSectorInfo, so the
+// result is sorted regardless of the order of the encoded values.
+func (s *SortedPublicSectorInfo) UnmarshalCBOR(r io.Reader) error {
+	br := cbg.GetPeeker(r)
+
+	length, err := readCBORArrayHeader(br)
+	if err != nil {
+		return err
+	}
+
+	infos := make([]PublicSectorInfo, length)
+	for i := range infos {
+		if err := infos[i].UnmarshalCBOR(br); err != nil {
+			return err
+		}
+	}
+
+	*s = NewSortedPublicSectorInfo(infos...)
+	return nil
+}
+
+// NewSortedPrivateSectorInfo returns a SortedPrivateSectorInfo sorted by
+// sector number. Of sectors with the same sector number, only the first one is
+// kept.
 func NewSortedPrivateSectorInfo(sectorInfo ...PrivateSectorInfo) SortedPrivateSectorInfo {
-	var remove_duplicate_privSector = make([]PrivateSectorInfo, 0)
-	for i := range sectorInfo {
-		for j := range remove_duplicate_privSector {
-			if !(remove_duplicate_privSector[j].SectorNumber == sectorInfo[i].SectorNumber) {
-				remove_duplicate_privSector = append(remove_duplicate_privSector, sectorInfo[i])
+	return NewSortedPrivateSectorInfoBy(SortBySectorNumber, sectorInfo...)
+}
+
+// NewSortedPrivateSectorInfoBy is like NewSortedPrivateSectorInfo, but sorts
+// the sectors by key, which the returned SortedPrivateSectorInfo keeps for
+// Merge and the other methods returning sectors to respect. Sectors with the
+// same sealed CID are sorted by sector number.
+func NewSortedPrivateSectorInfoBy(key SortKey, sectorInfo ...PrivateSectorInfo) SortedPrivateSectorInfo {
+	return newSortedPrivateSectorInfo(key, len(sectorInfo), sectorInfo)
+}
+
+// NewSortedPrivateSectorInfoWithHint is like NewSortedPrivateSectorInfo, but
+// allocates room for capacity sectors, or for all of sectorInfo if there are
+// more, e.g. the partition size when building partitions.
+func NewSortedPrivateSectorInfoWithHint(capacity int, sectorInfo ...PrivateSectorInfo) SortedPrivateSectorInfo {
+	return newSortedPrivateSectorInfo(SortBySectorNumber, capacity, sectorInfo)
+}
+
+func newSortedPrivateSectorInfo(key SortKey, capacity int, sectorInfo []PrivateSectorInfo) SortedPrivateSectorInfo {
+	if capacity < len(sectorInfo) {
+		capacity = len(sectorInfo)
+	}
+
+	seen := make(map[abi.SectorNumber]struct{}, capacity)
+	deduplicated := make([]PrivateSectorInfo, 0, capacity)
+	for _, info := range sectorInfo {
+		if _, ok := seen[info.SectorNumber]; ok {
+			continue
+		}
+		seen[info.SectorNumber] = struct{}{}
+		deduplicated = append(deduplicated, info)
+	}
+
+	sort.Slice(deduplicated, func(i, j int) bool {
+		return lessPrivateSectorInfo(key, &deduplicated[i], &deduplicated[j])
+	})
+
+	return SortedPrivateSectorInfo{
+		f:   deduplicated,
+		key: key,
+	}
+}
+
+// lessPrivateSectorInfo reports whether a sorts before b by key
+func lessPrivateSectorInfo(key SortKey, a, b *PrivateSectorInfo) bool {
+	if key == SortBySealedCID {
+		if c := bytes.Compare(a.SealedCID.Bytes(), b.SealedCID.Bytes()); c != 0 {
+			return c < 0
+		}
+	}
+	return a.SectorNumber < b.SectorNumber
+}
+
+// Key returns the order of the sectors.
+func (s *SortedPrivateSectorInfo) Key() SortKey {
+	return s.key
+}
+
+// Values returns the sorted PrivateSectorInfo as a slice
+func (s *SortedPrivateSectorInfo) Values() []PrivateSectorInfo {
+	return s.f
+}
+
+// Len returns the number of sectors
+func (s *SortedPrivateSectorInfo) Len() int {
+	return len(s.f)
+}
+
+// Contains returns true if a sector with the given number is present. Sectors
+// sorted by sector number are searched using binary search, others one by one.
+func (s *SortedPrivateSectorInfo) Contains(sectorNum abi.SectorNumber) bool {
+	if s.key != SortBySectorNumber {
+		for _, info := range s.f {
+			if info.SectorNumber == sectorNum {
+				return true
 			}
 		}
+		return false
+	}
+
+	idx := sort.Search(len(s.f), func(i int) bool {
+		return s.f[i].SectorNumber >= sectorNum
+	})
+
+	return idx < len(s.f) && s.f[idx].SectorNumber == sectorNum
+}
+
+// At returns the sector at index i, and false if i is out of bounds
+func (s *SortedPrivateSectorInfo) At(i int) (PrivateSectorInfo, bool) {
+	if i < 0 || i >= len(s.f) {
+		return PrivateSectorInfo{}, false
 	}
 
-	new_sector_len := len(remove_duplicate_privSector)
-	if new_sector_len < 2 {
+	return s.f[i], true
+}
+
+// Merge returns a new SortedPrivateSectorInfo containing the sectors of both s
+// and other, sorted by the key of s and deduplicated by
+// NewSortedPrivateSectorInfoBy. When both contain the same sector, the one from
+// s is kept.
+func (s SortedPrivateSectorInfo) Merge(other SortedPrivateSectorInfo) SortedPrivateSectorInfo {
+	combined := make([]PrivateSectorInfo, 0, len(s.f)+len(other.f))
+	combined = append(combined, s.f...)
+	combined = append(combined, other.f...)
+
+	return NewSortedPrivateSectorInfoBy(s.key, combined...)
+}
+
+// Difference returns a new SortedPrivateSectorInfo containing the sectors of s
+// whose sector numbers are not in other. When both are sorted by sector number,
+// they are compared in a single linear scan.
+func (s SortedPrivateSectorInfo) Difference(other SortedPrivateSectorInfo) SortedPrivateSectorInfo {
+	difference := make([]PrivateSectorInfo, 0, len(s.f))
+
+	if s.key != SortBySectorNumber || other.key != SortBySectorNumber {
+		for _, info := range s.f {
+			if !other.Contains(info.SectorNumber) {
+				difference = append(difference, info)
+			}
+		}
+
 		return SortedPrivateSectorInfo{
-			f: remove_duplicate_privSector,
+			f:   difference,
+			key: s.key,
 		}
 	}
 
-	for i := 0; i < new_sector_len; i++ {
-		flag := false
-		for j := 0; j < new_sector_len-i-1; j++ {
-			if remove_duplicate_privSector[j].SectorNumber > remove_duplicate_privSector[j+1].SectorNumber {
-				remove_duplicate_privSector[j], remove_duplicate_privSector[j+1] = remove_duplicate_privSector[j+1], remove_duplicate_privSector[j]
-				flag = true
-			}
+	j := 0
+	for _, info := range s.f {
+		for j < len(other.f) && other.f[j].SectorNumber < info.SectorNumber {
+			j++
 		}
-		if !flag {
-			break
+		if j < len(other.f) && other.f[j].SectorNumber == info.SectorNumber {
+			continue
 		}
+		difference = append(difference, info)
 	}
 
 	return SortedPrivateSectorInfo{
-		f: remove_duplicate_privSector,
+		f:   difference,
+		key: s.key,
 	}
 }
 
-// Values returns the sorted PrivateSectorInfo as a slice
-func (s *SortedPrivateSectorInfo) Values() []PrivateSectorInfo {
-	return s.f
+// ValidatePaths calls Validate on each sector of s, so that all misconfigured
+// sectors can be found before proof generation rather than one at a time. If
+// any sector is invalid, the returned slice holds the error of each sector at
+// its index, nil for the valid ones. It is empty if all sectors are valid.
+func (s SortedPrivateSectorInfo) ValidatePaths() []error {
+	var errs []error
+	for idx, info := range s.f {
+		err := info.Validate()
+		if err == nil {
+			continue
+		}
+		if errs == nil {
+			errs = make([]error, len(s.f))
+		}
+		errs[idx] = err
+	}
+
+	return errs
+}
+
+// Filter returns a new SortedPrivateSectorInfo containing only the sectors of
+// s with the given PoSt proof type.
+func (s SortedPrivateSectorInfo) Filter(proofType abi.RegisteredPoStProof) SortedPrivateSectorInfo {
+	return s.FilterFunc(func(info PrivateSectorInfo) bool {
+		return info.PoStProofType == proofType
+	})
+}
+
+// FilterFunc returns a new SortedPrivateSectorInfo containing only the sectors
+// of s for which fn returns true. The sectors keep their order.
+func (s SortedPrivateSectorInfo) FilterFunc(fn func(PrivateSectorInfo) bool) SortedPrivateSectorInfo {
+	filtered := make([]PrivateSectorInfo, 0, len(s.f))
+	for _, info := range s.f {
+		if fn(info) {
+			filtered = append(filtered, info)
+		}
+	}
+
+	return SortedPrivateSectorInfo{
+		f:   filtered,
+		key: s.key,
+	}
 }
 
 // MarshalJSON JSON-encodes and serializes the SortedPrivateSectorInfo.
@@ -134,11 +702,79 @@ func (s SortedPrivateSectorInfo) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.f)
 }
 
+// UnmarshalJSON parses the JSON-encoded byte slice and stores the result in s.
+// The decoded values are passed through NewSortedPrivateSectorInfoBy with the
+// key of s, so the result is sorted and deduplicated even if the encoded values
+// are not, e.g. because they were edited on disk.
 func (s *SortedPrivateSectorInfo) UnmarshalJSON(b []byte) error {
-	return json.Unmarshal(b, &s.f)
+	var infos []PrivateSectorInfo
+	if err := json.Unmarshal(b, &infos); err != nil {
+		return err
+	}
+
+	*s = NewSortedPrivateSectorInfoBy(s.key, infos...)
+	return nil
 }
 
-type publicSectorInfo struct {
+// MarshalCBOR CBOR-encodes the SortedPrivateSectorInfo as an array of its
+// PrivateSectorInfo.
+func (s *SortedPrivateSectorInfo) MarshalCBOR(w io.Writer) error {
+	if err := cbg.WriteMajorTypeHeader(w, cbg.MajArray, uint64(len(s.f))); err != nil {
+		return err
+	}
+
+	for i := range s.f {
+		if err := s.f[i].MarshalCBOR(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalCBOR decodes a CBOR array of PrivateSectorInfo. Like UnmarshalJSON,
+// the decoded values are passed through NewSortedPrivateSectorInfoBy with the
+// key of s, so the result is sorted and deduplicated regardless of the encoded
+// values.
+func (s *SortedPrivateSectorInfo) UnmarshalCBOR(r io.Reader) error {
+	br := cbg.GetPeeker(r)
+
+	length, err := readCBORArrayHeader(br)
+	if err != nil {
+		return err
+	}
+
+	infos := make([]PrivateSectorInfo, length)
+	for i := range infos {
+		if err := infos[i].UnmarshalCBOR(br); err != nil {
+			return err
+		}
+	}
+
+	*s = NewSortedPrivateSectorInfoBy(s.key, infos...)
+	return nil
+}
+
+func readCBORArrayHeader(br io.Reader) (uint64, error) {
+	maj, extra, err := cbg.CborReadHeader(br)
+	if err != nil {
+		return 0, err
+	}
+
+	if maj != cbg.MajArray {
+		return 0, xerrors.Errorf("cbor input should be of type array, got major type %d", maj)
+	}
+
+	if extra > cbg.MaxLength {
+		return 0, xerrors.Errorf("array too large (%d)", extra)
+	}
+
+	return extra, nil
+}
+
+// PublicSectorInfo is the public information about a sector needed to verify
+// its PoSt
+type PublicSectorInfo struct {
 	PoStProofType abi.RegisteredPoStProof
 	SealedCID     cid.Cid
 	SectorNum     abi.SectorNumber
@@ -151,15 +787,345 @@ type PrivateSectorInfo struct {
 	SealedSectorPath string
 }
 
+// Equal returns true if p and other describe the same sector in the same
+// place: all their fields are equal, with the sealed CIDs compared by
+// cid.Cid.Equals.
+func (p PrivateSectorInfo) Equal(other PrivateSectorInfo) bool {
+	return p.SealProof == other.SealProof &&
+		p.SectorNumber == other.SectorNumber &&
+		p.SealedCID.Equals(other.SealedCID) &&
+		p.CacheDirPath == other.CacheDirPath &&
+		p.PoStProofType == other.PoStProofType &&
+		p.SealedSectorPath == other.SealedSectorPath
+}
+
+// Validate checks that the cache directory and the sealed sector file exist
+// and are readable, and that the size of the sealed sector file matches the
+// sector size of the PoSt proof type. It is meant to catch misconfigured
+// sectors before they are handed to the native library.
+func (p PrivateSectorInfo) Validate() error {
+	cacheDir, err := os.Open(p.CacheDirPath)
+	if err != nil {
+		return xerrors.Errorf("sector %d: opening cache dir: %w", p.SectorNumber, err)
+	}
+	defer cacheDir.Close()
+
+	cacheDirInfo, err := cacheDir.Stat()
+	if err != nil {
+		return xerrors.Errorf("sector %d: stat cache dir: %w", p.SectorNumber, err)
+	}
+	if !cacheDirInfo.IsDir() {
+		return xerrors.Errorf("sector %d: cache dir %s is not a directory", p.SectorNumber, p.CacheDirPath)
+	}
+
+	sealedSector, err := os.Open(p.SealedSectorPath)
+	if err != nil {
+		return xerrors.Errorf("sector %d: opening sealed sector: %w", p.SectorNumber, err)
+	}
+	defer sealedSector.Close()
+
+	sealedSectorInfo, err := sealedSector.Stat()
+	if err != nil {
+		return xerrors.Errorf("sector %d: stat sealed sector: %w", p.SectorNumber, err)
+	}
+	if sealedSectorInfo.IsDir() {
+		return xerrors.Errorf("sector %d: sealed sector %s is a directory", p.SectorNumber, p.SealedSectorPath)
+	}
+
+	sectorSize, err := p.PoStProofType.SectorSize()
+	if err != nil {
+		return xerrors.Errorf("sector %d: %w", p.SectorNumber, err)
+	}
+	if uint64(sealedSectorInfo.Size()) != uint64(sectorSize) {
+		return xerrors.Errorf("sector %d: sealed sector %s is %d bytes, expected %d bytes for %d", p.SectorNumber, p.SealedSectorPath, sealedSectorInfo.Size(), sectorSize, p.PoStProofType)
+	}
+
+	return nil
+}
+
 // AllocationManager is an interface that provides Free() capability.
 type AllocationManager interface {
 	Free()
 }
 
+var (
+	bufferPoolsLk sync.Mutex
+	bufferPools   = make(map[int]*sync.Pool)
+)
+
+// bufferPool returns the pool of buffers of the given size, which is shared by
+// all the PooledAllocationManagers of that size.
+func bufferPool(bufSize int) *sync.Pool {
+	bufferPoolsLk.Lock()
+	defer bufferPoolsLk.Unlock()
+
+	pool, ok := bufferPools[bufSize]
+	if !ok {
+		pool = &sync.Pool{
+			New: func() interface{} {
+				buf := make([]byte, bufSize)
+				return &buf
+			},
+		}
+		bufferPools[bufSize] = pool
+	}
+
+	return pool
+}
+
+// PooledAllocationManager is an AllocationManager which hands out fixed-size
+// byte buffers, such as the buffers FFI return values are copied into, and
+// recycles them through a sync.Pool once freed. Managers with the same buffer
+// size share a pool, while managers of different sizes, such as those used for
+// the proofs of 32GiB and 64GiB sectors, never see each other's buffers.
+type PooledAllocationManager struct {
+	bufSize int
+	pool    *sync.Pool
+
+	lk          sync.Mutex
+	outstanding []*[]byte
+}
+
+var _ AllocationManager = (*PooledAllocationManager)(nil)
+
+// NewPooledAllocationManager creates a PooledAllocationManager handing out
+// buffers of bufSize bytes. It panics if bufSize is not positive.
+func NewPooledAllocationManager(bufSize int) *PooledAllocationManager {
+	if bufSize <= 0 {
+		panic(xerrors.Errorf("buffer size must be positive, got %d", bufSize))
+	}
+
+	return &PooledAllocationManager{
+		bufSize: bufSize,
+		pool:    bufferPool(bufSize),
+	}
+}
+
+// BufSize returns the size of the buffers handed out by the manager.
+func (m *PooledAllocationManager) BufSize() int {
+	return m.bufSize
+}
+
+// Get returns a buffer of BufSize bytes, which remains valid until Free is
+// called. The contents of the buffer are not zeroed.
+func (m *PooledAllocationManager) Get() []byte {
+	buf := m.pool.Get().(*[]byte)
+
+	m.lk.Lock()
+	m.outstanding = append(m.outstanding, buf)
+	m.lk.Unlock()
+
+	return *buf
+}
+
+// Free returns all the buffers handed out by Get to the pool. The buffers must
+// not be used afterwards.
+func (m *PooledAllocationManager) Free() {
+	m.lk.Lock()
+	outstanding := m.outstanding
+	m.outstanding = nil
+	m.lk.Unlock()
+
+	for _, buf := range outstanding {
+		m.pool.Put(buf)
+	}
+}
+
+// ErrInvalidRange is returned by SplitSortedPrivateSectorInfo when the range
+// to split is out of bounds.
+var ErrInvalidRange = xerrors.New("invalid range")
+
+// SplitSortedPrivateSectorInfo returns a copy of the sectors from start
+// (inclusive) to end (exclusive). ErrInvalidRange is returned if the range
+// does not satisfy 0 <= start <= end <= sortPrivSectors.Len(), and ctx.Err() if
+// ctx is already done.
 func SplitSortedPrivateSectorInfo(ctx context.Context, sortPrivSectors SortedPrivateSectorInfo, start int, end int) (SortedPrivateSectorInfo, error) {
-	var newSortPrivSectors SortedPrivateSectorInfo
+	if err := ctx.Err(); err != nil {
+		return SortedPrivateSectorInfo{}, err
+	}
+
+	if start < 0 || start > end || end > len(sortPrivSectors.f) {
+		return SortedPrivateSectorInfo{}, xerrors.Errorf("cannot split [%d, %d) of %d sectors: %w", start, end, len(sortPrivSectors.f), ErrInvalidRange)
+	}
+
+	newSortPrivSectors := SortedPrivateSectorInfo{key: sortPrivSectors.key}
 	newSortPrivSectors.f = make([]PrivateSectorInfo, 0)
 	newSortPrivSectors.f = append(newSortPrivSectors.f, sortPrivSectors.f[start:end]...)
 
 	return newSortPrivSectors, nil
 }
+
+// PartitionSectors splits sectors into the minimum number of partitions of at
+// most maxPerPartition sectors each, such as the window PoSt partitions of a
+// deadline. Every partition but the last holds exactly maxPerPartition
+// sectors, and the partitions hold copies of the sectors in sector number
+// order, which is how the verifier assigns them to partitions, whatever the key
+// of sectors. No partitions are returned if sectors is empty, and an error is
+// returned if maxPerPartition is not positive.
+func PartitionSectors(sectors SortedPrivateSectorInfo, maxPerPartition int) ([]SortedPrivateSectorInfo, error) {
+	if maxPerPartition <= 0 {
+		return nil, xerrors.Errorf("cannot partition sectors into partitions of %d sectors", maxPerPartition)
+	}
+
+	sectors = sectors.bySectorNumber()
+
+	partitions := make([]SortedPrivateSectorInfo, 0, (len(sectors.f)+maxPerPartition-1)/maxPerPartition)
+	for start := 0; start < len(sectors.f); start += maxPerPartition {
+		end := start + maxPerPartition
+		if end > len(sectors.f) {
+			end = len(sectors.f)
+		}
+
+		partitions = append(partitions, SortedPrivateSectorInfo{
+			f:   append([]PrivateSectorInfo{}, sectors.f[start:end]...),
+			key: sectors.key,
+		})
+	}
+
+	return partitions, nil
+}
+
+// bySectorNumber returns the sectors of s sorted by sector number, the order
+// in which Window PoSt partitions are built. s is returned as is if it is
+// already sorted so.
+func (s SortedPrivateSectorInfo) bySectorNumber() SortedPrivateSectorInfo {
+	if s.key == SortBySectorNumber {
+		return s
+	}
+
+	return NewSortedPrivateSectorInfo(s.f...)
+}
+
+// SectorPathProvider resolves where the files of a sector are stored, such as
+// the sector index of a storage node.
+type SectorPathProvider interface {
+	CacheDirPath(abi.SectorNumber) (string, error)
+	SealedSectorPath(abi.SectorNumber) (string, error)
+}
+
+// SectorPathError is returned by PublicToPrivateSectorInfo when the paths of
+// some sectors cannot be resolved. Errors holds an error for every path which
+// failed, in the order of the sectors.
+type SectorPathError struct {
+	Errors []error
+}
+
+func (e *SectorPathError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+
+	return fmt.Sprintf("failed to resolve %d sector paths: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// PublicToPrivateSectorInfo returns the SortedPrivateSectorInfo of the sectors
+// of pub, with their paths resolved by pathProvider. The sectors keep the
+// sealed CID order of pub, see SortBySealedCID. Every path is resolved even if
+// some fail, and the failures are reported together in a *SectorPathError.
+func PublicToPrivateSectorInfo(pub SortedPublicSectorInfo, pathProvider SectorPathProvider) (SortedPrivateSectorInfo, error) {
+	var pathErr SectorPathError
+	infos := make([]PrivateSectorInfo, 0, len(pub.f))
+	for _, info := range pub.f {
+		cacheDirPath, err := pathProvider.CacheDirPath(info.SectorNum)
+		if err != nil {
+			pathErr.Errors = append(pathErr.Errors, xerrors.Errorf("cache directory of sector %d: %w", info.SectorNum, err))
+		}
+
+		sealedSectorPath, err := pathProvider.SealedSectorPath(info.SectorNum)
+		if err != nil {
+			pathErr.Errors = append(pathErr.Errors, xerrors.Errorf("sealed sector path of sector %d: %w", info.SectorNum, err))
+		}
+
+		infos = append(infos, PrivateSectorInfo{
+			SectorInfo: proof.SectorInfo{
+				SectorNumber: info.SectorNum,
+				SealedCID:    info.SealedCID,
+			},
+			CacheDirPath:     cacheDirPath,
+			PoStProofType:    info.PoStProofType,
+			SealedSectorPath: sealedSectorPath,
+		})
+	}
+
+	if len(pathErr.Errors) > 0 {
+		return SortedPrivateSectorInfo{}, &pathErr
+	}
+
+	return NewSortedPrivateSectorInfoBy(SortBySealedCID, infos...), nil
+}
+
+// ProofMetadata describes a PoSt proof type.
+type ProofMetadata struct {
+	// Name is the name of the proof type, e.g. "StackedDrgWindow32GiBV1".
+	Name string
+	// SectorSize is the size of the sectors proven with the proof type.
+	SectorSize abi.SectorSize
+	// MaxPartitionSectors is the maximum number of sectors proven by a single
+	// proof, the window PoSt partition size for window PoSt proof types.
+	MaxPartitionSectors uint64
+}
+
+// postProofMetadata must match the sector counts of the proofs library, see
+// WINNING_POST_SECTOR_COUNT and WINDOW_POST_SECTOR_COUNT in
+// filecoin-proofs/src/constants.rs.
+var postProofMetadata = map[abi.RegisteredPoStProof]ProofMetadata{
+	abi.RegisteredPoStProof_StackedDrgWinning2KiBV1:   {"StackedDrgWinning2KiBV1", 2 << 10, 1},
+	abi.RegisteredPoStProof_StackedDrgWinning8MiBV1:   {"StackedDrgWinning8MiBV1", 8 << 20, 1},
+	abi.RegisteredPoStProof_StackedDrgWinning512MiBV1: {"StackedDrgWinning512MiBV1", 512 << 20, 1},
+	abi.RegisteredPoStProof_StackedDrgWinning32GiBV1:  {"StackedDrgWinning32GiBV1", 32 << 30, 1},
+	abi.RegisteredPoStProof_StackedDrgWinning64GiBV1:  {"StackedDrgWinning64GiBV1", 64 << 30, 1},
+
+	abi.RegisteredPoStProof_StackedDrgWindow2KiBV1:   {"StackedDrgWindow2KiBV1", 2 << 10, 2},
+	abi.RegisteredPoStProof_StackedDrgWindow8MiBV1:   {"StackedDrgWindow8MiBV1", 8 << 20, 2},
+	abi.RegisteredPoStProof_StackedDrgWindow512MiBV1: {"StackedDrgWindow512MiBV1", 512 << 20, 2},
+	abi.RegisteredPoStProof_StackedDrgWindow32GiBV1:  {"StackedDrgWindow32GiBV1", 32 << 30, 2349},
+	abi.RegisteredPoStProof_StackedDrgWindow64GiBV1:  {"StackedDrgWindow64GiBV1", 64 << 30, 2300},
+}
+
+// PoStProofMetadata returns the name, sector size and maximum partition size of
+// a PoSt proof type.
+func PoStProofMetadata(proofType abi.RegisteredPoStProof) (ProofMetadata, error) {
+	metadata, ok := postProofMetadata[proofType]
+	if !ok {
+		return ProofMetadata{}, xerrors.Errorf("no metadata available for PoSt proof type %d", proofType)
+	}
+	return metadata, nil
+}
+
+// PoStRandomnessBytes is the length of the randomness PoSt challenges are
+// derived from
+const PoStRandomnessBytes = 32
+
+// ErrInvalidPoStRandomness is returned by ValidatePoStRandomness and
+// ValidatePoStRandomnessRound when the randomness is unusable.
+var ErrInvalidPoStRandomness = xerrors.New("invalid PoSt randomness")
+
+// ValidatePoStRandomness checks that r is PoStRandomnessBytes long and not all
+// zeros, which indicates that no entropy was filled in. It is meant to catch
+// malformed randomness before a PoSt is generated or verified.
+func ValidatePoStRandomness(r abi.PoStRandomness) error {
+	if len(r) != PoStRandomnessBytes {
+		return xerrors.Errorf("randomness is %d bytes, expected %d: %w", len(r), PoStRandomnessBytes, ErrInvalidPoStRandomness)
+	}
+
+	if isZeroBytes(r) {
+		return xerrors.Errorf("randomness is all zeros: %w", ErrInvalidPoStRandomness)
+	}
+
+	return nil
+}
+
+// ValidatePoStRandomnessRound is like ValidatePoStRandomness, but also checks
+// that the beacon round r was drawn from is no older than minRound.
+func ValidatePoStRandomnessRound(r abi.PoStRandomness, round, minRound uint64) error {
+	if err := ValidatePoStRandomness(r); err != nil {
+		return err
+	}
+
+	if round < minRound {
+		return xerrors.Errorf("randomness is from beacon round %d, expected round %d or later: %w", round, minRound, ErrInvalidPoStRandomness)
+	}
+
+	return nil
+}
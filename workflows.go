@@ -4,8 +4,10 @@ package ffi
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -13,7 +15,12 @@ import (
 	"math/big"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
 
+	commcid "github.com/filecoin-project/go-fil-commcid"
 	"github.com/filecoin-project/go-state-types/abi"
 	prf "github.com/filecoin-project/specs-actors/actors/runtime/proof"
 	"github.com/ipfs/go-cid"
@@ -24,6 +31,7 @@ func WorkflowProofsLifecycle(t TestHelper) {
 	randomness := [32]byte{9, 9, 9}
 	sealProofType := abi.RegisteredSealProof_StackedDrg2KiBV1
 	winningPostProofType := abi.RegisteredPoStProof_StackedDrgWinning2KiBV1
+	windowPostProofType := abi.RegisteredPoStProof_StackedDrgWindow2KiBV1
 	sectorNum := abi.SectorNumber(42)
 
 	ticket := abi.SealRandomness{5, 4, 2}
@@ -70,6 +78,9 @@ func WorkflowProofsLifecycle(t TestHelper) {
 	unsealOutputFileD := requireTempFile(t, bytes.NewReader([]byte{}), 0)
 	defer unsealOutputFileD.Close()
 
+	unsealOutputFileE := requireTempFile(t, bytes.NewReader([]byte{}), 0)
+	defer unsealOutputFileE.Close()
+
 	// some rando bytes
 	someBytes := make([]byte, abi.PaddedPieceSize(2048).Unpadded())
 	_, err := io.ReadFull(rand.Reader, someBytes)
@@ -124,6 +135,27 @@ func WorkflowProofsLifecycle(t TestHelper) {
 	sealPreCommitPhase1Output, err := SealPreCommitPhase1(sealProofType, sectorCacheDirPath, stagedSectorFile.Name(), sealedSectorFile.Name(), sectorNum, minerID, ticket, publicPieces)
 	t.RequireNoError(err)
 
+	// the layers labeled on their own match those of phase 1, and replace
+	// them for phase 2
+	porepID, err := PoRepID(sealProofType)
+	t.RequireNoError(err)
+	replicaID, err := ComputeReplicaID(minerID, sectorNum, ticket, preGeneratedUnsealedCID, porepID)
+	t.RequireNoError(err)
+
+	sdrCacheDirPath := requireTempDirPath(t, "sdr-cache-dir")
+	defer os.RemoveAll(sdrCacheDirPath)
+	t.RequireNoError(GenerateSDR(sealProofType, sdrCacheDirPath, replicaID))
+
+	for layer := 1; layer <= sdrLayers(2048); layer++ {
+		expected, err := ioutil.ReadFile(sdrLayerPath(sectorCacheDirPath, layer))
+		t.RequireNoError(err)
+		labeled, err := ioutil.ReadFile(sdrLayerPath(sdrCacheDirPath, layer))
+		t.RequireNoError(err)
+		t.AssertTrue(bytes.Equal(expected, labeled), "SDR layer %d differs from the one of phase 1", layer)
+
+		t.RequireNoError(os.Rename(sdrLayerPath(sdrCacheDirPath, layer), sdrLayerPath(sectorCacheDirPath, layer)))
+	}
+
 	sealedCID, unsealedCID, err := SealPreCommitPhase2(sealPreCommitPhase1Output, sectorCacheDirPath, sealedSectorFile.Name())
 	t.RequireNoError(err)
 
@@ -153,6 +185,127 @@ func WorkflowProofsLifecycle(t TestHelper) {
 	t.RequireNoError(err)
 	t.RequireTrue(isValid, "proof wasn't valid")
 
+	// commit again through a file holding the phase 1 output
+	sealCommitPhase1OutputPath := filepath.Join(sectorCacheDirPath, "c1-output")
+	t.RequireNoError(SealCommitPhase1ToFile(sealProofType, sealedCID, unsealedCID, sectorCacheDirPath, sealedSectorFile.Name(), sectorNum, minerID, ticket, seed, publicPieces, sealCommitPhase1OutputPath))
+	defer os.Remove(sealCommitPhase1OutputPath)
+
+	sealCommitPhase1OutputFromFile, err := ioutil.ReadFile(sealCommitPhase1OutputPath)
+	t.RequireNoError(err)
+	t.AssertTrue(bytes.Equal(sealCommitPhase1Output, sealCommitPhase1OutputFromFile), "phase 1 output file differs from the returned output")
+
+	proofFromFile, err := SealCommitPhase2FromFile(context.Background(), sealCommitPhase1OutputPath, sectorNum, minerID)
+	t.RequireNoError(err)
+
+	isValid, err = VerifySeal(prf.SealVerifyInfo{
+		SectorID: abi.SectorID{
+			Miner:  minerID,
+			Number: sectorNum,
+		},
+		SealedCID:             sealedCID,
+		SealProof:             sealProofType,
+		Proof:                 proofFromFile,
+		DealIDs:               []abi.DealID{},
+		Randomness:            ticket,
+		InteractiveRandomness: seed,
+		UnsealedCID:           unsealedCID,
+	})
+	t.RequireNoError(err)
+	t.RequireTrue(isValid, "proof from phase 1 output file wasn't valid")
+
+	proofFromReader, err := SealCommitPhase2Reader(context.Background(), bytes.NewReader(sealCommitPhase1Output), int64(len(sealCommitPhase1Output)), sectorNum, minerID)
+	t.RequireNoError(err)
+
+	isValid, err = VerifySeal(prf.SealVerifyInfo{
+		SectorID: abi.SectorID{
+			Miner:  minerID,
+			Number: sectorNum,
+		},
+		SealedCID:             sealedCID,
+		SealProof:             sealProofType,
+		Proof:                 proofFromReader,
+		DealIDs:               []abi.DealID{},
+		Randomness:            ticket,
+		InteractiveRandomness: seed,
+		UnsealedCID:           unsealedCID,
+	})
+	t.RequireNoError(err)
+	t.RequireTrue(isValid, "proof from phase 1 output reader wasn't valid")
+
+	// an external prover's proofs are verified before they are returned
+	var proverCalls int
+	RegisterCommit2Prover(func(ctx context.Context, phase1Out []byte, sector abi.SectorID) ([]byte, error) {
+		proverCalls++
+		t.AssertTrue(bytes.Equal(sealCommitPhase1Output, phase1Out), "external prover got another phase 1 output")
+		t.AssertEqual(abi.SectorID{Miner: minerID, Number: sectorNum}, sector)
+		return proof, nil
+	})
+	defer RegisterCommit2Prover(nil)
+
+	externalProof, err := SealCommitPhase2(sealCommitPhase1Output, sectorNum, minerID)
+	t.RequireNoError(err)
+	t.AssertTrue(bytes.Equal(proof, externalProof), "SealCommitPhase2 did not return the external proof")
+	t.AssertEqual(1, proverCalls)
+
+	// the file and reader variants use the external prover too, with the
+	// context of the caller
+	type ctxKey struct{}
+	callerCtx := context.WithValue(context.Background(), ctxKey{}, sectorNum)
+	RegisterCommit2Prover(func(ctx context.Context, phase1Out []byte, sector abi.SectorID) ([]byte, error) {
+		proverCalls++
+		t.AssertEqual(sectorNum, ctx.Value(ctxKey{}))
+		t.AssertTrue(bytes.Equal(sealCommitPhase1Output, phase1Out), "external prover got another phase 1 output")
+		return proof, nil
+	})
+
+	externalProof, err = SealCommitPhase2FromFile(callerCtx, sealCommitPhase1OutputPath, sectorNum, minerID)
+	t.RequireNoError(err)
+	t.AssertTrue(bytes.Equal(proof, externalProof), "SealCommitPhase2FromFile did not return the external proof")
+
+	externalProof, err = SealCommitPhase2Reader(callerCtx, bytes.NewReader(sealCommitPhase1Output), int64(len(sealCommitPhase1Output)), sectorNum, minerID)
+	t.RequireNoError(err)
+	t.AssertTrue(bytes.Equal(proof, externalProof), "SealCommitPhase2Reader did not return the external proof")
+	t.AssertEqual(3, proverCalls)
+
+	RegisterCommit2Prover(func(ctx context.Context, phase1Out []byte, sector abi.SectorID) ([]byte, error) {
+		invalidProof := append([]byte{}, proof...)
+		invalidProof[0] ^= 0xff
+		return invalidProof, nil
+	})
+	_, err = SealCommitPhase2(sealCommitPhase1Output, sectorNum, minerID)
+	t.AssertTrue(errors.Is(err, ErrExternalProofInvalid), "invalid external proof was accepted")
+
+	RegisterCommit2Prover(nil)
+	nativeProof, err := SealCommitPhase2(sealCommitPhase1Output, sectorNum, minerID)
+	t.RequireNoError(err)
+	t.AssertTrue(len(nativeProof) == len(proof), "native prover was not restored")
+
+	// a corrupted output in a batch fails alone
+	corrupted := append([]byte{}, sealCommitPhase1Output[:len(sealCommitPhase1Output)/2]...)
+	batch := []Commit1Output{
+		{Phase1Output: sealCommitPhase1Output, SectorNum: sectorNum, MinerID: minerID},
+		{Phase1Output: corrupted, SectorNum: sectorNum, MinerID: minerID},
+		{Phase1Output: sealCommitPhase1Output, SectorNum: sectorNum, MinerID: minerID},
+	}
+	batchProofs, err := SealCommitPhase2Batch(batch, 2)
+	var batchErr *SealCommitPhase2BatchError
+	t.RequireTrue(errors.As(err, &batchErr), "expected a SealCommitPhase2BatchError")
+	t.AssertEqual(1, len(batchErr.Errors))
+	t.AssertTrue(batchErr.Errors[1] != nil, "corrupted phase 1 output was not reported by index")
+	t.AssertEqual(len(batch), len(batchProofs))
+	t.AssertTrue(batchProofs[1] == nil, "corrupted phase 1 output produced a proof")
+	for _, idx := range []int{0, 2} {
+		t.AssertTrue(len(batchProofs[idx]) == len(proof), "batch did not keep the proofs of valid inputs")
+	}
+
+	// an output for another sector is rejected before proving
+	_, err = SealCommitPhase2Reader(context.Background(), bytes.NewReader(sealCommitPhase1Output), int64(len(sealCommitPhase1Output)), sectorNum+1, minerID)
+	t.AssertTrue(errors.Is(err, ErrSealCommitPhase1OutputMismatch), "phase 1 output of another sector was accepted")
+
+	t.RequireNoError(os.Truncate(sealCommitPhase1OutputPath, int64(len(sealCommitPhase1OutputFromFile)/2)))
+	_, err = SealCommitPhase2FromFile(context.Background(), sealCommitPhase1OutputPath, sectorNum, minerID)
+	t.AssertTrue(err != nil && strings.Contains(err.Error(), "truncated"), "truncated phase 1 output file was accepted")
+
 	// unseal the entire sector and verify that things went as we planned
 	_, err = sealedSectorFile.Seek(0, 0)
 	t.RequireNoError(err)
@@ -194,6 +347,103 @@ func WorkflowProofsLifecycle(t TestHelper) {
 	t.AssertEqual(1016, len(contentsC))
 	t.AssertTrue(bytes.Equal(someBytes[0:1016], contentsC[0:1016]), "bytes aren't equal")
 
+	// unseal the second piece again, once with a cancelled context, which
+	// leaves the output alone
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = sealedSectorFile.Seek(0, 0)
+	t.RequireNoError(err)
+	err = UnsealRangeCtx(cancelledCtx, sealProofType, sectorCacheDirPath, sealedSectorFile, unsealOutputFileE, sectorNum, minerID, ticket, unsealedCID, 1016, 1016)
+	t.AssertEqual(context.Canceled, err)
+	contentsE, err := ioutil.ReadFile(unsealOutputFileE.Name())
+	t.RequireNoError(err)
+	t.AssertEqual(0, len(contentsE))
+
+	_, err = sealedSectorFile.Seek(0, 0)
+	t.RequireNoError(err)
+	t.RequireNoError(UnsealRangeCtx(context.Background(), sealProofType, sectorCacheDirPath, sealedSectorFile, unsealOutputFileE, sectorNum, minerID, ticket, unsealedCID, 1016, 1016))
+	contentsE, err = ioutil.ReadFile(unsealOutputFileE.Name())
+	t.RequireNoError(err)
+	t.AssertTrue(bytes.Equal(contentsC, contentsE), "UnsealRangeCtx and UnsealRange disagree")
+
+	// cancelling an unseal in progress kills its child process: with a cache
+	// of FIFOs, it blocks opening the first cache file it reads
+	if atomic.LoadInt32(&unsealChildEnabled) != 0 {
+		blockingCacheDirPath := requireTempDirPath(t, "blocking-cache-dir")
+		defer os.RemoveAll(blockingCacheDirPath)
+
+		cacheFiles, err := ioutil.ReadDir(sectorCacheDirPath)
+		t.RequireNoError(err)
+		for _, cacheFile := range cacheFiles {
+			t.RequireNoError(syscall.Mkfifo(filepath.Join(blockingCacheDirPath, cacheFile.Name()), 0600))
+		}
+
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		_, err = sealedSectorFile.Seek(0, 0)
+		t.RequireNoError(err)
+		started := time.Now()
+		err = UnsealRangeCtx(timeoutCtx, sealProofType, blockingCacheDirPath, sealedSectorFile, unsealOutputFileE, sectorNum, minerID, ticket, unsealedCID, 1016, 1016)
+		t.AssertEqual(context.DeadlineExceeded, err)
+		t.AssertTrue(time.Since(started) < 10*time.Second, "the unseal process was not killed when the context was done")
+
+		contentsE, err = ioutil.ReadFile(unsealOutputFileE.Name())
+		t.RequireNoError(err)
+		t.AssertTrue(bytes.Equal(contentsC, contentsE), "UnsealRangeCtx left a cancelled unseal in the output")
+	}
+
+	// errors of the unsealing process are returned as they are
+	err = UnsealRangeCtx(context.Background(), abi.RegisteredSealProof(-1), sectorCacheDirPath, sealedSectorFile, unsealOutputFileE, sectorNum, minerID, ticket, unsealedCID, 1016, 1016)
+	t.AssertTrue(err != nil && strings.Contains(err.Error(), "no mapping to C.FFIRegisteredSealProof"), "unexpected error unsealing with an invalid proof type: %v", err)
+
+	// stream ranges which start and end in the middle of a node, out of a
+	// sealed sector which is not a file
+	sealedSectorInfo, err := sealedSectorFile.Stat()
+	t.RequireNoError(err)
+	sealedSectorReader := io.NewSectionReader(sealedSectorFile, 0, sealedSectorInfo.Size())
+
+	for _, r := range []struct{ offset, length uint64 }{{100, 500}, {0, 2032}, {1016, 1}, {2031, 1}} {
+		var streamed bytes.Buffer
+		t.RequireNoError(UnsealRangeToWriter(sealProofType, sectorCacheDirPath, sealedSectorReader, &streamed, sectorNum, minerID, ticket, unsealedCID, r.offset, r.length))
+		t.AssertTrue(bytes.Equal(contents[r.offset:r.offset+r.length], streamed.Bytes()), "streamed range differs from the unsealed sector")
+	}
+
+	var streamed bytes.Buffer
+	t.RequireNoError(UnsealRangeToWriter(sealProofType, sectorCacheDirPath, sealedSectorFile, &streamed, sectorNum, minerID, ticket, unsealedCID, 1000, 0))
+	t.AssertEqual(0, streamed.Len())
+
+	err = UnsealRangeToWriter(sealProofType, sectorCacheDirPath, sealedSectorFile, &streamed, sectorNum, minerID, ticket, unsealedCID, 2000, 33)
+	t.AssertTrue(err != nil, "range past the end of the sector was accepted")
+
+	// the number of decoding threads does not change the output
+	var concurrencyOutputs [][]byte
+	for _, n := range []int{1, 8} {
+		t.RequireNoError(SetUnsealConcurrency(n))
+
+		var out bytes.Buffer
+		t.RequireNoError(UnsealRangeToWriter(sealProofType, sectorCacheDirPath, sealedSectorFile, &out, sectorNum, minerID, ticket, unsealedCID, 0, 2032))
+		concurrencyOutputs = append(concurrencyOutputs, out.Bytes())
+	}
+	t.RequireNoError(SetUnsealConcurrency(0))
+	t.AssertTrue(bytes.Equal(concurrencyOutputs[0], concurrencyOutputs[1]), "unsealing with 1 and 8 threads disagree")
+	t.AssertTrue(bytes.Equal(contents, concurrencyOutputs[0]), "unsealing with 1 thread differs from the unsealed sector")
+
+	// unseal overlapping, adjacent and empty ranges in one go
+	ranges := []Range{{Offset: 1016, Length: 10}, {Offset: 0, Length: 200}, {Offset: 150, Length: 20}, {Offset: 254, Length: 127}, {Offset: 7, Length: 0}}
+	rangeReaders, err := UnsealRanges(sealProofType, sectorCacheDirPath, sealedSectorReader, sectorNum, minerID, ticket, unsealedCID, ranges)
+	t.RequireNoError(err)
+	t.AssertEqual(len(ranges), len(rangeReaders))
+
+	for idx, r := range ranges {
+		var expected bytes.Buffer
+		t.RequireNoError(UnsealRangeToWriter(sealProofType, sectorCacheDirPath, sealedSectorFile, &expected, sectorNum, minerID, ticket, unsealedCID, r.Offset, r.Length))
+
+		actual, err := ioutil.ReadAll(rangeReaders[idx])
+		t.RequireNoError(err)
+		t.AssertTrue(bytes.Equal(expected.Bytes(), actual), "UnsealRanges and UnsealRangeToWriter disagree")
+	}
+
 	// verify that the sector builder owns no sealed sectors
 	var sealedSectorPaths []string
 	t.RequireNoError(filepath.Walk(sealedSectorsDir, visit(&sealedSectorPaths)))
@@ -214,6 +464,36 @@ func WorkflowProofsLifecycle(t TestHelper) {
 	t.RequireNoError(err, "FauxRep2 produced an error")
 	t.RequireTrue(!cid.Undef.Equals(fauxSectorCID2), "faux sector CID 2 shouldn't be undefined")
 
+	// the newer variants of the seal proof type can be faked as well
+	for proofType, info := range abi.SealProofInfos {
+		if info.SectorSize != 2048 || proofType == sealProofType {
+			continue
+		}
+
+		variantCacheDirPath := requireTempDirPath(t, "faux-variant-cache-dir")
+		defer os.RemoveAll(variantCacheDirPath)
+
+		variantSealedSectorFile := requireTempFile(t, bytes.NewReader([]byte{}), 0)
+		defer variantSealedSectorFile.Close()
+
+		variantCID, err := FauxRep(proofType, variantCacheDirPath, variantSealedSectorFile.Name())
+		t.RequireNoError(err, "FauxRep produced an error for seal proof type %d", proofType)
+		t.RequireTrue(!cid.Undef.Equals(variantCID), "faux sector CID shouldn't be undefined")
+	}
+
+	// the cache of the sealed sector holds everything needed for proving
+	cacheReport, err := CheckSectorCache(winningPostProofType, sectorCacheDirPath, sealedSectorFile.Name(), sectorNum, sealedCID)
+	t.RequireNoError(err)
+	t.AssertTrue(cacheReport.Provable, "sector cache is not provable: %+v", cacheReport)
+
+	// but not for another sealed CID
+	otherSealedCID, err := commcid.ReplicaCommitmentV1ToCID(make([]byte, 32))
+	t.RequireNoError(err)
+	cacheReport, err = CheckSectorCache(winningPostProofType, sectorCacheDirPath, sealedSectorFile.Name(), sectorNum, otherSealedCID)
+	t.RequireNoError(err)
+	t.AssertTrue(cacheReport.CommRMismatch, "p_aux matched another sealed CID")
+	t.AssertTrue(!cacheReport.Provable, "sector cache is provable for another sealed CID")
+
 	// generate a PoSt over the proving set before importing, just to exercise
 	// the new API
 	privateInfo := NewSortedPrivateSectorInfo(PrivateSectorInfo{
@@ -252,6 +532,195 @@ func WorkflowProofsLifecycle(t TestHelper) {
 	})
 	t.RequireNoError(err)
 	t.AssertTrue(isValid, "VerifyWinningPoSt rejected the (standalone) proof as invalid")
+
+	// the same through the Prover interface
+	prover := NewFFIProver()
+	proverProofs, err := prover.GenerateWinningPoSt(minerID, privateInfo, randomness[:])
+	t.RequireNoError(err)
+
+	isValid, err = prover.VerifyWinningPoSt(prf.WinningPoStVerifyInfo{
+		Randomness:        randomness[:],
+		Proofs:            proverProofs,
+		ChallengedSectors: challengedSectors,
+		Prover:            minerID,
+	})
+	t.RequireNoError(err)
+	t.AssertTrue(isValid, "Prover.VerifyWinningPoSt rejected the proof as invalid")
+
+	// generate a Window PoSt over the same sector, both in one call and
+	// spread over a worker pool
+	windowPrivateInfo := NewSortedPrivateSectorInfo(PrivateSectorInfo{
+		SectorInfo: prf.SectorInfo{
+			SectorNumber: sectorNum,
+			SealedCID:    sealedCID,
+		},
+		CacheDirPath:     sectorCacheDirPath,
+		PoStProofType:    windowPostProofType,
+		SealedSectorPath: sealedSectorFile.Name(),
+	})
+
+	windowProofs, faultySectors, err := GenerateWindowPoSt(minerID, windowPrivateInfo, randomness[:])
+	t.RequireNoError(err)
+	t.AssertEqual(0, len(faultySectors))
+
+	parallelProofs, faultySectors, err := GenerateWindowPoStParallel(context.Background(), minerID, windowPrivateInfo, randomness[:], 4)
+	t.RequireNoError(err)
+	t.AssertEqual(0, len(faultySectors))
+
+	for _, proofs := range [][]prf.PoStProof{windowProofs, parallelProofs} {
+		isValid, err = VerifyWindowPoSt(prf.WindowPoStVerifyInfo{
+			Randomness:        randomness[:],
+			Proofs:            proofs,
+			ChallengedSectors: provingSet,
+			Prover:            minerID,
+		})
+		t.RequireNoError(err)
+		t.AssertTrue(isValid, "VerifyWindowPoSt rejected the proof as invalid")
+	}
+}
+
+func WorkflowConcurrentSealScratchDirs(t TestHelper) {
+	sealProofType := abi.RegisteredSealProof_StackedDrg2KiBV1
+	unpaddedSize := abi.PaddedPieceSize(2048).Unpadded()
+
+	type sealResult struct {
+		scratchDir   string
+		scratchFiles map[string]struct{}
+		err          error
+	}
+
+	results := make(chan sealResult, 2)
+	for i := 0; i < 2; i++ {
+		scratchDir := requireTempDirPath(t, fmt.Sprintf("scratch-dir-%d", i))
+		defer os.RemoveAll(scratchDir)
+
+		cacheDirPath := requireTempDirPath(t, fmt.Sprintf("sector-cache-dir-%d", i))
+		defer os.RemoveAll(cacheDirPath)
+
+		sealedSectorFile := requireTempFile(t, bytes.NewReader([]byte{}), 0)
+		defer sealedSectorFile.Close()
+
+		sectorData := make([]byte, unpaddedSize)
+		_, err := io.ReadFull(rand.Reader, sectorData)
+		t.RequireNoError(err)
+
+		pieceFile := requireTempFile(t, bytes.NewReader(sectorData), uint64(unpaddedSize))
+		defer pieceFile.Close()
+
+		pieceCID, err := GeneratePieceCIDFromFile(sealProofType, pieceFile, unpaddedSize)
+		t.RequireNoError(err)
+		pieces := []abi.PieceInfo{{Size: unpaddedSize.Padded(), PieceCID: pieceCID}}
+
+		go func() {
+			// the scratch directory is listed while the layers are labeled,
+			// when the staged sector must exist
+			scratchFiles := map[string]struct{}{}
+			listScratchDir := func(layer, totalLayers int) {
+				entries, _ := ioutil.ReadDir(scratchDir)
+				for _, entry := range entries {
+					scratchFiles[entry.Name()] = struct{}{}
+				}
+			}
+
+			_, err := SealPreCommitPhase1FromReader(sealProofType, cacheDirPath, bytes.NewReader(sectorData), sealedSectorFile.Name(), abi.SectorNumber(42), randActorID(), make([]byte, 32), pieces, WithScratchDir(scratchDir), WithLayerProgress(listScratchDir))
+			results <- sealResult{scratchDir, scratchFiles, err}
+		}()
+	}
+
+	for i := 0; i < 2; i++ {
+		result := <-results
+		t.RequireNoError(result.err)
+
+		// only the staged sector of the seal using the directory is in it
+		t.AssertEqual(1, len(result.scratchFiles), "unexpected files in scratch directory: %v", result.scratchFiles)
+		for name := range result.scratchFiles {
+			t.AssertTrue(strings.HasPrefix(name, "staged-sector-"), "unexpected file %s in scratch directory", name)
+		}
+
+		entries, err := ioutil.ReadDir(result.scratchDir)
+		t.RequireNoError(err)
+		t.AssertEqual(0, len(entries), "scratch directory not cleaned up")
+	}
+
+	// a scratch directory would be ignored where no temporary files are created
+	_, err := SealPreCommitPhase1(sealProofType, "", "", "", abi.SectorNumber(42), randActorID(), make([]byte, 32), nil, WithScratchDir(os.TempDir()))
+	t.AssertTrue(err != nil, "WithScratchDir was accepted by SealPreCommitPhase1")
+}
+
+func WorkflowStagedSectorBuilder(t TestHelper) {
+	sealProofType := abi.RegisteredSealProof_StackedDrg2KiBV1
+
+	someBytes := make([]byte, 1016)
+	_, err := io.ReadFull(rand.Reader, someBytes)
+	t.RequireNoError(err)
+
+	stagedSectorFile := requireTempFile(t, bytes.NewReader([]byte{}), 0)
+	defer stagedSectorFile.Close()
+
+	builder, err := NewStagedSectorBuilder(sealProofType, stagedSectorFile)
+	t.RequireNoError(err)
+
+	// the first piece is read from a file, the second one from a reader
+	pieceFileA := requireTempFile(t, bytes.NewReader(someBytes[0:127]), 127)
+	defer pieceFileA.Close()
+
+	pieceA, err := builder.AddPiece(pieceFileA, 127)
+	t.RequireNoError(err)
+
+	pieceB, err := builder.AddPiece(bytes.NewReader(someBytes), 1016)
+	t.RequireNoError(err)
+
+	// the sector is full once the second piece is aligned
+	_, err = builder.AddPiece(bytes.NewReader(someBytes), 127)
+	t.AssertTrue(err != nil, "a piece overflowing the sector must be rejected")
+
+	t.RequireNoError(builder.Pad())
+
+	commD, pieces, err := builder.Finish()
+	t.RequireNoError(err)
+	t.AssertEqual([]abi.PieceInfo{pieceA, pieceB}, pieces)
+
+	expectedCommD, err := GenerateUnsealedCID(sealProofType, pieces)
+	t.RequireNoError(err)
+	t.AssertTrue(commD.Equals(expectedCommD), "builder and batch computation should agree on data commitment")
+
+	// the staged sector is the one written piece by piece
+	expectedStagedFile := requireTempFile(t, bytes.NewReader([]byte{}), 0)
+	defer expectedStagedFile.Close()
+
+	_, _, _, err = WriteWithAlignment(sealProofType, requireTempFile(t, bytes.NewReader(someBytes[0:127]), 127), 127, expectedStagedFile, []abi.UnpaddedPieceSize{})
+	t.RequireNoError(err)
+	_, _, _, err = WriteWithAlignment(sealProofType, requireTempFile(t, bytes.NewReader(someBytes), 1016), 1016, expectedStagedFile, []abi.UnpaddedPieceSize{127})
+	t.RequireNoError(err)
+
+	staged, err := ioutil.ReadFile(stagedSectorFile.Name())
+	t.RequireNoError(err)
+	expectedStaged, err := ioutil.ReadFile(expectedStagedFile.Name())
+	t.RequireNoError(err)
+	t.AssertTrue(bytes.Equal(expectedStaged, staged), "staged sectors differ")
+
+	// padding a partially filled sector adds zero pieces up to its end
+	paddedSectorFile := requireTempFile(t, bytes.NewReader([]byte{}), 0)
+	defer paddedSectorFile.Close()
+
+	builder, err = NewStagedSectorBuilder(sealProofType, paddedSectorFile)
+	t.RequireNoError(err)
+	_, err = builder.AddPiece(bytes.NewReader(someBytes), 127)
+	t.RequireNoError(err)
+	t.RequireNoError(builder.Pad())
+
+	commD, pieces, err = builder.Finish()
+	t.RequireNoError(err)
+
+	var total abi.PaddedPieceSize
+	for _, piece := range pieces {
+		total += piece.Size
+	}
+	t.AssertEqual(abi.PaddedPieceSize(2048), total)
+
+	expectedCommD, err = GenerateUnsealedCID(sealProofType, pieces[:1])
+	t.RequireNoError(err)
+	t.AssertTrue(commD.Equals(expectedCommD), "zero pieces should not change the data commitment")
 }
 
 func WorkflowGetGPUDevicesDoesNotProduceAnError(t TestHelper) {
@@ -348,6 +817,154 @@ func WorkflowGenerateWinningPoStSectorChallenge(t TestHelper) {
 	}
 }
 
+func WorkflowProveSectorZero(t TestHelper) {
+	minerID := randActorID()
+	randomness := [32]byte{9, 9, 9}
+	sectorNum := abi.SectorNumber(0)
+
+	cacheDirPath, sealedSectorPath, sealedCID := requireSealedSector(t, sectorNum, minerID)
+	defer os.RemoveAll(cacheDirPath)
+	defer os.Remove(sealedSectorPath)
+
+	provingSet := []prf.SectorInfo{{
+		SealProof:    abi.RegisteredSealProof_StackedDrg2KiBV1,
+		SectorNumber: sectorNum,
+		SealedCID:    sealedCID,
+	}}
+
+	privateInfo := NewSortedPrivateSectorInfo(PrivateSectorInfo{
+		SectorInfo:       provingSet[0],
+		CacheDirPath:     cacheDirPath,
+		PoStProofType:    abi.RegisteredPoStProof_StackedDrgWinning2KiBV1,
+		SealedSectorPath: sealedSectorPath,
+	})
+
+	proofs, err := GenerateWinningPoSt(minerID, privateInfo, randomness[:])
+	t.RequireNoError(err)
+
+	isValid, err := VerifyWinningPoSt(prf.WinningPoStVerifyInfo{
+		Randomness:        randomness[:],
+		Proofs:            proofs,
+		ChallengedSectors: provingSet,
+		Prover:            minerID,
+	})
+	t.RequireNoError(err)
+	t.AssertTrue(isValid, "VerifyWinningPoSt rejected the proof of sector 0 as invalid")
+
+	windowPrivateInfo := NewSortedPrivateSectorInfo(PrivateSectorInfo{
+		SectorInfo:       provingSet[0],
+		CacheDirPath:     cacheDirPath,
+		PoStProofType:    abi.RegisteredPoStProof_StackedDrgWindow2KiBV1,
+		SealedSectorPath: sealedSectorPath,
+	})
+
+	windowProofs, faultySectors, err := GenerateWindowPoSt(minerID, windowPrivateInfo, randomness[:])
+	t.RequireNoError(err)
+	t.AssertEqual(0, len(faultySectors))
+
+	isValid, err = VerifyWindowPoSt(prf.WindowPoStVerifyInfo{
+		Randomness:        randomness[:],
+		Proofs:            windowProofs,
+		ChallengedSectors: provingSet,
+		Prover:            minerID,
+	})
+	t.RequireNoError(err)
+	t.AssertTrue(isValid, "VerifyWindowPoSt rejected the proof of sector 0 as invalid")
+}
+
+func WorkflowWindowPoStMissingReplica(t TestHelper) {
+	minerID := randActorID()
+	randomness := [32]byte{9, 9, 9}
+
+	var provingSet []prf.SectorInfo
+	var privateSectors []PrivateSectorInfo
+	for sectorNum := abi.SectorNumber(1); sectorNum <= 2; sectorNum++ {
+		cacheDirPath, sealedSectorPath, sealedCID := requireSealedSector(t, sectorNum, minerID)
+		defer os.RemoveAll(cacheDirPath)
+		defer os.Remove(sealedSectorPath)
+
+		sector := prf.SectorInfo{
+			SealProof:    abi.RegisteredSealProof_StackedDrg2KiBV1,
+			SectorNumber: sectorNum,
+			SealedCID:    sealedCID,
+		}
+		provingSet = append(provingSet, sector)
+		privateSectors = append(privateSectors, PrivateSectorInfo{
+			SectorInfo:       sector,
+			CacheDirPath:     cacheDirPath,
+			PoStProofType:    abi.RegisteredPoStProof_StackedDrgWindow2KiBV1,
+			SealedSectorPath: sealedSectorPath,
+		})
+	}
+
+	// the replica of the second sector is lost
+	t.RequireNoError(os.Remove(privateSectors[1].SealedSectorPath))
+	privateInfo := NewSortedPrivateSectorInfo(privateSectors...)
+
+	_, _, err := GenerateWindowPoSt(minerID, privateInfo, randomness[:])
+	t.AssertTrue(err != nil, "GenerateWindowPoSt succeeded without a replica")
+
+	proofs, faultySectors, err := GenerateWindowPoSt(minerID, privateInfo, randomness[:], WithFaultTolerance(1))
+	t.RequireNoError(err)
+	t.AssertEqual([]abi.SectorNumber{2}, faultySectors)
+
+	isValid, err := VerifyWindowPoSt(prf.WindowPoStVerifyInfo{
+		Randomness:        randomness[:],
+		Proofs:            proofs,
+		ChallengedSectors: provingSet[:1],
+		Prover:            minerID,
+	})
+	t.RequireNoError(err)
+	t.AssertTrue(isValid, "VerifyWindowPoSt rejected the proof over the readable sector as invalid")
+}
+
+func WorkflowWindowPoStParallelSealedCIDOrder(t TestHelper) {
+	minerID := randActorID()
+	randomness := [32]byte{9, 9, 9}
+
+	// seal sectors until their sealed CID order differs from their sector
+	// number order, with more of them than fit in a 2KiB partition
+	var provingSet []prf.SectorInfo
+	var privateSectors []PrivateSectorInfo
+	for sectorNum := abi.SectorNumber(1); ; sectorNum++ {
+		cacheDirPath, sealedSectorPath, sealedCID := requireSealedSector(t, sectorNum, minerID)
+		defer os.RemoveAll(cacheDirPath)
+		defer os.Remove(sealedSectorPath)
+
+		sector := prf.SectorInfo{
+			SealProof:    abi.RegisteredSealProof_StackedDrg2KiBV1,
+			SectorNumber: sectorNum,
+			SealedCID:    sealedCID,
+		}
+		provingSet = append(provingSet, sector)
+		privateSectors = append(privateSectors, PrivateSectorInfo{
+			SectorInfo:       sector,
+			CacheDirPath:     cacheDirPath,
+			PoStProofType:    abi.RegisteredPoStProof_StackedDrgWindow2KiBV1,
+			SealedSectorPath: sealedSectorPath,
+		})
+
+		byCID := NewSortedPrivateSectorInfoBy(SortBySealedCID, privateSectors...)
+		if len(privateSectors) > 2 && byCID.Values()[0].SectorNumber != 1 {
+			break
+		}
+	}
+
+	privateInfo := NewSortedPrivateSectorInfoBy(SortBySealedCID, privateSectors...)
+	proofs, faultySectors, err := GenerateWindowPoStParallel(context.Background(), minerID, privateInfo, randomness[:], 2)
+	t.RequireNoError(err)
+	t.AssertEqual(0, len(faultySectors))
+
+	isValid, err := VerifyWindowPoSt(prf.WindowPoStVerifyInfo{
+		Randomness:        randomness[:],
+		Proofs:            proofs,
+		ChallengedSectors: provingSet,
+		Prover:            minerID,
+	})
+	t.RequireNoError(err)
+	t.AssertTrue(isValid, "VerifyWindowPoSt rejected the proof of sectors sorted by sealed CID as invalid")
+}
+
 func randActorID() abi.ActorID {
 	bID, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
 	if err != nil {
@@ -366,6 +983,38 @@ func randUInt64() uint64 {
 	return binary.LittleEndian.Uint64(buf)
 }
 
+// requireSealedSector seals a 2KiB sector of random data, returning its cache
+// directory, the path of its sealed sector file and its sealed CID
+func requireSealedSector(t TestHelper, sectorNum abi.SectorNumber, minerID abi.ActorID) (string, string, cid.Cid) {
+	sealProofType := abi.RegisteredSealProof_StackedDrg2KiBV1
+	unpaddedSize := abi.PaddedPieceSize(2048).Unpadded()
+
+	sectorData := make([]byte, unpaddedSize)
+	_, err := io.ReadFull(rand.Reader, sectorData)
+	t.RequireNoError(err)
+
+	stagedSectorFile := requireTempFile(t, bytes.NewReader(sectorData), uint64(unpaddedSize))
+	defer os.Remove(stagedSectorFile.Name())
+	defer stagedSectorFile.Close()
+
+	pieceCID, err := GeneratePieceCIDFromFile(sealProofType, stagedSectorFile, unpaddedSize)
+	t.RequireNoError(err)
+	pieces := []abi.PieceInfo{{Size: unpaddedSize.Padded(), PieceCID: pieceCID}}
+
+	cacheDirPath := requireTempDirPath(t, "sector-cache-dir")
+
+	sealedSectorFile := requireTempFile(t, bytes.NewReader([]byte{}), 0)
+	t.RequireNoError(sealedSectorFile.Close())
+
+	phase1Output, err := SealPreCommitPhase1(sealProofType, cacheDirPath, stagedSectorFile.Name(), sealedSectorFile.Name(), sectorNum, minerID, abi.SealRandomness{5, 4, 2}, pieces)
+	t.RequireNoError(err)
+
+	sealedCID, _, err := SealPreCommitPhase2(phase1Output, cacheDirPath, sealedSectorFile.Name())
+	t.RequireNoError(err)
+
+	return cacheDirPath, sealedSectorFile.Name(), sealedCID
+}
+
 func requireTempFile(t TestHelper, fileContentsReader io.Reader, size uint64) *os.File {
 	file, err := ioutil.TempFile("", "")
 	t.RequireNoError(err)